@@ -0,0 +1,395 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ADF task and decision item states.
+const (
+	adfTaskTodo     = "TODO"
+	adfTaskDone     = "DONE"
+	adfDecided      = "DECIDED"
+	adfDecisionText = "✓ decision: "
+)
+
+var adfTaskLine = regexp.MustCompile(`^- \[([ xX])\] ?(.*)$`)
+
+// adfTextNodes are the node types TextToADF produces. Blocks made only of
+// them are rebuilt faithfully from their text.
+var adfTextNodes = map[string]bool{
+	"doc":          true,
+	"paragraph":    true,
+	"text":         true,
+	"hardBreak":    true,
+	"taskList":     true,
+	"taskItem":     true,
+	"decisionList": true,
+	"decisionItem": true,
+}
+
+// adfKnownNodes are the other ADF node types whose text is rendered. Plain
+// text can't rebuild them, so blocks containing them are preserved.
+var adfKnownNodes = map[string]bool{
+	"heading":       true,
+	"bulletList":    true,
+	"orderedList":   true,
+	"listItem":      true,
+	"blockquote":    true,
+	"codeBlock":     true,
+	"rule":          true,
+	"panel":         true,
+	"table":         true,
+	"tableRow":      true,
+	"tableHeader":   true,
+	"tableCell":     true,
+	"expand":        true,
+	"nestedExpand":  true,
+	"mention":       true,
+	"emoji":         true,
+	"date":          true,
+	"status":        true,
+	"inlineCard":    true,
+	"blockCard":     true,
+	"embedCard":     true,
+	"mediaSingle":   true,
+	"mediaGroup":    true,
+	"media":         true,
+	"mediaInline":   true,
+	"layoutSection": true,
+	"layoutColumn":  true,
+	"placeholder":   true,
+}
+
+// ADFBlock is a top-level ADF block that plain text can't represent, kept
+// so writing the text back doesn't drop it.
+type ADFBlock struct {
+	// Text is the block as rendered by ADFToText, or "" when it renders to
+	// nothing (unknown node types, media).
+	Text string `json:"text,omitempty"`
+	// After is the text of the nearest preceding block that has any,
+	// anchoring blocks without text of their own.
+	After string `json:"after,omitempty"`
+	// Index is the number of blocks with text that preceded the block.
+	Index int             `json:"index"`
+	Node  json.RawMessage `json:"node"`
+}
+
+// PreservedADFBlocks returns the top-level blocks of a document that
+// TextToADF can't rebuild from ADFToText's output.
+func PreservedADFBlocks(adf interface{}) []ADFBlock {
+	doc, ok := adf.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	content, _ := doc["content"].([]interface{})
+
+	var blocks []ADFBlock
+	index, after := 0, ""
+	for _, node := range content {
+		text := extractText(node)
+		if !adfRebuildable(node, false) {
+			raw, err := json.Marshal(node)
+			if err == nil {
+				block := ADFBlock{Text: text, Index: index, Node: raw}
+				if text == "" {
+					block.After = after
+				}
+				blocks = append(blocks, block)
+			}
+		}
+		if text != "" {
+			index++
+			after = text
+		}
+	}
+	return blocks
+}
+
+// SpliceADFBlocks puts preserved blocks back into a document built from
+// text. A block whose text is still present replaces the rebuilt block;
+// blocks without text go back after the block they followed, or at their
+// old position when that block is gone. Blocks whose text was edited or
+// removed are dropped, since the text is authoritative.
+func SpliceADFBlocks(doc map[string]interface{}, blocks []ADFBlock) map[string]interface{} {
+	if doc == nil || len(blocks) == 0 {
+		return doc
+	}
+
+	// Round-trip through JSON so built and parsed documents share a shape.
+	var normalized struct {
+		Content []interface{} `json:"content"`
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil || json.Unmarshal(raw, &normalized) != nil {
+		return doc
+	}
+	content := normalized.Content
+
+	texts := make([]string, len(content))
+	for i, node := range content {
+		texts[i] = extractText(node)
+	}
+
+	// inserted[i+1] holds the blocks that go after content[i].
+	inserted := make([][]interface{}, len(content)+1)
+	replaced := make(map[int]bool)
+	for _, block := range blocks {
+		var node interface{}
+		if json.Unmarshal(block.Node, &node) != nil {
+			continue
+		}
+
+		if block.Text != "" {
+			for i, text := range texts {
+				if text == block.Text && !replaced[i] {
+					content[i] = node
+					replaced[i] = true
+					break
+				}
+			}
+			continue
+		}
+
+		position := block.Index - 1
+		if block.After != "" {
+			best := -2
+			for i, text := range texts {
+				if text == block.After && (best == -2 || abs(i-position) < abs(best-position)) {
+					best = i
+				}
+			}
+			if best != -2 {
+				position = best
+			}
+		} else {
+			position = -1
+		}
+		if position >= len(content) {
+			position = len(content) - 1
+		}
+		if position < -1 {
+			position = -1
+		}
+		inserted[position+1] = append(inserted[position+1], node)
+	}
+
+	spliced := append([]interface{}{}, inserted[0]...)
+	for i, node := range content {
+		spliced = append(spliced, node)
+		spliced = append(spliced, inserted[i+1]...)
+	}
+
+	result := make(map[string]interface{}, len(doc))
+	for k, v := range doc {
+		result[k] = v
+	}
+	result["content"] = spliced
+	return result
+}
+
+// adfRebuildable reports whether a node is made only of node types that
+// TextToADF produces. Nested task and decision lists are flattened by the
+// text rendering, so they count as not rebuildable.
+func adfRebuildable(node interface{}, inList bool) bool {
+	nodeMap, ok := node.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	nodeType, _ := nodeMap["type"].(string)
+	if !adfTextNodes[nodeType] {
+		return false
+	}
+
+	list := nodeType == "taskList" || nodeType == "decisionList"
+	if list && inList {
+		return false
+	}
+
+	content, _ := nodeMap["content"].([]interface{})
+	for _, child := range content {
+		if !adfRebuildable(child, inList || list) {
+			return false
+		}
+	}
+	return true
+}
+
+// adfItemsText renders a task or decision list, one item per line.
+func adfItemsText(nodeMap map[string]interface{}) string {
+	content, _ := nodeMap["content"].([]interface{})
+	lines := make([]string, 0, len(content))
+	for _, item := range content {
+		if line := extractText(item); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// adfItemText renders a single task item as a Markdown checkbox, or a
+// decision item with a decision marker.
+func adfItemText(nodeMap map[string]interface{}, nodeType string) string {
+	content, _ := nodeMap["content"].([]interface{})
+	var text strings.Builder
+	for _, child := range content {
+		text.WriteString(extractText(child))
+	}
+
+	if nodeType == "decisionItem" {
+		return adfDecisionText + text.String()
+	}
+
+	attrs, _ := nodeMap["attrs"].(map[string]interface{})
+	if state, _ := attrs["state"].(string); state == adfTaskDone {
+		return "- [x] " + text.String()
+	}
+	return "- [ ] " + text.String()
+}
+
+// adfListText renders a bullet or ordered list as "- " or "1. " lines.
+// Nested lists and further blocks in an item are indented under its marker.
+func adfListText(nodeMap map[string]interface{}, ordered bool) string {
+	number := adfIntAttr(nodeMap, "order", 1)
+	content, _ := nodeMap["content"].([]interface{})
+	lines := make([]string, 0, len(content))
+	for _, item := range content {
+		marker := "- "
+		if ordered {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+
+		itemMap, _ := item.(map[string]interface{})
+		text := adfChildrenText(itemMap, "\n")
+		lines = append(lines, marker+strings.ReplaceAll(text, "\n", "\n"+strings.Repeat(" ", len(marker))))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// adfQuoteText renders a block quote with "> " before every line.
+func adfQuoteText(nodeMap map[string]interface{}) string {
+	lines := strings.Split(adfChildrenText(nodeMap, "\n\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// adfChildrenText renders the children of a node that have text, joined by
+// sep.
+func adfChildrenText(nodeMap map[string]interface{}, sep string) string {
+	content, _ := nodeMap["content"].([]interface{})
+	parts := make([]string, 0, len(content))
+	for _, child := range content {
+		if text := extractText(child); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// adfIntAttr returns a numeric attribute of a node, which is a float64 in
+// parsed documents and an int in built ones.
+func adfIntAttr(nodeMap map[string]interface{}, name string, fallback int) int {
+	attrs, _ := nodeMap["attrs"].(map[string]interface{})
+	switch value := attrs[name].(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	}
+	return fallback
+}
+
+// adfExpandText renders an expand as its title followed by its content,
+// one block per line.
+func adfExpandText(nodeMap map[string]interface{}) string {
+	var lines []string
+	if attrs, ok := nodeMap["attrs"].(map[string]interface{}); ok {
+		if title, _ := attrs["title"].(string); title != "" {
+			lines = append(lines, title)
+		}
+	}
+
+	content, _ := nodeMap["content"].([]interface{})
+	for _, child := range content {
+		if text := extractText(child); text != "" {
+			lines = append(lines, text)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// textToItemList converts a paragraph made entirely of checklist lines or
+// decision lines into a task or decision list. It returns nil for any
+// other paragraph.
+func textToItemList(lines []string) map[string]interface{} {
+	tasks, decisions := true, true
+	for _, line := range lines {
+		tasks = tasks && adfTaskLine.MatchString(line)
+		decisions = decisions && strings.HasPrefix(line, adfDecisionText)
+	}
+	if len(lines) == 0 || (!tasks && !decisions) {
+		return nil
+	}
+
+	listType, itemType := "taskList", "taskItem"
+	if !tasks {
+		listType, itemType = "decisionList", "decisionItem"
+	}
+
+	items := make([]map[string]interface{}, 0, len(lines))
+	for _, line := range lines {
+		state, text := adfDecided, strings.TrimPrefix(line, adfDecisionText)
+		if tasks {
+			m := adfTaskLine.FindStringSubmatch(line)
+			state, text = adfTaskTodo, m[2]
+			if m[1] != " " {
+				state = adfTaskDone
+			}
+		}
+
+		item := map[string]interface{}{
+			"type":  itemType,
+			"attrs": map[string]interface{}{"localId": adfLocalID(), "state": state},
+		}
+		if text != "" {
+			item["content"] = []map[string]interface{}{adfText(text, nil)}
+		}
+		items = append(items, item)
+	}
+
+	return map[string]interface{}{
+		"type":    listType,
+		"attrs":   map[string]interface{}{"localId": adfLocalID()},
+		"content": items,
+	}
+}
+
+// adfLocalID returns a random UUID for the localId attribute that task and
+// decision nodes require.
+func adfLocalID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "00000000-0000-4000-8000-000000000000"
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
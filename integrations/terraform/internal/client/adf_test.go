@@ -0,0 +1,240 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// parseADF returns doc as it looks after a round trip through Jira, with
+// JSON numbers and plain maps and slices.
+func parseADF(t *testing.T, doc interface{}) map[string]interface{} {
+	t.Helper()
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed map[string]interface{}
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func blockTypes(doc map[string]interface{}) []string {
+	content, _ := doc["content"].([]interface{})
+	types := make([]string, len(content))
+	for i, node := range content {
+		types[i], _ = node.(map[string]interface{})["type"].(string)
+	}
+	return types
+}
+
+func TestTaskAndDecisionListsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		wantTypes []string
+	}{
+		{"task list", "- [ ] Write tests\n- [x] Ship it\n- [X] Tell support", []string{"taskList"}},
+		{"decision list", "✓ decision: Use Go\n✓ decision: Keep v2 support", []string{"decisionList"}},
+		{"mixed lines stay text", "- [ ] Write tests\nand then ship", []string{"paragraph"}},
+		{"list between paragraphs", "Plan:\n\n- [ ] Draft\n\nDone.", []string{"paragraph", "taskList", "paragraph"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := parseADF(t, TextToADF(tt.text))
+			if got := blockTypes(doc); !reflect.DeepEqual(got, tt.wantTypes) {
+				t.Errorf("block types = %v, want %v", got, tt.wantTypes)
+			}
+			if PreservedADFBlocks(doc) != nil {
+				t.Error("text-built document has blocks to preserve")
+			}
+
+			want := tt.text
+			if tt.name == "task list" {
+				// Done tasks render with a lowercase x.
+				want = "- [ ] Write tests\n- [x] Ship it\n- [x] Tell support"
+			}
+			if got := ADFToText(doc); got != want {
+				t.Errorf("ADFToText() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTaskItemStates(t *testing.T) {
+	doc := parseADF(t, TextToADF("- [ ] Open\n- [x] Closed"))
+	list := doc["content"].([]interface{})[0].(map[string]interface{})
+	var states []string
+	for _, item := range list["content"].([]interface{}) {
+		attrs := item.(map[string]interface{})["attrs"].(map[string]interface{})
+		if attrs["localId"] == "" {
+			t.Error("task item has no localId")
+		}
+		states = append(states, attrs["state"].(string))
+	}
+	if want := []string{"TODO", "DONE"}; !reflect.DeepEqual(states, want) {
+		t.Errorf("task states = %v, want %v", states, want)
+	}
+}
+
+func TestExpandText(t *testing.T) {
+	doc := map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{
+				"type":  "expand",
+				"attrs": map[string]interface{}{"title": "Details"},
+				"content": []interface{}{
+					map[string]interface{}{"type": "paragraph", "content": []interface{}{
+						map[string]interface{}{"type": "text", "text": "Hidden text"},
+					}},
+				},
+			},
+		},
+	}
+	if got, want := ADFToText(doc), "Details\nHidden text"; got != want {
+		t.Errorf("ADFToText() = %q, want %q", got, want)
+	}
+}
+
+// richDocument has a paragraph, a panel, an image and a nested task list,
+// none of which but the paragraphs plain text can rebuild.
+func richDocument() map[string]interface{} {
+	paragraph := func(text string) map[string]interface{} {
+		return map[string]interface{}{"type": "paragraph", "content": []interface{}{
+			map[string]interface{}{"type": "text", "text": text},
+		}}
+	}
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": []interface{}{
+			paragraph("Intro"),
+			map[string]interface{}{
+				"type":    "panel",
+				"attrs":   map[string]interface{}{"panelType": "warning"},
+				"content": []interface{}{paragraph("Careful")},
+			},
+			map[string]interface{}{
+				"type": "mediaSingle",
+				"content": []interface{}{
+					map[string]interface{}{"type": "media", "attrs": map[string]interface{}{"id": "abc", "type": "file"}},
+				},
+			},
+			paragraph("Outro"),
+		},
+	}
+}
+
+func TestPreservedADFBlocks(t *testing.T) {
+	blocks := PreservedADFBlocks(parseADF(t, richDocument()))
+	if len(blocks) != 2 {
+		t.Fatalf("preserved %d blocks, want 2: %+v", len(blocks), blocks)
+	}
+	if b := blocks[0]; b.Text != "Careful" || b.Index != 1 || b.After != "" {
+		t.Errorf("panel block = %+v, want text Careful at index 1", b)
+	}
+	if b := blocks[1]; b.Text != "" || b.Index != 2 || b.After != "Careful" {
+		t.Errorf("media block = %+v, want no text after Careful at index 2", b)
+	}
+
+	if PreservedADFBlocks("plain v2 text") != nil {
+		t.Error("PreservedADFBlocks() of a string returned blocks")
+	}
+}
+
+func TestSpliceADFBlocks(t *testing.T) {
+	original := parseADF(t, richDocument())
+	blocks := PreservedADFBlocks(original)
+	text := ADFToText(original)
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"unchanged", text, []string{"paragraph", "panel", "mediaSingle", "paragraph"}},
+		{"paragraph edited", "Intro\n\nCareful\n\nOutro, revised", []string{"paragraph", "panel", "mediaSingle", "paragraph"}},
+		{"panel text edited", "Intro\n\nBe careful\n\nOutro", []string{"paragraph", "paragraph", "mediaSingle", "paragraph"}},
+		{"paragraph added first", "Summary\n\nIntro\n\nCareful\n\nOutro", []string{"paragraph", "paragraph", "panel", "mediaSingle", "paragraph"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := SpliceADFBlocks(TextToADF(tt.text), blocks)
+			if got := blockTypes(parseADF(t, doc)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("block types = %v, want %v", got, tt.want)
+			}
+			if got := ADFToText(parseADF(t, doc)); got != tt.text {
+				t.Errorf("spliced text = %q, want %q", got, tt.text)
+			}
+		})
+	}
+
+	if doc := map[string]interface{}{"type": "doc"}; !reflect.DeepEqual(SpliceADFBlocks(doc, nil), doc) {
+		t.Error("SpliceADFBlocks() without blocks changed the document")
+	}
+}
+
+func TestADFToText(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"heading", "## Steps", "## Steps"},
+		{"marks dropped", "Run **now**, see [docs](https://example.com)", "Run now, see docs"},
+		{"bullet list", "- one\n- two\n  - nested", "- one\n- two\n  - nested"},
+		{"ordered list", "7. seventh\n8. eighth", "7. seventh\n8. eighth"},
+		{"code block", "```sh\nmake\nmake test\n```", "```sh\nmake\nmake test\n```"},
+		{"quote", "> Quoted\n>\n> More", "> Quoted\n>\n> More"},
+		{"rule", "Above\n\n---\n\nBelow", "Above\n\n---\n\nBelow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ADFToText(parseADF(t, MarkdownToADF(tt.markdown))); got != tt.want {
+				t.Errorf("ADFToText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADFToTextInlineNodes(t *testing.T) {
+	doc := parseADF(t, map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{
+			map[string]interface{}{"type": "paragraph", "content": []interface{}{
+				map[string]interface{}{"type": "text", "text": "Line one"},
+				map[string]interface{}{"type": "hardBreak"},
+				map[string]interface{}{"type": "text", "text": "ask "},
+				map[string]interface{}{"type": "mention", "attrs": map[string]interface{}{"id": "1", "text": "@Ana"}},
+				map[string]interface{}{"type": "futureInlineNode", "content": []interface{}{
+					map[string]interface{}{"type": "text", "text": "dropped"},
+				}},
+			}},
+			map[string]interface{}{"type": "futureBlockNode", "content": []interface{}{
+				map[string]interface{}{"type": "text", "text": "dropped"},
+			}},
+		},
+	})
+
+	if got, want := ADFToText(doc), "Line one\nask "; got != want {
+		t.Errorf("ADFToText() = %q, want %q", got, want)
+	}
+	if got, want := ADFToText(TextToADF("Built\n\n- [x] in memory")), "Built\n\n- [x] in memory"; got != want {
+		t.Errorf("ADFToText(TextToADF()) = %q, want %q", got, want)
+	}
+	if got := ADFToText("v2 text"); got != "v2 text" {
+		t.Errorf("ADFToText(string) = %q, want it unchanged", got)
+	}
+	if got := ADFToText(nil); got != "" {
+		t.Errorf("ADFToText(nil) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,503 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Board types returned by the Agile API.
+const (
+	BoardTypeScrum  = "scrum"
+	BoardTypeKanban = "kanban"
+)
+
+// Sprint states returned by the Agile API.
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// agileMoveBatchSize is the maximum number of issues the Agile API accepts
+// in a single sprint or backlog move.
+const agileMoveBatchSize = 50
+
+// Board represents a Jira Software board.
+type Board struct {
+	ID       int64          `json:"id"`
+	Name     string         `json:"name,omitempty"`
+	Type     string         `json:"type,omitempty"`
+	Self     string         `json:"self,omitempty"`
+	Location *BoardLocation `json:"location,omitempty"`
+}
+
+// BoardLocation describes the project a board belongs to.
+type BoardLocation struct {
+	ProjectID  int64  `json:"projectId,omitempty"`
+	ProjectKey string `json:"projectKey,omitempty"`
+}
+
+// Sprint represents a Jira Software sprint.
+type Sprint struct {
+	ID            int64  `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	State         string `json:"state,omitempty"`
+	Goal          string `json:"goal,omitempty"`
+	StartDate     string `json:"startDate,omitempty"`
+	EndDate       string `json:"endDate,omitempty"`
+	OriginBoardID int64  `json:"originBoardId,omitempty"`
+	Self          string `json:"self,omitempty"`
+}
+
+// boardPage is a page of boards from the Agile API.
+type boardPage struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	IsLast     bool    `json:"isLast"`
+	Values     []Board `json:"values"`
+}
+
+// createBoardRequest is the request body for creating a board.
+type createBoardRequest struct {
+	Name     string              `json:"name"`
+	Type     string              `json:"type"`
+	FilterID int64               `json:"filterId"`
+	Location createBoardLocation `json:"location"`
+}
+
+// createBoardLocation places a new board in a project.
+type createBoardLocation struct {
+	Type           string `json:"type"`
+	ProjectKeyOrID string `json:"projectKeyOrId"`
+}
+
+// rankIssuesRequest is the request body for ranking issues.
+type rankIssuesRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+}
+
+// rankIssuesResponse reports per-issue results when ranking only partly
+// succeeds (HTTP 207).
+type rankIssuesResponse struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+// sprintUpdateRequest is the request body for replacing a sprint. Unlike
+// Sprint, an empty goal is sent so it can be cleared.
+type sprintUpdateRequest struct {
+	Name      string `json:"name"`
+	Goal      string `json:"goal"`
+	State     string `json:"state,omitempty"`
+	StartDate string `json:"startDate,omitempty"`
+	EndDate   string `json:"endDate,omitempty"`
+}
+
+// sprintIssuePage is a page of the issues in a sprint.
+type sprintIssuePage struct {
+	StartAt    int `json:"startAt"`
+	MaxResults int `json:"maxResults"`
+	Total      int `json:"total"`
+	Issues     []struct {
+		Key string `json:"key"`
+	} `json:"issues"`
+}
+
+// moveIssuesRequest is the request body for sprint and backlog moves.
+type moveIssuesRequest struct {
+	Issues []string `json:"issues"`
+}
+
+// GetBoards lists boards, optionally filtered by project key, board type,
+// and name. Jira matches names that contain the given text,
+// case-insensitively.
+func (c *JiraClient) GetBoards(ctx context.Context, projectKey, boardType, name string) ([]Board, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]Board, int, error) {
+		query := url.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		if projectKey != "" {
+			query.Set("projectKeyOrId", projectKey)
+		}
+		if boardType != "" {
+			query.Set("type", boardType)
+		}
+		if name != "" {
+			query.Set("name", name)
+		}
+
+		body, err := c.doAgileRequest(ctx, "GET", "/board?"+query.Encode(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page boardPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse boards: %w", err)
+		}
+
+		// The board listing doesn't always report a total; isLast is authoritative.
+		if page.IsLast {
+			return page.Values, startAt + len(page.Values), nil
+		}
+		return page.Values, -1, nil
+	})
+}
+
+// GetBoard retrieves a board by ID.
+func (c *JiraClient) GetBoard(ctx context.Context, id int64) (*Board, error) {
+	body, err := c.doAgileRequest(ctx, "GET", "/board/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var board Board
+	if err := json.Unmarshal(body, &board); err != nil {
+		return nil, fmt.Errorf("failed to parse board: %w", err)
+	}
+
+	return &board, nil
+}
+
+// CreateBoard creates a board of the given type in a project, showing the
+// issues matched by a filter.
+func (c *JiraClient) CreateBoard(ctx context.Context, name, boardType string, filterID int64, projectKey string) (*Board, error) {
+	req := createBoardRequest{
+		Name:     name,
+		Type:     boardType,
+		FilterID: filterID,
+		Location: createBoardLocation{Type: "project", ProjectKeyOrID: projectKey},
+	}
+	body, err := c.doAgileRequest(ctx, "POST", "/board", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var board Board
+	if err := json.Unmarshal(body, &board); err != nil {
+		return nil, fmt.Errorf("failed to parse created board: %w", err)
+	}
+
+	c.boardsMu.Lock()
+	delete(c.scrumProject, projectKey)
+	c.boardsMu.Unlock()
+
+	return &board, nil
+}
+
+// DeleteBoard deletes a board. Its filter is left in place.
+func (c *JiraClient) DeleteBoard(ctx context.Context, id int64) error {
+	_, err := c.doAgileRequest(ctx, "DELETE", "/board/"+strconv.FormatInt(id, 10), nil)
+	return err
+}
+
+// ProjectHasScrumBoard reports whether a project has at least one scrum
+// board. Results are cached per client since board types rarely change.
+func (c *JiraClient) ProjectHasScrumBoard(ctx context.Context, projectKey string) (bool, error) {
+	c.boardsMu.Lock()
+	hasScrum, ok := c.scrumProject[projectKey]
+	c.boardsMu.Unlock()
+	if ok {
+		return hasScrum, nil
+	}
+
+	boards, err := c.GetBoards(ctx, projectKey, BoardTypeScrum, "")
+	if err != nil {
+		return false, err
+	}
+
+	hasScrum = len(boards) > 0
+
+	c.boardsMu.Lock()
+	c.scrumProject[projectKey] = hasScrum
+	c.boardsMu.Unlock()
+
+	return hasScrum, nil
+}
+
+// CreateSprint creates a future sprint on a board. Name and OriginBoardID
+// are required; the other fields are optional.
+func (c *JiraClient) CreateSprint(ctx context.Context, sprint *Sprint) (*Sprint, error) {
+	body, err := c.doAgileRequest(ctx, "POST", "/sprint", sprint)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Sprint
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created sprint: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetSprint retrieves a sprint by ID.
+func (c *JiraClient) GetSprint(ctx context.Context, id int64) (*Sprint, error) {
+	body, err := c.doAgileRequest(ctx, "GET", "/sprint/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sprint Sprint
+	if err := json.Unmarshal(body, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to parse sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// UpdateSprint replaces a sprint's name, goal, state, and dates. Empty
+// fields are cleared, so callers pass the current state to keep it.
+func (c *JiraClient) UpdateSprint(ctx context.Context, id int64, sprint *Sprint) (*Sprint, error) {
+	req := sprintUpdateRequest{
+		Name:      sprint.Name,
+		Goal:      sprint.Goal,
+		State:     sprint.State,
+		StartDate: sprint.StartDate,
+		EndDate:   sprint.EndDate,
+	}
+	body, err := c.doAgileRequest(ctx, "PUT", "/sprint/"+strconv.FormatInt(id, 10), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Sprint
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse updated sprint: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteSprint deletes a sprint. Its open issues move to the backlog.
+func (c *JiraClient) DeleteSprint(ctx context.Context, id int64) error {
+	_, err := c.doAgileRequest(ctx, "DELETE", "/sprint/"+strconv.FormatInt(id, 10), nil)
+	return err
+}
+
+// GetSprintIssueKeys lists the keys of the issues in a sprint.
+func (c *JiraClient) GetSprintIssueKeys(ctx context.Context, id int64) ([]string, error) {
+	endpoint := "/sprint/" + strconv.FormatInt(id, 10) + "/issue?fields=key&startAt="
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]string, int, error) {
+		body, err := c.doAgileRequest(ctx, "GET", endpoint+strconv.Itoa(startAt), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page sprintIssuePage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse sprint issues: %w", err)
+		}
+
+		keys := make([]string, len(page.Issues))
+		for i, issue := range page.Issues {
+			keys[i] = issue.Key
+		}
+		return keys, page.Total, nil
+	})
+}
+
+// GetIssueSprint retrieves the active or future sprint an issue belongs to.
+// It returns nil without an error when the issue is not in an open sprint.
+func (c *JiraClient) GetIssueSprint(ctx context.Context, key string) (*Sprint, error) {
+	body, err := c.doAgileRequest(ctx, "GET", "/issue/"+key+"?fields=sprint", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Fields struct {
+			Sprint *Sprint `json:"sprint"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse agile issue: %w", err)
+	}
+
+	return result.Fields.Sprint, nil
+}
+
+// MoveIssuesToSprint moves issues into a sprint.
+func (c *JiraClient) MoveIssuesToSprint(ctx context.Context, sprintID int64, keys []string) error {
+	endpoint := "/sprint/" + strconv.FormatInt(sprintID, 10) + "/issue"
+	return c.moveIssues(ctx, endpoint, keys)
+}
+
+// MoveIssuesToBacklog removes issues from any sprint and moves them to the
+// board backlog.
+func (c *JiraClient) MoveIssuesToBacklog(ctx context.Context, keys []string) error {
+	return c.moveIssues(ctx, "/backlog/issue", keys)
+}
+
+// RankIssueBefore ranks an issue immediately before another one.
+func (c *JiraClient) RankIssueBefore(ctx context.Context, key, before string) error {
+	body, err := c.doAgileRequest(ctx, "PUT", "/issue/rank", rankIssuesRequest{Issues: []string{key}, RankBeforeIssue: before})
+	if err != nil {
+		return err
+	}
+
+	// A successful rank returns no content; a partial one lists failures.
+	if len(body) == 0 {
+		return nil
+	}
+	var result rankIssuesResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse rank result: %w", err)
+	}
+	for _, entry := range result.Entries {
+		if entry.Status >= 400 {
+			return fmt.Errorf("failed to rank %s before %s: %s", entry.IssueKey, before, strings.Join(entry.Errors, "; "))
+		}
+	}
+	return nil
+}
+
+// GetRankedIssueKeys returns the given issues ordered by their Agile rank.
+// Keys that don't exist (or aren't visible) are absent from the result;
+// callers compare keys to find them.
+func (c *JiraClient) GetRankedIssueKeys(ctx context.Context, keys []string) ([]string, error) {
+	// Each batch comes back in rank order; merging several batches needs
+	// the rank values themselves.
+	var rankField string
+	if len(keys) > keyBatchSize {
+		id, err := c.FieldIDByCustomType(ctx, RankFieldType)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			return nil, fmt.Errorf("ranking more than %d issues needs the Agile rank field, which this Jira instance doesn't have", keyBatchSize)
+		}
+		rankField = id
+	}
+
+	type rankedIssue struct {
+		key  string
+		rank string
+	}
+	var issues []rankedIssue
+
+	for start := 0; start < len(keys); start += keyBatchSize {
+		end := start + keyBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			quoted = append(quoted, QuoteJQL(key))
+		}
+
+		fields := []string{"key"}
+		if rankField != "" {
+			fields = append(fields, rankField)
+		}
+
+		// Unknown keys are JQL errors in strict mode, which would fail the
+		// whole search; downgrade them to warnings.
+		body := map[string]interface{}{
+			"jql":           "key in (" + strings.Join(quoted, ", ") + ") ORDER BY Rank ASC",
+			"maxResults":    end - start,
+			"fields":        fields,
+			"validateQuery": validateWarn,
+		}
+
+		var page struct {
+			Issues []struct {
+				Key    string                     `json:"key"`
+				Fields map[string]json.RawMessage `json:"fields"`
+			} `json:"issues"`
+		}
+		if err := c.doRequestJSON(ctx, "POST", "/search", body, &page); err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+			ranked := rankedIssue{key: issue.Key}
+			if rankField != "" {
+				if err := json.Unmarshal(issue.Fields[rankField], &ranked.rank); err != nil {
+					return nil, fmt.Errorf("failed to parse rank of %s: %w", issue.Key, err)
+				}
+			}
+			issues = append(issues, ranked)
+		}
+	}
+
+	if rankField != "" {
+		sort.SliceStable(issues, func(i, j int) bool { return issues[i].rank < issues[j].rank })
+	}
+
+	ranked := make([]string, len(issues))
+	for i, issue := range issues {
+		ranked[i] = issue.key
+	}
+	return ranked, nil
+}
+
+// moveIssues posts issue keys to an Agile move endpoint in batches.
+func (c *JiraClient) moveIssues(ctx context.Context, endpoint string, keys []string) error {
+	for start := 0; start < len(keys); start += agileMoveBatchSize {
+		end := start + agileMoveBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		if _, err := c.doAgileRequest(ctx, "POST", endpoint, moveIssuesRequest{Issues: keys[start:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Epic is an epic as the Agile API describes it. Color is the epic's
+// palette entry, color_1 through color_14.
+type Epic struct {
+	ID      int64      `json:"id"`
+	Key     string     `json:"key"`
+	Name    string     `json:"name,omitempty"`
+	Summary string     `json:"summary,omitempty"`
+	Done    bool       `json:"done"`
+	Color   *EpicColor `json:"color,omitempty"`
+}
+
+// EpicColor references an entry of the epic color palette.
+type EpicColor struct {
+	Key string `json:"key"`
+}
+
+// GetEpic retrieves an epic by key or ID.
+func (c *JiraClient) GetEpic(ctx context.Context, keyOrID string) (*Epic, error) {
+	body, err := c.doAgileRequest(ctx, "GET", "/epic/"+url.PathEscape(keyOrID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var epic Epic
+	if err := json.Unmarshal(body, &epic); err != nil {
+		return nil, fmt.Errorf("failed to parse epic: %w", err)
+	}
+
+	return &epic, nil
+}
+
+// SetEpicColor sets an epic's color to a palette entry, color_1 through
+// color_14. This is how company-managed projects color epics; team-managed
+// projects keep the color in an issue field instead.
+func (c *JiraClient) SetEpicColor(ctx context.Context, keyOrID, color string) error {
+	req := struct {
+		Color EpicColor `json:"color"`
+	}{Color: EpicColor{Key: color}}
+	_, err := c.doAgileRequest(ctx, "POST", "/epic/"+url.PathEscape(keyOrID), req)
+	return err
+}
@@ -0,0 +1,139 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+)
+
+// rankedKeysPattern extracts the keys of a "key in (...)" search.
+var rankedKeysPattern = regexp.MustCompile(`"([^"]+)"`)
+
+// newRankSearchServer serves key searches over issues ranked in the given
+// order, failing strict searches that name an unknown key as Jira does. It
+// returns the client and a pointer to the number of searches made.
+func newRankSearchServer(t *testing.T, order []string) (*JiraClient, *int) {
+	t.Helper()
+	rank := make(map[string]string, len(order))
+	for i, key := range order {
+		rank[key] = fmt.Sprintf("0|i%05d:", i)
+	}
+
+	searches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"customfield_10019","name":"Rank","custom":true,"schema":{"type":"any","custom":"` + RankFieldType + `"}}]`))
+		case "/rest/api/3/search":
+			searches++
+			var req struct {
+				JQL           string   `json:"jql"`
+				Fields        []string `json:"fields"`
+				ValidateQuery string   `json:"validateQuery"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+				return
+			}
+
+			var found []string
+			for _, m := range rankedKeysPattern.FindAllStringSubmatch(req.JQL, -1) {
+				if _, ok := rank[m[1]]; ok {
+					found = append(found, m[1])
+				} else if req.ValidateQuery != validateWarn {
+					w.WriteHeader(http.StatusBadRequest)
+					_, _ = fmt.Fprintf(w, `{"errorMessages":["An issue with key '%s' does not exist for field 'key'."]}`, m[1])
+					return
+				}
+			}
+			if len(found) > keyBatchSize {
+				t.Errorf("search for %d keys, want at most %d per batch", len(found), keyBatchSize)
+			}
+			sort.Slice(found, func(i, j int) bool { return rank[found[i]] < rank[found[j]] })
+
+			issues := make([]map[string]interface{}, len(found))
+			for i, key := range found {
+				fields := map[string]interface{}{}
+				for _, field := range req.Fields {
+					if field == "customfield_10019" {
+						fields[field] = rank[key]
+					}
+				}
+				issues[i] = map[string]interface{}{"key": key, "fields": fields}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": len(issues), "issues": issues})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+	return c, &searches
+}
+
+func TestGetRankedIssueKeysMissing(t *testing.T) {
+	c, searches := newRankSearchServer(t, []string{"PROJ-1", "PROJ-2", "PROJ-3"})
+
+	got, err := c.GetRankedIssueKeys(context.Background(), []string{"PROJ-3", "PROJ-9", "PROJ-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"PROJ-1", "PROJ-3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRankedIssueKeys() = %v, want %v", got, want)
+	}
+	if *searches != 1 {
+		t.Errorf("searches = %d, want 1", *searches)
+	}
+}
+
+func TestGetRankedIssueKeysBatches(t *testing.T) {
+	order := make([]string, 2*keyBatchSize+50)
+	for i := range order {
+		order[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+	c, searches := newRankSearchServer(t, order)
+
+	// Ask in reverse so every batch holds issues from across the ranking.
+	keys := make([]string, len(order))
+	for i, key := range order {
+		keys[len(keys)-1-i] = key
+	}
+
+	got, err := c.GetRankedIssueKeys(context.Background(), keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, order) {
+		t.Errorf("GetRankedIssueKeys() = %v, want %v", got, order)
+	}
+	if *searches != 3 {
+		t.Errorf("searches = %d, want 3", *searches)
+	}
+}
+
+func TestGetRankedIssueKeysEmpty(t *testing.T) {
+	c, searches := newRankSearchServer(t, nil)
+
+	got, err := c.GetRankedIssueKeys(context.Background(), nil)
+	if err != nil || len(got) != 0 {
+		t.Errorf("GetRankedIssueKeys(nil) = %v, %v, want no keys", got, err)
+	}
+	if *searches != 0 {
+		t.Errorf("searches = %d, want none", *searches)
+	}
+}
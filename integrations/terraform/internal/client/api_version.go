@@ -0,0 +1,115 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Jira platform REST API versions. Jira Cloud serves version 3, which
+// exchanges rich text as Atlassian Document Format; Jira Server and Data
+// Center only serve version 2, which exchanges it as wiki-markup strings.
+const (
+	APIVersionAuto = "auto"
+	APIVersion2    = "2"
+	APIVersion3    = "3"
+)
+
+// deploymentTypeCloud is the serverInfo deployment type of Jira Cloud.
+const deploymentTypeCloud = "Cloud"
+
+// serverInfo is the subset of /serverInfo the client uses.
+type serverInfo struct {
+	DeploymentType string `json:"deploymentType"`
+	Version        string `json:"version"`
+}
+
+// WithAPIVersion selects the platform REST API version. APIVersionAuto
+// picks version 3 for Jira Cloud hosts and otherwise leaves the choice to
+// DetectAPIVersion.
+func WithAPIVersion(version string) Option {
+	return func(c *JiraClient) {
+		if version == APIVersionAuto && isCloudSite(c.siteURL) {
+			version = APIVersion3
+		}
+		c.setAPIVersion(version)
+	}
+}
+
+// DetectAPIVersion resolves APIVersionAuto by asking the site for its
+// deployment type: Jira Cloud uses version 3, Server and Data Center
+// version 2. It does nothing once a version has been chosen.
+func (c *JiraClient) DetectAPIVersion(ctx context.Context) error {
+	if c.APIVersion != APIVersionAuto {
+		return nil
+	}
+
+	// serverInfo exists under version 2 on every deployment type.
+	var info serverInfo
+	if _, err := c.do(ctx, "GET", c.siteURL+"/rest/api/2/serverInfo", nil, &info); err != nil {
+		return fmt.Errorf("failed to detect the Jira API version: %w", err)
+	}
+
+	if info.DeploymentType == deploymentTypeCloud {
+		c.setAPIVersion(APIVersion3)
+	} else {
+		c.setAPIVersion(APIVersion2)
+	}
+	return nil
+}
+
+// UsesADF reports whether rich text is exchanged as Atlassian Document
+// Format rather than as plain strings.
+func (c *JiraClient) UsesADF() bool {
+	return c.APIVersion != APIVersion2
+}
+
+// FormatText converts plain text to the rich-text representation of the
+// client's API version: ADF on version 3, the text itself on version 2.
+func (c *JiraClient) FormatText(text string) interface{} {
+	if !c.UsesADF() {
+		return text
+	}
+	return TextToADF(text)
+}
+
+// FormatMarkdown converts Markdown to the rich-text representation of the
+// client's API version: ADF on version 3, wiki markup on version 2.
+func (c *JiraClient) FormatMarkdown(markdown string) interface{} {
+	if !c.UsesADF() {
+		return MarkdownToWiki(markdown)
+	}
+	return MarkdownToADF(markdown)
+}
+
+// UserRef returns a reference to a user for issue fields. Version 3
+// identifies users by account ID; version 2 by username.
+func (c *JiraClient) UserRef(id string) *User {
+	if !c.UsesADF() {
+		return &User{Name: id}
+	}
+	return &User{AccountID: id}
+}
+
+// setAPIVersion points the platform API base URL at a version.
+func (c *JiraClient) setAPIVersion(version string) {
+	c.APIVersion = version
+	if version == APIVersion2 {
+		c.BaseURL = c.siteURL + "/rest/api/2"
+	} else {
+		c.BaseURL = c.siteURL + "/rest/api/3"
+	}
+}
+
+// isCloudSite reports whether a normalized site URL is hosted on Jira Cloud.
+func isCloudSite(siteURL string) bool {
+	u, err := url.Parse(siteURL)
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(strings.ToLower(u.Hostname()), cloudHostSuffix)
+}
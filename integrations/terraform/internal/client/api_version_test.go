@@ -0,0 +1,269 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// recordedRequest is a request a mock Jira server received.
+type recordedRequest struct {
+	Method        string
+	Path          string
+	Authorization string
+	Body          map[string]interface{}
+}
+
+// newMockJira starts a server that answers serverInfo with deploymentType
+// and every other request with an empty object or responses[path], and
+// records the requests it receives.
+func newMockJira(t *testing.T, deploymentType string, responses map[string]string) (*httptest.Server, func() []recordedRequest) {
+	t.Helper()
+	var (
+		mu       sync.Mutex
+		requests []recordedRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		req := recordedRequest{Method: r.Method, Path: r.URL.Path, Authorization: r.Header.Get("Authorization")}
+		_ = json.Unmarshal(raw, &req.Body)
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/rest/api/2/serverInfo":
+			_, _ = w.Write([]byte(`{"deploymentType":"` + deploymentType + `","version":"9.12.0"}`))
+		case responses[r.URL.Path] != "":
+			_, _ = w.Write([]byte(responses[r.URL.Path]))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, func() []recordedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]recordedRequest(nil), requests...)
+	}
+}
+
+func TestDetectAPIVersion(t *testing.T) {
+	tests := []struct {
+		deploymentType string
+		want           string
+	}{
+		{"Cloud", APIVersion3},
+		{"Server", APIVersion2},
+		{"", APIVersion2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			server, requests := newMockJira(t, tt.deploymentType, nil)
+			c, err := NewJiraClient(server.URL, "user", "token", true, WithAPIVersion(APIVersionAuto))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.DetectAPIVersion(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			if c.APIVersion != tt.want {
+				t.Errorf("APIVersion = %q, want %q", c.APIVersion, tt.want)
+			}
+			if want := server.URL + "/rest/api/" + tt.want; c.BaseURL != want {
+				t.Errorf("BaseURL = %q, want %q", c.BaseURL, want)
+			}
+			if n := len(requests()); n != 1 {
+				t.Errorf("detection made %d requests, want 1", n)
+			}
+		})
+	}
+}
+
+func TestDetectAPIVersionSkipped(t *testing.T) {
+	server, requests := newMockJira(t, "Server", nil)
+	for _, version := range []string{APIVersion2, APIVersion3} {
+		c, err := NewJiraClient(server.URL, "user", "token", true, WithAPIVersion(version))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.DetectAPIVersion(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if c.APIVersion != version {
+			t.Errorf("APIVersion = %q, want %q", c.APIVersion, version)
+		}
+	}
+	if n := len(requests()); n != 0 {
+		t.Errorf("explicit versions made %d requests, want 0", n)
+	}
+
+	c, err := NewJiraClient("https://example.atlassian.net", "user", "token", false, WithAPIVersion(APIVersionAuto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.APIVersion != APIVersion3 {
+		t.Errorf("Cloud host APIVersion = %q, want %q", c.APIVersion, APIVersion3)
+	}
+}
+
+func TestAuthorizationHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{"basic with email", "user@example.com", "Basic dXNlckBleGFtcGxlLmNvbTp0b2tlbg=="},
+		{"bearer without email", "", "Bearer token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := newMockJira(t, "Server", nil)
+			c, err := NewJiraClient(server.URL, tt.email, "token", true, WithAPIVersion(APIVersion2))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.GetIssue(context.Background(), "PROJ-1"); err != nil {
+				t.Fatal(err)
+			}
+			if got := requests()[0].Authorization; got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIVersionVariants(t *testing.T) {
+	tests := []struct {
+		deploymentType  string
+		wantPath        string
+		wantDescription interface{}
+		wantAssignee    map[string]interface{}
+	}{
+		{
+			deploymentType: "Cloud",
+			wantPath:       "/rest/api/3/issue",
+			wantDescription: map[string]interface{}{
+				"type":    "doc",
+				"version": float64(1),
+				"content": []interface{}{map[string]interface{}{
+					"type":    "paragraph",
+					"content": []interface{}{map[string]interface{}{"type": "text", "text": "Bold", "marks": []interface{}{map[string]interface{}{"type": "strong"}}}},
+				}},
+			},
+			wantAssignee: map[string]interface{}{"accountId": "jdoe"},
+		},
+		{
+			deploymentType:  "Server",
+			wantPath:        "/rest/api/2/issue",
+			wantDescription: "*Bold*",
+			wantAssignee:    map[string]interface{}{"name": "jdoe"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.deploymentType, func(t *testing.T) {
+			server, requests := newMockJira(t, tt.deploymentType, map[string]string{
+				tt.wantPath: `{"id":"10001","key":"PROJ-1"}`,
+			})
+			c, err := NewJiraClient(server.URL, "user", "token", true, WithAPIVersion(APIVersionAuto))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.DetectAPIVersion(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			issue, err := c.CreateIssue(context.Background(), &CreateIssueRequest{Fields: IssueFields{
+				Summary:     "Variant",
+				Description: c.FormatMarkdown("**Bold**"),
+				Assignee:    c.UserRef("jdoe"),
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if issue.Key != "PROJ-1" {
+				t.Errorf("created key = %q, want PROJ-1", issue.Key)
+			}
+
+			got := requests()
+			create := got[len(got)-1]
+			if create.Method != "POST" || create.Path != tt.wantPath {
+				t.Fatalf("create request = %s %s, want POST %s", create.Method, create.Path, tt.wantPath)
+			}
+			fields, _ := create.Body["fields"].(map[string]interface{})
+			if !jsonEqual(t, fields["description"], tt.wantDescription) {
+				t.Errorf("description = %#v, want %#v", fields["description"], tt.wantDescription)
+			}
+			if !jsonEqual(t, fields["assignee"], tt.wantAssignee) {
+				t.Errorf("assignee = %#v, want %#v", fields["assignee"], tt.wantAssignee)
+			}
+		})
+	}
+}
+
+func TestFormatTextVariants(t *testing.T) {
+	v2 := &JiraClient{APIVersion: APIVersion2}
+	if got := v2.FormatText("h1. Kept"); got != "h1. Kept" {
+		t.Errorf("version 2 FormatText = %#v, want the text unchanged", got)
+	}
+	v3 := &JiraClient{APIVersion: APIVersion3}
+	if _, ok := v3.FormatText("text").(map[string]interface{}); !ok {
+		t.Errorf("version 3 FormatText = %#v, want an ADF document", v3.FormatText("text"))
+	}
+}
+
+func TestMarkdownToWiki(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"paragraph", "Plain text", "Plain text"},
+		{"heading", "## Steps", "h2. Steps"},
+		{"marks", "**bold**, *em*, `code` and [docs](https://example.com)", "*bold*, _em_, {{code}} and [docs|https://example.com]"},
+		{"bullets", "- one\n- two", "* one\n* two"},
+		{"nested", "1. first\n   - detail\n2. second", "# first\n#* detail\n# second"},
+		{"code", "```go\nfmt.Println(1)\n```", "{code:go}\nfmt.Println(1)\n{code}"},
+		{"quote", "> quoted", "{quote}\nquoted\n{quote}"},
+		{"rule", "above\n\n---\n\nbelow", "above\n\n----\n\nbelow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MarkdownToWiki(tt.markdown); got != tt.want {
+				t.Errorf("MarkdownToWiki(%q) = %q, want %q", tt.markdown, got, tt.want)
+			}
+		})
+	}
+
+	if got := ADFToWiki("already wiki"); got != "already wiki" {
+		t.Errorf("ADFToWiki(string) = %q, want it unchanged", got)
+	}
+}
+
+// jsonEqual reports whether two values encode to the same JSON.
+func jsonEqual(t *testing.T, a, b interface{}) bool {
+	t.Helper()
+	rawA, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawB, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(rawA) == string(rawB)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+)
+
+// Attachment is a file attached to a Jira issue.
+type Attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType,omitempty"`
+	Content  string `json:"content,omitempty"`
+	Created  string `json:"created,omitempty"`
+	Author   *User  `json:"author,omitempty"`
+}
+
+// multipartBody is a prepared multipart/form-data request body. send posts
+// it as is instead of encoding it as JSON.
+type multipartBody struct {
+	contentType string
+	data        []byte
+}
+
+// newMultipartFile builds a multipart/form-data body holding a single file
+// in the given form field.
+func newMultipartFile(field, filename string, content []byte) (*multipartBody, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	part, err := writer.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build upload of %s: %w", filename, err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to build upload of %s: %w", filename, err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build upload of %s: %w", filename, err)
+	}
+
+	return &multipartBody{contentType: writer.FormDataContentType(), data: buf.Bytes()}, nil
+}
+
+// doUpload posts a file to the Jira platform REST API as multipart/form-data.
+// It goes through the same limiter, retries, and metrics as JSON requests.
+func (c *JiraClient) doUpload(ctx context.Context, endpoint, field, filename string, content []byte) ([]byte, error) {
+	body, err := newMultipartFile(field, filename, content)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, "POST", c.BaseURL+endpoint, body, nil)
+}
+
+// attachmentDownload is the body of a request for an attachment's content.
+// Nothing is sent, and any content type is accepted, since the response is
+// the file rather than JSON.
+type attachmentDownload struct{}
+
+// AddAttachment uploads a file to an issue.
+func (c *JiraClient) AddAttachment(ctx context.Context, issueKey, filename string, content []byte) (*Attachment, error) {
+	respBody, err := c.doUpload(ctx, "/issue/"+issueKey+"/attachments", "file", filename, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Jira answers with the list of attachments created by the request.
+	var attachments []Attachment
+	if err := json.Unmarshal(respBody, &attachments); err != nil {
+		return nil, fmt.Errorf("failed to parse attachments: %w", err)
+	}
+	if len(attachments) == 0 {
+		return nil, fmt.Errorf("no attachment was created for %s on %s", filename, issueKey)
+	}
+
+	return &attachments[0], nil
+}
+
+// GetAttachment retrieves the metadata of an attachment.
+func (c *JiraClient) GetAttachment(ctx context.Context, id string) (*Attachment, error) {
+	var attachment Attachment
+	if err := c.doRequestJSON(ctx, "GET", "/attachment/"+id, nil, &attachment); err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// DownloadAttachment returns the content of an attachment. Jira would
+// otherwise redirect to a media host that doesn't take the client's
+// credentials, so the content is requested directly. Like every response it
+// is bounded by MaxResponseBytes.
+func (c *JiraClient) DownloadAttachment(ctx context.Context, id string) ([]byte, error) {
+	return c.do(ctx, "GET", c.BaseURL+"/attachment/content/"+id+"?redirect=false", attachmentDownload{}, nil)
+}
+
+// DeleteAttachment deletes an attachment.
+func (c *JiraClient) DeleteAttachment(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/attachment/"+id, nil)
+	return err
+}
@@ -0,0 +1,130 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// bulkTaskPollInterval is how often MoveIssues checks on a bulk move.
+const bulkTaskPollInterval = 2 * time.Second
+
+// Terminal states of a bulk operation task.
+const (
+	bulkTaskComplete  = "COMPLETE"
+	bulkTaskFailed    = "FAILED"
+	bulkTaskCancelled = "CANCELLED"
+	bulkTaskDead      = "DEAD"
+)
+
+// bulkMoveRequest is the request body for moving issues between projects.
+// Targets are keyed by "projectKeyOrId,issueTypeId".
+type bulkMoveRequest struct {
+	SendBulkNotification   bool                    `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]bulkMoveSpec `json:"targetToSourcesMapping"`
+}
+
+// bulkMoveSpec lists the issues moved to one target. Jira fills in field
+// values, statuses and subtask types the target needs from its defaults.
+type bulkMoveSpec struct {
+	IssueIdsOrKeys          []string `json:"issueIdsOrKeys"`
+	InferFieldDefaults      bool     `json:"inferFieldDefaults"`
+	InferStatusDefaults     bool     `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault bool     `json:"inferSubtaskTypeDefault"`
+}
+
+// bulkTask is the progress of a bulk operation.
+type bulkTask struct {
+	TaskID                          string              `json:"taskId"`
+	Status                          string              `json:"status"`
+	ProgressPercent                 int                 `json:"progressPercent"`
+	FailedAccessibleIssues          map[string][]string `json:"failedAccessibleIssues"`
+	InvalidOrInaccessibleIssueCount int                 `json:"invalidOrInaccessibleIssueCount"`
+}
+
+// BulkMoveError is returned when a bulk move doesn't move every issue. It
+// carries Jira's reasons per issue ID, such as field mapping conflicts.
+type BulkMoveError struct {
+	Status       string
+	Failures     map[string][]string
+	Inaccessible int
+}
+
+func (e *BulkMoveError) Error() string {
+	ids := make([]string, 0, len(e.Failures))
+	for id := range e.Failures {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	parts := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		parts = append(parts, fmt.Sprintf("issue %s: %s", id, strings.Join(e.Failures[id], "; ")))
+	}
+	if e.Inaccessible > 0 {
+		parts = append(parts, fmt.Sprintf("%d issue(s) were invalid or not accessible", e.Inaccessible))
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("bulk move ended with status %s", e.Status)
+	}
+	return fmt.Sprintf("bulk move ended with status %s: %s", e.Status, strings.Join(parts, "\n"))
+}
+
+// MoveIssues moves issues to another project and issue type with the bulk
+// move API, without notifying watchers, and waits for the move to finish.
+// Moved issues keep their IDs but get new keys.
+func (c *JiraClient) MoveIssues(ctx context.Context, keys []string, projectKey, issueTypeID string) error {
+	req := bulkMoveRequest{
+		TargetToSourcesMapping: map[string]bulkMoveSpec{
+			projectKey + "," + issueTypeID: {
+				IssueIdsOrKeys:          keys,
+				InferFieldDefaults:      true,
+				InferStatusDefaults:     true,
+				InferSubtaskTypeDefault: true,
+			},
+		},
+	}
+	body, err := c.doRequest(ctx, "POST", "/bulk/issues/move", req)
+	if err != nil {
+		return err
+	}
+
+	var task bulkTask
+	if err := json.Unmarshal(body, &task); err != nil {
+		return fmt.Errorf("failed to parse bulk move task: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("bulk move %s did not finish: %w", task.TaskID, ctx.Err())
+		case <-time.After(bulkTaskPollInterval):
+		}
+
+		body, err := c.doRequest(ctx, "GET", "/bulk/queue/"+task.TaskID, nil)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(body, &task); err != nil {
+			return fmt.Errorf("failed to parse bulk move progress: %w", err)
+		}
+
+		switch task.Status {
+		case bulkTaskComplete, bulkTaskFailed, bulkTaskCancelled, bulkTaskDead:
+			if task.Status == bulkTaskComplete && len(task.FailedAccessibleIssues) == 0 && task.InvalidOrInaccessibleIssueCount == 0 {
+				return nil
+			}
+			return &BulkMoveError{
+				Status:       task.Status,
+				Failures:     task.FailedAccessibleIssues,
+				Inaccessible: task.InvalidOrInaccessibleIssueCount,
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// jiraTimeLayout is the timestamp format Jira uses in REST responses, e.g.
+// 2024-01-15T10:30:00.000+0000.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// changelogPageSize is the page size requested from the changelog endpoint.
+const changelogPageSize = 100
+
+// ChangelogEntry is one edit to an issue, possibly touching several fields.
+type ChangelogEntry struct {
+	ID      string          `json:"id"`
+	Author  *User           `json:"author,omitempty"`
+	Created string          `json:"created"`
+	Items   []ChangelogItem `json:"items"`
+}
+
+// ChangelogItem is a single field change within a changelog entry.
+type ChangelogItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype,omitempty"`
+	FromString string `json:"fromString,omitempty"`
+	ToString   string `json:"toString,omitempty"`
+}
+
+// GetChangelog retrieves the full change history of an issue, oldest first.
+func (c *JiraClient) GetChangelog(ctx context.Context, issueKey string) ([]ChangelogEntry, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]ChangelogEntry, int, error) {
+		query := url.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		query.Set("maxResults", strconv.Itoa(changelogPageSize))
+
+		body, err := c.doRequest(ctx, "GET", "/issue/"+issueKey+"/changelog?"+query.Encode(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total  int              `json:"total"`
+			Values []ChangelogEntry `json:"values"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse changelog: %w", err)
+		}
+
+		return page.Values, page.Total, nil
+	})
+}
+
+// FormatTime formats a timestamp the way Jira expects it in request bodies,
+// such as a worklog's started time.
+func FormatTime(t time.Time) string {
+	return t.Format(jiraTimeLayout)
+}
+
+// ParseTime parses a timestamp from a Jira response. Jira normally uses
+// millisecond precision with a numeric zone offset, but RFC 3339 is accepted
+// too.
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(jiraTimeLayout, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unrecognized Jira timestamp %q", value)
+	}
+	return t, nil
+}
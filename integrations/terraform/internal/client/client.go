@@ -5,29 +5,108 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // JiraClient is the HTTP client for Jira API.
 type JiraClient struct {
 	BaseURL    string
+	AgileURL   string
 	Email      string
 	APIToken   string
 	HTTPClient *http.Client
+
+	// PaginationLimit caps how many results any list operation pages
+	// through. Zero means DefaultPaginationLimit.
+	PaginationLimit int
+
+	// APIVersion is the platform REST API version in use (APIVersion2 or
+	// APIVersion3), or APIVersionAuto until DetectAPIVersion resolves it.
+	APIVersion string
+
+	// StoryPointsField, when set, is the story points field used for every
+	// project instead of discovering it per project.
+	StoryPointsField string
+
+	// StartDateField, when set, is the start date field used instead of
+	// looking up the field named StartDateFieldName.
+	StartDateField string
+
+	// MaxResponseBytes caps the decompressed size of any response body.
+	// Zero means DefaultMaxResponseBytes.
+	MaxResponseBytes int64
+
+	// Metrics, when set, records per-endpoint request statistics.
+	Metrics *Metrics
+
+	// Limiter paces every request the client makes. Nil means unlimited.
+	Limiter *RateLimiter
+
+	// Retry controls how rate-limited and failed requests are retried.
+	Retry RetryPolicy
+
+	// siteURL is the normalized site root the API base URLs are built on.
+	siteURL string
+
+	// tracer, when set through WithTracerProvider, traces every request.
+	tracer trace.Tracer
+
+	// tokenSource, when set through WithTokenSource, supplies APIToken on
+	// the first request.
+	tokenSource  TokenSource
+	tokenMu      sync.Mutex
+	tokenFetched bool
+	tokenErr     error
+
+	boardsMu     sync.Mutex
+	scrumProject map[string]bool
+
+	fieldsMu sync.Mutex
+	fields   []Field
+
+	createMetaMu sync.Mutex
+	createMeta   map[string][]CreateMetaField
+
+	editMetaMu sync.Mutex
+	editMeta   map[string]map[string]EditMetaField
+
+	storyPointsMu sync.Mutex
+	storyPoints   map[string]string
+
+	epicFieldsMu sync.Mutex
+	epicFields   map[string]*EpicFields
+
+	rolesMu sync.Mutex
+	roleIDs map[string]int64
+
+	linkTypesMu sync.Mutex
+	linkTypes   []IssueLinkType
+
+	prioritiesMu sync.Mutex
+	priorities   []Priority
+
+	projectMetadataMu sync.Mutex
+	projectMetadata   map[string]projectLookup
 }
 
 // Issue represents a Jira issue.
 type Issue struct {
-	ID          string                 `json:"id,omitempty"`
-	Key         string                 `json:"key,omitempty"`
-	Self        string                 `json:"self,omitempty"`
-	Fields      IssueFields            `json:"fields"`
-	Transitions []Transition           `json:"transitions,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	Key         string       `json:"key,omitempty"`
+	Self        string       `json:"self,omitempty"`
+	Fields      IssueFields  `json:"fields"`
+	Transitions []Transition `json:"transitions,omitempty"`
 }
 
 // IssueFields contains the fields of a Jira issue.
@@ -41,48 +120,146 @@ type IssueFields struct {
 	Parent      *Parent     `json:"parent,omitempty"`
 	Assignee    *User       `json:"assignee,omitempty"`
 	Reporter    *User       `json:"reporter,omitempty"`
+	Creator     *User       `json:"creator,omitempty"`
 	Labels      []string    `json:"labels,omitempty"`
-	// Custom fields can be added as needed
+	DueDate     string      `json:"duedate,omitempty"`
+	FixVersions []Version   `json:"fixVersions,omitempty"`
+	Versions    []Version   `json:"versions,omitempty"`
+
+	TimeTracking *TimeTracking `json:"timetracking,omitempty"`
+
+	// Attachments is only populated in responses.
+	Attachments []Attachment `json:"attachment,omitempty"`
+
+	// Custom holds custom field values (customfield_*) as raw JSON.
+	Custom map[string]json.RawMessage `json:"-"`
+
+	// Clear lists fields sent as an explicit null, e.g. "assignee" to
+	// unassign an issue. Omitted fields are left unchanged by updates.
+	Clear []string `json:"-"`
+}
+
+// TimeTracking holds an issue's estimates as Jira durations (e.g., "2d 4h").
+// The seconds are only populated in responses.
+type TimeTracking struct {
+	OriginalEstimate         string `json:"originalEstimate,omitempty"`
+	RemainingEstimate        string `json:"remainingEstimate,omitempty"`
+	OriginalEstimateSeconds  int64  `json:"originalEstimateSeconds,omitempty"`
+	RemainingEstimateSeconds int64  `json:"remainingEstimateSeconds,omitempty"`
 }
 
-// Project represents a Jira project.
+// Project represents a Jira project. Description, Lead, ProjectTypeKey,
+// Style and IssueTypes are only populated in responses.
 type Project struct {
-	ID   string `json:"id,omitempty"`
-	Key  string `json:"key,omitempty"`
-	Name string `json:"name,omitempty"`
-	Self string `json:"self,omitempty"`
+	ID             string      `json:"id,omitempty"`
+	Key            string      `json:"key,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Lead           *User       `json:"lead,omitempty"`
+	ProjectTypeKey string      `json:"projectTypeKey,omitempty"`
+	Style          string      `json:"style,omitempty"`
+	IssueTypes     []IssueType `json:"issueTypes,omitempty"`
+	Self           string      `json:"self,omitempty"`
 }
 
-// IssueType represents a Jira issue type.
+// Project styles: company-managed projects are "classic" and team-managed
+// projects "next-gen".
+const (
+	ProjectStyleCompanyManaged = "classic"
+	ProjectStyleTeamManaged    = "next-gen"
+)
+
+// IssueType represents a Jira issue type. IconURL, Subtask and
+// HierarchyLevel are only populated in responses.
 type IssueType struct {
-	ID   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
-	Self string `json:"self,omitempty"`
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	IconURL        string `json:"iconUrl,omitempty"`
+	Subtask        bool   `json:"subtask,omitempty"`
+	HierarchyLevel int    `json:"hierarchyLevel,omitempty"`
+	Self           string `json:"self,omitempty"`
+}
+
+// IsID reports whether value is a numeric Jira ID rather than a name, for
+// attributes that accept either.
+func IsID(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// IssueTypeRef references an issue type by ID when nameOrID is numeric and
+// by name otherwise.
+func IssueTypeRef(nameOrID string) *IssueType {
+	if IsID(nameOrID) {
+		return &IssueType{ID: nameOrID}
+	}
+	return &IssueType{Name: nameOrID}
 }
 
 // Status represents a Jira status.
 type Status struct {
-	ID   string `json:"id,omitempty"`
+	ID             string          `json:"id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	Self           string          `json:"self,omitempty"`
+	StatusCategory *StatusCategory `json:"statusCategory,omitempty"`
+}
+
+// StatusCategory is the category of an issue's status as reported on the
+// issue (keys are "new", "indeterminate", and "done").
+type StatusCategory struct {
+	ID   int64  `json:"id,omitempty"`
+	Key  string `json:"key,omitempty"`
 	Name string `json:"name,omitempty"`
-	Self string `json:"self,omitempty"`
 }
 
-// Priority represents a Jira priority.
+// Priority represents a Jira priority. Description, IconURL, StatusColor
+// and IsDefault are only populated in responses and are never sent when
+// writing an issue.
 type Priority struct {
-	ID   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
-	Self string `json:"self,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Self        string `json:"self,omitempty"`
+	Description string `json:"description,omitempty"`
+	IconURL     string `json:"iconUrl,omitempty"`
+	StatusColor string `json:"statusColor,omitempty"`
+	IsDefault   bool   `json:"isDefault,omitempty"`
+}
+
+// PriorityRef references a priority by ID when nameOrID is numeric and by
+// name otherwise, since priority names aren't unique across priority schemes.
+func PriorityRef(nameOrID string) *Priority {
+	if IsID(nameOrID) {
+		return &Priority{ID: nameOrID}
+	}
+	return &Priority{Name: nameOrID}
 }
 
 // Parent represents a parent issue (for subtasks).
 type Parent struct {
-	ID  string `json:"id,omitempty"`
-	Key string `json:"key,omitempty"`
+	ID     string        `json:"id,omitempty"`
+	Key    string        `json:"key,omitempty"`
+	Fields *ParentFields `json:"fields,omitempty"`
+}
+
+// ParentFields holds the subset of parent fields Jira embeds in a child
+// issue. They are only populated in responses.
+type ParentFields struct {
+	Summary string  `json:"summary,omitempty"`
+	Status  *Status `json:"status,omitempty"`
 }
 
 // User represents a Jira user.
 type User struct {
 	AccountID    string `json:"accountId,omitempty"`
+	Name         string `json:"name,omitempty"`
 	DisplayName  string `json:"displayName,omitempty"`
 	EmailAddress string `json:"emailAddress,omitempty"`
 	Self         string `json:"self,omitempty"`
@@ -100,9 +277,11 @@ type CreateIssueRequest struct {
 	Fields IssueFields `json:"fields"`
 }
 
-// UpdateIssueRequest is the request body for updating an issue.
+// UpdateIssueRequest is the request body for updating an issue. Fields
+// overwrites values; Update applies verb operations (see UpdateVerbs).
 type UpdateIssueRequest struct {
 	Fields IssueFields `json:"fields"`
+	Update UpdateVerbs `json:"update,omitempty"`
 }
 
 // TransitionRequest is the request body for transitioning an issue.
@@ -138,71 +317,284 @@ func (e *ErrorResponse) Error() string {
 	return strings.Join(parts, "; ")
 }
 
-// NewJiraClient creates a new Jira API client.
-func NewJiraClient(baseURL, email, apiToken string) (*JiraClient, error) {
-	// Normalize URL
-	baseURL = strings.TrimSuffix(baseURL, "/")
-	if !strings.HasSuffix(baseURL, "/rest/api/3") {
-		baseURL = baseURL + "/rest/api/3"
+// NewJiraClient creates a new Jira API client. The URL is normalized with
+// NormalizeURL; an unusable URL returns a *URLError.
+func NewJiraClient(baseURL, email, apiToken string, allowHTTP bool, opts ...Option) (*JiraClient, error) {
+	siteURL, _, err := NormalizeURL(baseURL, allowHTTP)
+	if err != nil {
+		return nil, err
 	}
 
-	return &JiraClient{
-		BaseURL:  baseURL,
-		Email:    email,
-		APIToken: apiToken,
+	c := &JiraClient{
+		BaseURL:    siteURL + "/rest/api/3",
+		AgileURL:   siteURL + "/rest/agile/1.0",
+		Email:      email,
+		APIToken:   apiToken,
+		APIVersion: APIVersion3,
+		siteURL:    siteURL,
 		HTTPClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout: DefaultHTTPTimeout,
 		},
-	}, nil
+		PaginationLimit: DefaultPaginationLimit,
+		Limiter:         NewRateLimiter(DefaultRequestsPerSecond),
+		Retry:           DefaultRetryPolicy(),
+		scrumProject:    make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// BrowseURL returns the web URL of an issue.
+func (c *JiraClient) BrowseURL(key string) string {
+	return c.siteURL + "/browse/" + key
+}
+
+// doRequest performs an HTTP request to the Jira platform REST API.
+func (c *JiraClient) doRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	return c.do(ctx, method, c.BaseURL+endpoint, body, nil)
+}
+
+// doRequestJSON performs an HTTP request to the Jira platform REST API and
+// decodes the response into out as it streams in, so large responses such
+// as searches are never buffered whole.
+func (c *JiraClient) doRequestJSON(ctx context.Context, method, endpoint string, body, out interface{}) error {
+	_, err := c.do(ctx, method, c.BaseURL+endpoint, body, out)
+	return err
 }
 
-// doRequest performs an HTTP request to the Jira API.
-func (c *JiraClient) doRequest(method, endpoint string, body interface{}) ([]byte, error) {
-	url := c.BaseURL + endpoint
+// doAgileRequest performs an HTTP request to the Jira Software (Agile) REST API.
+func (c *JiraClient) doAgileRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	return c.do(ctx, method, c.AgileURL+endpoint, body, nil)
+}
+
+// do performs an HTTP request against an absolute Jira URL, retrying rate
+// limits and transient failures according to the client's RetryPolicy.
+// Cancelling ctx abandons the request, including any wait for the rate
+// limiter or a retry. When out is non-nil a successful response is decoded
+// into it and no body is returned.
+func (c *JiraClient) do(ctx context.Context, method, url string, body, out interface{}) ([]byte, error) {
+	span := c.startSpan(ctx, method, url)
+
+	var (
+		respBody []byte
+		status   int
+		err      error
+		retries  int
+	)
+	for attempt := 1; ; attempt++ {
+		respBody, status, err = c.send(ctx, span, method, url, body, out)
+		if err == nil || attempt >= c.Retry.MaxAttempts || !shouldRetry(ctx, method, err) {
+			break
+		}
+
+		delay, ok := c.Retry.retryDelay(attempt, err)
+		if !ok {
+			break
+		}
+
+		retries++
+		c.Metrics.recordRetry(method, requestPath(url))
+		addSpanEvent(span, "retry",
+			attribute.Int("attempt", attempt+1),
+			attribute.Int64("wait_ms", delay.Milliseconds()),
+		)
+
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			err = sleepErr
+			break
+		}
+	}
+
+	if span != nil {
+		span.SetAttributes(attribute.Int("jira.retry_count", retries))
+	}
+	finishSpan(span, status, err)
+	return respBody, err
+}
 
+// send makes a single request, returning the response body and status code
+// (zero when no response arrived). Successful responses are decoded into out
+// instead when it is non-nil.
+func (c *JiraClient) send(ctx context.Context, span trace.Span, method, url string, body, out interface{}) ([]byte, int, error) {
 	var reqBody io.Reader
-	if body != nil {
+	// Some Jira Server and Data Center instances decode bodies without a
+	// declared charset as ISO-8859-1, garbling anything outside ASCII.
+	contentType := "application/json; charset=utf-8"
+	accept := "application/json"
+	upload, isUpload := body.(*multipartBody)
+	_, isDownload := body.(attachmentDownload)
+	switch {
+	case isDownload:
+		accept = "*/*"
+	case isUpload:
+		// Read the prepared body afresh, so retries send all of it.
+		reqBody = bytes.NewReader(upload.data)
+		contentType = upload.contentType
+	case body != nil:
 		jsonBytes, err := json.Marshal(body)
 		if err != nil {
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, 0, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBytes)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.SetBasicAuth(c.Email, c.APIToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	priority := methodPriority(method)
+	waited, err := c.Limiter.wait(ctx, priority)
+	if err != nil {
+		return nil, 0, err
+	}
+	c.Metrics.recordLimiterWait(priority, waited)
+	if waited.delay > 0 {
+		addSpanEvent(span, "rate_limiter.wait", attribute.Int64("wait_ms", waited.delay.Milliseconds()))
+	}
 
+	token, err := c.apiToken(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Without an email the token is a Server or Data Center personal access
+	// token, which is sent as a bearer token rather than a password.
+	if c.Email == "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.SetBasicAuth(c.Email, token)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", accept)
+	if isUpload {
+		// Jira rejects multipart requests without this XSRF opt-out.
+		req.Header.Set("X-Atlassian-Token", "no-check")
+	}
+	// Setting Accept-Encoding ourselves turns off the transport's transparent
+	// decompression, so gzip stays on regardless of how the transport is
+	// configured and responseBody decodes it.
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	start := time.Now()
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		c.Metrics.recordRequest(method, req.URL.Path, 0, time.Since(start))
+		// Report cancellation as such rather than as a failed request.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, 0, ctxErr
+		}
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	defer func() {
+		c.Metrics.recordRequest(method, req.URL.Path, resp.StatusCode, time.Since(start))
+	}()
+
+	if status, ok := parseRateLimitHeaders(resp.Header); ok {
+		c.observeRateLimit(ctx, span, method, req.URL.Path, status)
+	}
 
-	respBody, err := io.ReadAll(resp.Body)
+	reader, err := c.responseBody(resp, method, req.URL.Path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, resp.StatusCode, err
+	}
+
+	if out != nil && resp.StatusCode < 400 {
+		if err := json.NewDecoder(reader).Decode(out); err != nil {
+			var tooLarge *ResponseTooLargeError
+			if errors.As(err, &tooLarge) {
+				return nil, resp.StatusCode, err
+			}
+			return nil, resp.StatusCode, fmt.Errorf("failed to parse response from %s %s: %w", method, req.URL.Path, err)
+		}
+		return nil, resp.StatusCode, nil
+	}
+
+	respBody, err := io.ReadAll(reader)
+	if err != nil {
+		var tooLarge *ResponseTooLargeError
+		if errors.As(err, &tooLarge) {
+			return nil, resp.StatusCode, err
+		}
+		return nil, resp.StatusCode, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		addSpanEvent(span, "throttled", attribute.String("retry_after", resp.Header.Get("Retry-After")))
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && isScopeMismatch(respBody) {
+		return nil, resp.StatusCode, &ScopeError{Method: method, Endpoint: req.URL.Path}
 	}
 
 	if resp.StatusCode >= 400 {
 		var errResp ErrorResponse
+		apiErr := &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    errorBodyMessage(resp.Header.Get("Content-Type"), respBody),
+			Body:       respBody,
+			Method:     method,
+			Endpoint:   req.URL.Path,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
 		if json.Unmarshal(respBody, &errResp) == nil && (len(errResp.ErrorMessages) > 0 || len(errResp.Errors) > 0) {
-			return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, errResp.Error())
+			apiErr.Message = errResp.Error()
+			apiErr.Response = &errResp
 		}
-		return nil, fmt.Errorf("API error (%d): %s", resp.StatusCode, string(respBody))
+		return nil, resp.StatusCode, apiErr
 	}
 
-	return respBody, nil
+	return respBody, resp.StatusCode, nil
+}
+
+// GetIssue retrieves an issue by key or ID. The returned issue carries its
+// current key, which differs from a key that Jira redirected: one from
+// before the issue was moved to another project or its project key changed.
+func (c *JiraClient) GetIssue(ctx context.Context, key string) (*Issue, error) {
+	var issue Issue
+	if err := c.doRequestJSON(ctx, "GET", "/issue/"+key, nil, &issue); err != nil {
+		return nil, err
+	}
+
+	return &issue, nil
 }
 
-// GetIssue retrieves an issue by key.
-func (c *JiraClient) GetIssue(key string) (*Issue, error) {
-	body, err := c.doRequest("GET", "/issue/"+key, nil)
+// WaitForIssue retrieves an issue, polling while Jira reports it missing
+// until it appears or timeout elapses. Polls back off the same way request
+// retries do. When the issue never appears, the returned error still
+// satisfies IsNotFound.
+func (c *JiraClient) WaitForIssue(ctx context.Context, key string, timeout time.Duration) (*Issue, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for poll := 1; ; poll++ {
+		issue, err := c.GetIssue(waitCtx, key)
+		if err == nil || !IsNotFound(err) {
+			if err != nil && ctx.Err() == nil && waitCtx.Err() != nil {
+				return nil, fmt.Errorf("issue %s did not appear within %s: %w", key, timeout, waitCtx.Err())
+			}
+			return issue, err
+		}
+
+		delay, _ := c.Retry.retryDelay(poll, err)
+		if delay <= 0 {
+			delay = retryBaseDelay
+		}
+		if sleepErr := sleep(waitCtx, delay); sleepErr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("issue %s did not appear within %s: %w", key, timeout, err)
+		}
+	}
+}
+
+// GetIssueStatus retrieves only the status of an issue, for cheap polling.
+func (c *JiraClient) GetIssueStatus(ctx context.Context, key string) (*Status, error) {
+	body, err := c.doRequest(ctx, "GET", "/issue/"+key+"?fields=status", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -212,12 +604,15 @@ func (c *JiraClient) GetIssue(key string) (*Issue, error) {
 		return nil, fmt.Errorf("failed to parse issue: %w", err)
 	}
 
-	return &issue, nil
+	if issue.Fields.Status == nil {
+		return nil, fmt.Errorf("issue %s has no status", key)
+	}
+	return issue.Fields.Status, nil
 }
 
 // CreateIssue creates a new issue.
-func (c *JiraClient) CreateIssue(req *CreateIssueRequest) (*Issue, error) {
-	body, err := c.doRequest("POST", "/issue", req)
+func (c *JiraClient) CreateIssue(ctx context.Context, req *CreateIssueRequest) (*Issue, error) {
+	body, err := c.doRequest(ctx, "POST", "/issue", req)
 	if err != nil {
 		return nil, err
 	}
@@ -231,20 +626,39 @@ func (c *JiraClient) CreateIssue(req *CreateIssueRequest) (*Issue, error) {
 }
 
 // UpdateIssue updates an existing issue.
-func (c *JiraClient) UpdateIssue(key string, req *UpdateIssueRequest) error {
-	_, err := c.doRequest("PUT", "/issue/"+key, req)
+func (c *JiraClient) UpdateIssue(ctx context.Context, key string, req *UpdateIssueRequest) error {
+	_, err := c.doRequest(ctx, "PUT", "/issue/"+key, req)
+	return err
+}
+
+// UpdateIssueSilently updates an issue without emailing watchers or the
+// assignee. Jira only honours this for accounts with the Administer Jira
+// global permission or administer rights on the project.
+func (c *JiraClient) UpdateIssueSilently(ctx context.Context, key string, req *UpdateIssueRequest) error {
+	_, err := c.doRequest(ctx, "PUT", "/issue/"+key+"?notifyUsers=false", req)
 	return err
 }
 
-// DeleteIssue deletes an issue.
-func (c *JiraClient) DeleteIssue(key string) error {
-	_, err := c.doRequest("DELETE", "/issue/"+key, nil)
+// UpdateIssueVerbs edits an issue with verb operations only.
+func (c *JiraClient) UpdateIssueVerbs(ctx context.Context, key string, verbs UpdateVerbs) error {
+	return c.UpdateIssue(ctx, key, &UpdateIssueRequest{Update: verbs})
+}
+
+// DeleteIssue deletes an issue. Jira refuses to delete an issue with
+// subtasks unless deleteSubtasks is set, which deletes them along with it;
+// HasSubtasks recognizes the refusal.
+func (c *JiraClient) DeleteIssue(ctx context.Context, key string, deleteSubtasks bool) error {
+	endpoint := "/issue/" + key
+	if deleteSubtasks {
+		endpoint += "?deleteSubtasks=true"
+	}
+	_, err := c.doRequest(ctx, "DELETE", endpoint, nil)
 	return err
 }
 
 // GetTransitions retrieves available transitions for an issue.
-func (c *JiraClient) GetTransitions(key string) ([]Transition, error) {
-	body, err := c.doRequest("GET", "/issue/"+key+"/transitions", nil)
+func (c *JiraClient) GetTransitions(ctx context.Context, key string) ([]Transition, error) {
+	body, err := c.doRequest(ctx, "GET", "/issue/"+key+"/transitions", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -260,38 +674,266 @@ func (c *JiraClient) GetTransitions(key string) ([]Transition, error) {
 }
 
 // TransitionIssue transitions an issue to a new status.
-func (c *JiraClient) TransitionIssue(key string, transitionID string) error {
+func (c *JiraClient) TransitionIssue(ctx context.Context, key string, transitionID string) error {
 	req := TransitionRequest{
 		Transition: TransitionID{ID: transitionID},
 	}
-	_, err := c.doRequest("POST", "/issue/"+key+"/transitions", req)
+	_, err := c.doRequest(ctx, "POST", "/issue/"+key+"/transitions", req)
 	return err
 }
 
-// SearchIssues searches for issues using JQL.
-func (c *JiraClient) SearchIssues(jql string, maxResults int) (*SearchResult, error) {
-	body := map[string]interface{}{
-		"jql":        jql,
-		"maxResults": maxResults,
-		"fields":     []string{"summary", "description", "status", "issuetype", "project", "priority", "parent", "labels"},
+// searchFields is the field list requested by issue searches.
+var searchFields = []string{"summary", "description", "status", "issuetype", "project", "priority", "parent", "labels", "reporter", "creator", "assignee", "duedate"}
+
+// searchPageSize is the largest page Jira returns from a search.
+const searchPageSize = 100
+
+// keySearchPageSize is the largest page the enhanced search returns when
+// only issue keys are requested.
+const keySearchPageSize = 5000
+
+// keyBatchSize is the number of keys per "key in (...)" search, keeping the
+// JQL well below Jira's query length limits.
+const keyBatchSize = 100
+
+// JQL validation modes for searches.
+const (
+	validateStrict = "strict"
+	validateWarn   = "warn"
+)
+
+// SearchIssues searches for issues using JQL, returning at most maxResults
+// issues. A maxResults of zero returns only the total match count. Use
+// SearchAllIssues to fetch every match.
+func (c *JiraClient) SearchIssues(ctx context.Context, jql string, maxResults int) (*SearchResult, error) {
+	return c.search(ctx, jql, maxResults, validateStrict)
+}
+
+// SearchOptions controls paging for SearchAllIssues and EachIssue.
+type SearchOptions struct {
+	// PageSize is the number of issues fetched per request, at most 100.
+	// Zero means 100.
+	PageSize int
+
+	// Limit is the most issues the search may match before failing with a
+	// *PaginationLimitError. Zero means the client's PaginationLimit.
+	Limit int
+}
+
+// SearchAllIssues returns every issue matching the JQL, paging through the
+// results. Use EachIssue for result sets too large to hold in memory.
+func (c *JiraClient) SearchAllIssues(ctx context.Context, jql string, opts SearchOptions) ([]Issue, error) {
+	var issues []Issue
+	err := c.EachIssue(ctx, jql, opts, func(issue Issue) error {
+		issues = append(issues, issue)
+		return nil
+	})
+	return issues, err
+}
+
+// EachIssue pages through every issue matching the JQL, calling visit for
+// each one. An error from visit stops the search and is returned wrapped.
+func (c *JiraClient) EachIssue(ctx context.Context, jql string, opts SearchOptions, visit func(Issue) error) error {
+	pageSize := opts.PageSize
+	if pageSize <= 0 || pageSize > searchPageSize {
+		pageSize = searchPageSize
 	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = c.PaginationLimit
+	}
+
+	return paginateEach(ctx, limit, func(startAt int) ([]Issue, int, error) {
+		page, err := c.searchPage(ctx, jql, startAt, pageSize, validateStrict)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Issues, page.Total, nil
+	}, visit)
+}
+
+// SearchIssuesByKey fetches issues by key using batched "key in (...)"
+// searches. Keys that don't exist (or aren't visible) are silently absent
+// from the result; callers compare keys to find them.
+func (c *JiraClient) SearchIssuesByKey(ctx context.Context, keys []string) ([]Issue, error) {
+	var issues []Issue
+
+	for start := 0; start < len(keys); start += keyBatchSize {
+		end := start + keyBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			quoted = append(quoted, QuoteJQL(key))
+		}
+
+		// Unknown keys are JQL errors in strict mode; downgrade them to warnings.
+		jql := "key in (" + strings.Join(quoted, ", ") + ")"
+		result, err := c.search(ctx, jql, end-start, validateWarn)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, result.Issues...)
+	}
+
+	return issues, nil
+}
+
+// search runs a JQL search with the given validation mode, paging until
+// maxResults issues have been collected.
+func (c *JiraClient) search(ctx context.Context, jql string, maxResults int, validateQuery string) (*SearchResult, error) {
+	if maxResults <= 0 {
+		return c.searchPage(ctx, jql, 0, 0, validateQuery)
+	}
+
+	result := &SearchResult{MaxResults: maxResults}
+	issues, err := paginate(ctx, c.PaginationLimit, func(startAt int) ([]Issue, int, error) {
+		pageSize := maxResults - startAt
+		if pageSize > searchPageSize {
+			pageSize = searchPageSize
+		}
+
+		page, err := c.searchPage(ctx, jql, startAt, pageSize, validateQuery)
+		if err != nil {
+			return nil, 0, err
+		}
 
-	respBody, err := c.doRequest("POST", "/search", body)
+		result.Total = page.Total
+		if page.Total > maxResults {
+			return page.Issues, maxResults, nil
+		}
+		return page.Issues, page.Total, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	result.Issues = issues
+	return result, nil
+}
+
+// searchPage fetches a single page of JQL search results.
+func (c *JiraClient) searchPage(ctx context.Context, jql string, startAt, maxResults int, validateQuery string) (*SearchResult, error) {
+	body := map[string]interface{}{
+		"jql":           jql,
+		"startAt":       startAt,
+		"maxResults":    maxResults,
+		"fields":        searchFields,
+		"validateQuery": validateQuery,
+	}
+
 	var result SearchResult
-	if err := json.Unmarshal(respBody, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse search results: %w", err)
+	if err := c.doRequestJSON(ctx, "POST", "/search", body, &result); err != nil {
+		return nil, err
 	}
 
 	return &result, nil
 }
 
+// ApproximateCount returns an approximate number of issues matching the
+// JQL. It is much cheaper than a search when only existence matters. REST
+// API version 2 has no approximate count, so the exact total is used there.
+func (c *JiraClient) ApproximateCount(ctx context.Context, jql string) (int, error) {
+	if !c.UsesADF() {
+		result, err := c.searchPage(ctx, jql, 0, 0, validateStrict)
+		if err != nil {
+			return 0, err
+		}
+		return result.Total, nil
+	}
+
+	respBody, err := c.doRequest(ctx, "POST", "/search/approximate-count", map[string]interface{}{
+		"jql": jql,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Count int `json:"count"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, fmt.Errorf("failed to parse approximate count: %w", err)
+	}
+
+	return result.Count, nil
+}
+
+// SearchIssueKeys returns the keys of every issue matching the JQL, using
+// the token-paginated enhanced search endpoint. REST API version 2 doesn't
+// have it, so the offset-paginated search is used there.
+func (c *JiraClient) SearchIssueKeys(ctx context.Context, jql string) ([]string, error) {
+	if !c.UsesADF() {
+		return c.searchIssueKeysOffset(ctx, jql)
+	}
+
+	return paginateToken(ctx, c.PaginationLimit, func(token string) ([]string, string, error) {
+		body := map[string]interface{}{
+			"jql":        jql,
+			"maxResults": keySearchPageSize,
+			"fields":     []string{"key"},
+		}
+		if token != "" {
+			body["nextPageToken"] = token
+		}
+
+		var page struct {
+			Issues []struct {
+				Key string `json:"key"`
+			} `json:"issues"`
+			NextPageToken string `json:"nextPageToken"`
+			IsLast        bool   `json:"isLast"`
+		}
+		if err := c.doRequestJSON(ctx, "POST", "/search/jql", body, &page); err != nil {
+			return nil, "", err
+		}
+
+		keys := make([]string, 0, len(page.Issues))
+		for _, issue := range page.Issues {
+			keys = append(keys, issue.Key)
+		}
+
+		if page.IsLast {
+			return keys, "", nil
+		}
+		return keys, page.NextPageToken, nil
+	})
+}
+
+// searchIssueKeysOffset returns the keys of every issue matching the JQL
+// using the offset-paginated search endpoint.
+func (c *JiraClient) searchIssueKeysOffset(ctx context.Context, jql string) ([]string, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]string, int, error) {
+		body := map[string]interface{}{
+			"jql":        jql,
+			"startAt":    startAt,
+			"maxResults": keySearchPageSize,
+			"fields":     []string{"key"},
+		}
+
+		var page struct {
+			Total  int `json:"total"`
+			Issues []struct {
+				Key string `json:"key"`
+			} `json:"issues"`
+		}
+		if err := c.doRequestJSON(ctx, "POST", "/search", body, &page); err != nil {
+			return nil, 0, err
+		}
+
+		keys := make([]string, 0, len(page.Issues))
+		for _, issue := range page.Issues {
+			keys = append(keys, issue.Key)
+		}
+		return keys, page.Total, nil
+	})
+}
+
 // GetProject retrieves a project by key.
-func (c *JiraClient) GetProject(key string) (*Project, error) {
-	body, err := c.doRequest("GET", "/project/"+key, nil)
+func (c *JiraClient) GetProject(ctx context.Context, key string) (*Project, error) {
+	body, err := c.doRequest(ctx, "GET", "/project/"+key, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -305,8 +947,8 @@ func (c *JiraClient) GetProject(key string) (*Project, error) {
 }
 
 // GetCurrentUser retrieves the authenticated user.
-func (c *JiraClient) GetCurrentUser() (*User, error) {
-	body, err := c.doRequest("GET", "/myself", nil)
+func (c *JiraClient) GetCurrentUser(ctx context.Context) (*User, error) {
+	body, err := c.doRequest(ctx, "GET", "/myself", nil)
 	if err != nil {
 		return nil, err
 	}
@@ -319,13 +961,16 @@ func (c *JiraClient) GetCurrentUser() (*User, error) {
 	return &user, nil
 }
 
-// TextToADF converts plain text to Atlassian Document Format.
+// TextToADF converts plain text to Atlassian Document Format. Paragraphs
+// made entirely of "- [ ]" / "- [x]" lines become task lists, and those made
+// of "✓ decision:" lines become decision lists.
 func TextToADF(text string) map[string]interface{} {
 	if text == "" {
 		return nil
 	}
 
 	// Split text into paragraphs
+	text = strings.ReplaceAll(text, "\r\n", "\n")
 	paragraphs := strings.Split(text, "\n\n")
 	content := make([]map[string]interface{}, 0, len(paragraphs))
 
@@ -336,6 +981,11 @@ func TextToADF(text string) map[string]interface{} {
 
 		// Handle single newlines within paragraphs
 		lines := strings.Split(para, "\n")
+		if list := textToItemList(lines); list != nil {
+			content = append(content, list)
+			continue
+		}
+
 		textContent := make([]map[string]interface{}, 0)
 
 		for i, line := range lines {
@@ -365,7 +1015,12 @@ func TextToADF(text string) map[string]interface{} {
 	}
 }
 
-// ADFToText converts Atlassian Document Format to plain text.
+// ADFToText converts Atlassian Document Format to plain text. Task lists
+// render as Markdown checklists and decisions as "✓ decision:" lines, both
+// of which TextToADF parses back. Lists, headings, code blocks, quotes and
+// rules keep their structure as Markdown-style text, though TextToADF
+// rebuilds them as paragraphs. Node types it doesn't know render as
+// nothing; see PreservedADFBlocks for keeping them across a round trip.
 func ADFToText(adf interface{}) string {
 	if adf == nil {
 		return ""
@@ -382,18 +1037,26 @@ func ADFToText(adf interface{}) string {
 
 	content, ok := doc["content"].([]interface{})
 	if !ok {
-		return ""
+		// Documents built by TextToADF hold typed slices; round-trip them
+		// through JSON into the shape of parsed documents.
+		var parsed struct {
+			Content []interface{} `json:"content"`
+		}
+		raw, err := json.Marshal(doc)
+		if err != nil || json.Unmarshal(raw, &parsed) != nil {
+			return ""
+		}
+		content = parsed.Content
 	}
 
-	var result strings.Builder
-	for i, item := range content {
-		if i > 0 {
-			result.WriteString("\n\n")
+	blocks := make([]string, 0, len(content))
+	for _, item := range content {
+		if text := extractText(item); text != "" {
+			blocks = append(blocks, text)
 		}
-		result.WriteString(extractText(item))
 	}
 
-	return result.String()
+	return strings.Join(blocks, "\n\n")
 }
 
 func extractText(node interface{}) string {
@@ -410,7 +1073,29 @@ func extractText(node interface{}) string {
 		return text
 	case "hardBreak":
 		return "\n"
+	case "taskList", "decisionList":
+		return adfItemsText(nodeMap)
+	case "taskItem", "decisionItem":
+		return adfItemText(nodeMap, nodeType)
+	case "expand", "nestedExpand":
+		return adfExpandText(nodeMap)
+	case "bulletList", "orderedList":
+		return adfListText(nodeMap, nodeType == "orderedList")
+	case "heading":
+		return strings.Repeat("#", adfIntAttr(nodeMap, "level", 1)) + " " + adfChildrenText(nodeMap, "")
+	case "codeBlock":
+		attrs, _ := nodeMap["attrs"].(map[string]interface{})
+		language, _ := attrs["language"].(string)
+		return "```" + language + "\n" + adfChildrenText(nodeMap, "") + "\n```"
+	case "blockquote":
+		return adfQuoteText(nodeMap)
+	case "rule":
+		return "---"
 	default:
+		if !adfTextNodes[nodeType] && !adfKnownNodes[nodeType] {
+			return ""
+		}
+
 		// Recursively extract text from content
 		content, ok := nodeMap["content"].([]interface{})
 		if !ok {
@@ -424,4 +1109,3 @@ func extractText(node interface{}) string {
 		return result.String()
 	}
 }
-
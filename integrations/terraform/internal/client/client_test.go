@@ -0,0 +1,69 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateIssueNotifications(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/3/issue/PROJ-1" {
+			http.NotFound(w, r)
+			return
+		}
+		queries = append(queries, r.URL.RawQuery)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	update := &UpdateIssueRequest{Fields: IssueFields{Assignee: c.UserRef("5b10ac8d82e05b22cc7d4ef5")}}
+
+	if err := c.UpdateIssue(ctx, "PROJ-1", update); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.UpdateIssueSilently(ctx, "PROJ-1", update); err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) != 2 || queries[0] != "" || queries[1] != "notifyUsers=false" {
+		t.Errorf("update queries = %q, want none and then notifyUsers=false", queries)
+	}
+}
+
+func TestRequestContentType(t *testing.T) {
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.CreateIssue(context.Background(), &CreateIssueRequest{Fields: IssueFields{Summary: "Café ☕"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "application/json; charset=utf-8"; contentType != want {
+		t.Errorf("Content-Type = %q, want %q", contentType, want)
+	}
+}
+
+func TestTextToADFLineEndings(t *testing.T) {
+	if got, want := ADFToText(TextToADF("One\r\nline\r\n\r\nTwo")), "One\nline\n\nTwo"; got != want {
+		t.Errorf("ADFToText(TextToADF()) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Comment represents a comment on a Jira issue.
+type Comment struct {
+	ID      string      `json:"id,omitempty"`
+	Author  *User       `json:"author,omitempty"`
+	Body    interface{} `json:"body,omitempty"`
+	Created string      `json:"created,omitempty"`
+	Updated string      `json:"updated,omitempty"`
+	Self    string      `json:"self,omitempty"`
+}
+
+// GetComments retrieves every comment on an issue, oldest first.
+func (c *JiraClient) GetComments(ctx context.Context, issueKey string) ([]Comment, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]Comment, int, error) {
+		endpoint := "/issue/" + issueKey + "/comment?orderBy=created&startAt=" + strconv.Itoa(startAt)
+		body, err := c.doRequest(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total    int       `json:"total"`
+			Comments []Comment `json:"comments"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse comments: %w", err)
+		}
+
+		return page.Comments, page.Total, nil
+	})
+}
+
+// commentRequest is the request body for creating or updating a comment.
+type commentRequest struct {
+	Body interface{} `json:"body"`
+}
+
+// AddComment posts a comment on an issue. The body is Atlassian Document
+// Format, e.g. from TextToADF.
+func (c *JiraClient) AddComment(ctx context.Context, issueKey string, body interface{}) (*Comment, error) {
+	respBody, err := c.doRequest(ctx, "POST", "/issue/"+issueKey+"/comment", commentRequest{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := json.Unmarshal(respBody, &comment); err != nil {
+		return nil, fmt.Errorf("failed to parse comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// GetComment retrieves a single comment on an issue.
+func (c *JiraClient) GetComment(ctx context.Context, issueKey, id string) (*Comment, error) {
+	body, err := c.doRequest(ctx, "GET", "/issue/"+issueKey+"/comment/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := json.Unmarshal(body, &comment); err != nil {
+		return nil, fmt.Errorf("failed to parse comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// UpdateComment replaces the body of a comment.
+func (c *JiraClient) UpdateComment(ctx context.Context, issueKey, id string, body interface{}) (*Comment, error) {
+	respBody, err := c.doRequest(ctx, "PUT", "/issue/"+issueKey+"/comment/"+id, commentRequest{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := json.Unmarshal(respBody, &comment); err != nil {
+		return nil, fmt.Errorf("failed to parse comment: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment from an issue.
+func (c *JiraClient) DeleteComment(ctx context.Context, issueKey, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/issue/"+issueKey+"/comment/"+id, nil)
+	return err
+}
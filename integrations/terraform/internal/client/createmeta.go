@@ -0,0 +1,295 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SubtaskIssueTypeName is the name of Jira's default subtask issue type.
+const SubtaskIssueTypeName = "Sub-task"
+
+// CreateMetaField describes a field on a project's create screen for an
+// issue type.
+type CreateMetaField struct {
+	FieldID  string       `json:"fieldId"`
+	Name     string       `json:"name"`
+	Required bool         `json:"required"`
+	Schema   *FieldSchema `json:"schema,omitempty"`
+}
+
+// GetCreateMetaIssueTypes lists the issue types that can be created in a
+// project.
+func (c *JiraClient) GetCreateMetaIssueTypes(ctx context.Context, projectKey string) ([]IssueType, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]IssueType, int, error) {
+		endpoint := "/issue/createmeta/" + url.PathEscape(projectKey) + "/issuetypes?startAt=" + strconv.Itoa(startAt)
+		body, err := c.doRequest(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total      int         `json:"total"`
+			IssueTypes []IssueType `json:"issueTypes"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse create metadata: %w", err)
+		}
+
+		return page.IssueTypes, page.Total, nil
+	})
+}
+
+// GetCreateMetaFields lists the fields on a project's create screen for an
+// issue type. Results are cached per client since screens rarely change
+// during a run.
+func (c *JiraClient) GetCreateMetaFields(ctx context.Context, projectKey, issueTypeID string) ([]CreateMetaField, error) {
+	cacheKey := projectKey + "/" + issueTypeID
+
+	c.createMetaMu.Lock()
+	fields, ok := c.createMeta[cacheKey]
+	c.createMetaMu.Unlock()
+	if ok {
+		return fields, nil
+	}
+
+	fields, err := paginate(ctx, c.PaginationLimit, func(startAt int) ([]CreateMetaField, int, error) {
+		endpoint := "/issue/createmeta/" + url.PathEscape(projectKey) + "/issuetypes/" + url.PathEscape(issueTypeID) + "?startAt=" + strconv.Itoa(startAt)
+		body, err := c.doRequest(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total  int               `json:"total"`
+			Fields []CreateMetaField `json:"fields"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse create metadata: %w", err)
+		}
+
+		return page.Fields, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.createMetaMu.Lock()
+	if c.createMeta == nil {
+		c.createMeta = make(map[string][]CreateMetaField)
+	}
+	c.createMeta[cacheKey] = fields
+	c.createMetaMu.Unlock()
+
+	return fields, nil
+}
+
+// SubtaskStoryPointsFieldID returns the ID of the story points field on a
+// project's subtask create screen, or "" when the screen has none. The
+// client's StoryPointsField, when set, is returned for every project.
+// Otherwise the field is picked from the screen rather than the whole
+// instance, preferring the kind the project's style uses: "Story point
+// estimate" in team-managed projects and "Story Points" in company-managed
+// ones. Results are cached per project.
+func (c *JiraClient) SubtaskStoryPointsFieldID(ctx context.Context, projectKey string) (string, error) {
+	if c.StoryPointsField != "" {
+		return c.StoryPointsField, nil
+	}
+
+	c.storyPointsMu.Lock()
+	id, ok := c.storyPoints[projectKey]
+	c.storyPointsMu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	id, err := c.discoverStoryPointsField(ctx, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	c.storyPointsMu.Lock()
+	if c.storyPoints == nil {
+		c.storyPoints = make(map[string]string)
+	}
+	c.storyPoints[projectKey] = id
+	c.storyPointsMu.Unlock()
+
+	return id, nil
+}
+
+// discoverStoryPointsField finds the story points field on a project's
+// subtask create screen.
+func (c *JiraClient) discoverStoryPointsField(ctx context.Context, projectKey string) (string, error) {
+	project, err := c.GetProject(ctx, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	issueTypes, err := c.GetCreateMetaIssueTypes(ctx, projectKey)
+	if err != nil {
+		return "", err
+	}
+
+	var subtaskType *IssueType
+	for i, issueType := range issueTypes {
+		if !issueType.Subtask {
+			continue
+		}
+		if subtaskType == nil || strings.EqualFold(issueType.Name, SubtaskIssueTypeName) {
+			subtaskType = &issueTypes[i]
+		}
+	}
+	if subtaskType == nil {
+		return "", fmt.Errorf("project %s has no subtask issue type", projectKey)
+	}
+
+	fields, err := c.GetCreateMetaFields(ctx, projectKey, subtaskType.ID)
+	if err != nil {
+		return "", err
+	}
+
+	var estimate, points string
+	for _, field := range fields {
+		switch {
+		case field.Schema != nil && field.Schema.Custom == StoryPointsFieldType:
+			if estimate == "" {
+				estimate = field.FieldID
+			}
+		case strings.EqualFold(field.Name, StoryPointsFieldName) && strings.HasPrefix(field.FieldID, customFieldPrefix):
+			if points == "" {
+				points = field.FieldID
+			}
+		}
+	}
+
+	// Either field on its own is the one to use; when the screen has both,
+	// the project's style decides.
+	if project.Style == ProjectStyleCompanyManaged && points != "" {
+		return points, nil
+	}
+	if estimate != "" {
+		return estimate, nil
+	}
+	return points, nil
+}
+
+// EpicIssueTypeName is the name of Jira's epic issue type.
+const EpicIssueTypeName = "Epic"
+
+// EpicFields identifies how a project's epics are named and colored.
+// NameFieldID is the Epic Name field, "" in team-managed projects. Epics in
+// company-managed projects are colored through the Agile API (AgileColor);
+// in team-managed projects ColorFieldID is the Issue color field, or "" when
+// the site has none.
+type EpicFields struct {
+	NameFieldID  string
+	ColorFieldID string
+	AgileColor   bool
+}
+
+// EpicFieldIDs returns the epic fields on the create screen of a project's
+// issue type, given by name or ID, or nil when the issue type isn't an
+// epic. Results are cached per project and issue type.
+func (c *JiraClient) EpicFieldIDs(ctx context.Context, projectKey, issueType string) (*EpicFields, error) {
+	cacheKey := projectKey + "/" + strings.ToLower(issueType)
+
+	c.epicFieldsMu.Lock()
+	epic, ok := c.epicFields[cacheKey]
+	c.epicFieldsMu.Unlock()
+	if ok {
+		return epic, nil
+	}
+
+	epic, err := c.discoverEpicFields(ctx, projectKey, issueType)
+	if err != nil {
+		return nil, err
+	}
+
+	c.epicFieldsMu.Lock()
+	if c.epicFields == nil {
+		c.epicFields = make(map[string]*EpicFields)
+	}
+	c.epicFields[cacheKey] = epic
+	c.epicFieldsMu.Unlock()
+
+	return epic, nil
+}
+
+// discoverEpicFields finds the epic fields on a project's create screen for
+// an issue type. Epics are recognized by name or by sitting one level above
+// standard issue types, and the project's style decides how they are
+// colored.
+func (c *JiraClient) discoverEpicFields(ctx context.Context, projectKey, issueType string) (*EpicFields, error) {
+	issueTypes, err := c.GetCreateMetaIssueTypes(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var epicType *IssueType
+	for i, candidate := range issueTypes {
+		if candidate.ID == issueType || strings.EqualFold(candidate.Name, issueType) {
+			epicType = &issueTypes[i]
+			break
+		}
+	}
+	if epicType == nil {
+		return nil, fmt.Errorf("project %s has no issue type %q", projectKey, issueType)
+	}
+	if !strings.EqualFold(epicType.Name, EpicIssueTypeName) && epicType.HierarchyLevel != 1 {
+		return nil, nil
+	}
+
+	fields, err := c.GetCreateMetaFields(ctx, projectKey, epicType.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := c.GetProjectMetadata(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	epic := &EpicFields{AgileColor: project.Style != ProjectStyleTeamManaged}
+	for _, field := range fields {
+		if field.Schema == nil {
+			continue
+		}
+		switch field.Schema.Custom {
+		case EpicNameFieldType:
+			epic.NameFieldID = field.FieldID
+		case IssueColorFieldType:
+			if !epic.AgileColor {
+				epic.ColorFieldID = field.FieldID
+			}
+		}
+	}
+
+	// Jira picks a color for new epics, so the color field is usually left
+	// off create screens while edit screens still carry it.
+	if !epic.AgileColor && epic.ColorFieldID == "" {
+		all, err := c.GetFields(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Team-managed projects each have their own Issue color field.
+		for _, field := range all {
+			if field.Schema == nil || field.Schema.Custom != IssueColorFieldType {
+				continue
+			}
+			if field.Scope == nil || field.Scope.Project == nil || field.Scope.Project.ID == project.ID {
+				epic.ColorFieldID = field.ID
+				if field.Scope != nil {
+					break
+				}
+			}
+		}
+	}
+	return epic, nil
+}
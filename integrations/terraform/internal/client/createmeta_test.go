@@ -0,0 +1,107 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	storyPointEstimateField = `{"fieldId":"customfield_10016","name":"Story point estimate","schema":{"type":"number","custom":"com.pyxis.greenhopper.jira:jsw-story-points"}}`
+	storyPointsField        = `{"fieldId":"customfield_10026","name":"Story Points","schema":{"type":"number","custom":"com.atlassian.jira.plugin.system.customfieldtypes:float"}}`
+	summaryField            = `{"fieldId":"summary","name":"Summary","required":true}`
+)
+
+// newCreateMetaServer serves a PROJ project of the given style whose
+// "Sub-task" create screen has fields, and counts the requests it gets.
+func newCreateMetaServer(t *testing.T, style string, fields ...string) (*JiraClient, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/PROJ":
+			_ = json.NewEncoder(w).Encode(Project{Key: "PROJ", Style: style})
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+			_, _ = w.Write([]byte(`{"total":3,"issueTypes":[
+				{"id":"10001","name":"Task"},
+				{"id":"10002","name":"QA check","subtask":true},
+				{"id":"10003","name":"Sub-task","subtask":true}]}`))
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes/10003":
+			_, _ = fmt.Fprintf(w, `{"total":%d,"fields":[%s]}`, len(fields), strings.Join(fields, ","))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, &requests
+}
+
+func TestSubtaskStoryPointsFieldID(t *testing.T) {
+	tests := []struct {
+		name   string
+		style  string
+		fields []string
+		want   string
+	}{
+		{"team-managed with both", ProjectStyleTeamManaged, []string{summaryField, storyPointsField, storyPointEstimateField}, "customfield_10016"},
+		{"company-managed with both", ProjectStyleCompanyManaged, []string{summaryField, storyPointEstimateField, storyPointsField}, "customfield_10026"},
+		{"company-managed with estimate only", ProjectStyleCompanyManaged, []string{storyPointEstimateField}, "customfield_10016"},
+		{"team-managed with points only", ProjectStyleTeamManaged, []string{storyPointsField}, "customfield_10026"},
+		{"neither", ProjectStyleCompanyManaged, []string{summaryField}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := newCreateMetaServer(t, tt.style, tt.fields...)
+			got, err := c.SubtaskStoryPointsFieldID(context.Background(), "PROJ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("SubtaskStoryPointsFieldID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubtaskStoryPointsFieldIDCached(t *testing.T) {
+	c, requests := newCreateMetaServer(t, ProjectStyleTeamManaged, storyPointEstimateField)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if got, err := c.SubtaskStoryPointsFieldID(ctx, "PROJ"); err != nil || got != "customfield_10016" {
+			t.Fatalf("SubtaskStoryPointsFieldID() = %q, %v", got, err)
+		}
+	}
+	if n := atomic.LoadInt32(requests); n != 3 {
+		t.Errorf("server got %d requests, want 3 for the first lookup only", n)
+	}
+}
+
+func TestSubtaskStoryPointsFieldIDOverride(t *testing.T) {
+	c, requests := newCreateMetaServer(t, ProjectStyleTeamManaged, storyPointEstimateField)
+	c.StoryPointsField = "customfield_10099"
+
+	got, err := c.SubtaskStoryPointsFieldID(context.Background(), "PROJ")
+	if err != nil || got != "customfield_10099" {
+		t.Errorf("SubtaskStoryPointsFieldID() = %q, %v, want the configured field", got, err)
+	}
+	if n := atomic.LoadInt32(requests); n != 0 {
+		t.Errorf("server got %d requests, want none", n)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// maxTokenCommandStderr caps how much of a failing token command's stderr
+// is quoted in the error.
+const maxTokenCommandStderr = 512
+
+// TokenSource supplies the API token. A client calls it at most once, on
+// its first request.
+type TokenSource func(ctx context.Context) (string, error)
+
+// TokenError is returned by every request once the client's token source
+// has failed.
+type TokenError struct {
+	Err error
+}
+
+func (e *TokenError) Error() string {
+	return "failed to obtain the Jira API token: " + e.Err.Error()
+}
+
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// WithTokenSource fetches the API token from source when the first request
+// is made, instead of using the token passed to NewJiraClient. Clients that
+// never make a request never call it.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *JiraClient) {
+		c.tokenSource = source
+	}
+}
+
+// apiToken returns the token to authenticate requests with, running the
+// token source on first use. Its result, success or failure, is kept for
+// the life of the client so a broken helper isn't run for every request;
+// only a cancelled first call is retried.
+func (c *JiraClient) apiToken(ctx context.Context) (string, error) {
+	if c.tokenSource == nil {
+		return c.APIToken, nil
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if !c.tokenFetched {
+		token, err := c.tokenSource(ctx)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return "", ctxErr
+			}
+			c.tokenErr = &TokenError{Err: err}
+		}
+		c.APIToken = token
+		c.tokenFetched = true
+	}
+
+	return c.APIToken, c.tokenErr
+}
+
+// CommandTokenSource runs argv directly, without a shell, and uses its
+// trimmed standard output as the token. Forward slashes in the command path
+// work on every platform, and a bare name is looked up on PATH. The token is
+// never logged; failures quote the start of the command's stderr.
+func CommandTokenSource(argv []string, timeout time.Duration) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		if len(argv) == 0 || argv[0] == "" {
+			return "", errors.New("no token command configured")
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		name := filepath.FromSlash(argv[0])
+		cmd := exec.CommandContext(ctx, name, argv[1:]...)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		// Don't wait on helpers whose children keep the output pipes open.
+		cmd.WaitDelay = time.Second
+
+		if err := cmd.Run(); err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return "", fmt.Errorf("token command %s timed out after %s", name, timeout)
+			}
+			return "", fmt.Errorf("token command %s failed: %w%s", name, err, stderrDetail(stderr.Bytes()))
+		}
+
+		token := strings.TrimSpace(stdout.String())
+		if token == "" {
+			return "", fmt.Errorf("token command %s printed no token%s", name, stderrDetail(stderr.Bytes()))
+		}
+		return token, nil
+	}
+}
+
+// stderrDetail formats a command's stderr for an error message, truncated
+// to maxTokenCommandStderr bytes.
+func stderrDetail(stderr []byte) string {
+	text := strings.TrimSpace(string(stderr))
+	if text == "" {
+		return ""
+	}
+	if len(text) > maxTokenCommandStderr {
+		text = strings.ToValidUTF8(text[:maxTokenCommandStderr], "") + "..."
+	}
+	return ": " + text
+}
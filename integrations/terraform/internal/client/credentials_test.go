@@ -0,0 +1,212 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenSourceRunsOnFirstRequest(t *testing.T) {
+	var gotToken atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, token, _ := r.BasicAuth()
+		gotToken.Store(token)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var calls int32
+	source := func(ctx context.Context) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		return "fetched", nil
+	}
+	c, err := NewJiraClient(server.URL, "user@example.com", "", true, WithTokenSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 0 {
+		t.Fatalf("token source ran %d times before any request", n)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.doRequest(context.Background(), "GET", "/myself", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("token source ran %d times, want 1", n)
+	}
+	if got := gotToken.Load(); got != "fetched" {
+		t.Errorf("request token = %v, want fetched", got)
+	}
+}
+
+func TestTokenSourceFailureIsKept(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	var calls int32
+	source := func(ctx context.Context) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		return "", errors.New("helper locked")
+	}
+	c, err := NewJiraClient(server.URL, "user@example.com", "", true, WithTokenSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.doRequest(context.Background(), "GET", "/myself", nil)
+		var tokenErr *TokenError
+		if !errors.As(err, &tokenErr) {
+			t.Fatalf("error = %v, want a *TokenError", err)
+		}
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("token source ran %d times, want 1", n)
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("%d requests reached Jira without a token", n)
+	}
+}
+
+func TestTokenSourceCancelledIsRetried(t *testing.T) {
+	var calls int32
+	source := func(ctx context.Context) (string, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			<-ctx.Done()
+			return "", ctx.Err()
+		}
+		return "fetched", nil
+	}
+	c := &JiraClient{tokenSource: source}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.apiToken(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled apiToken() error = %v", err)
+	}
+	token, err := c.apiToken(context.Background())
+	if err != nil || token != "fetched" {
+		t.Errorf("apiToken() = %q, %v, want fetched", token, err)
+	}
+}
+
+// TestTokenCommandHelper is the token command run by TestCommandTokenSource:
+// the test binary itself, re-executed with TOKEN_COMMAND_HELPER set. Its
+// argument after "--" picks the behavior.
+func TestTokenCommandHelper(t *testing.T) {
+	if os.Getenv("TOKEN_COMMAND_HELPER") == "" {
+		return
+	}
+	mode := os.Args[len(os.Args)-1]
+
+	// Exit directly, so the test framework prints nothing on stdout.
+	switch mode {
+	case "token":
+		fmt.Print("  secret\n")
+		os.Exit(0)
+	case "empty":
+		fmt.Fprintln(os.Stderr, "oops")
+		os.Exit(0)
+	case "fail":
+		fmt.Print("leaked-secret")
+		fmt.Fprintln(os.Stderr, "locked")
+		os.Exit(3)
+	case "long-stderr":
+		fmt.Print("leaked-secret")
+		fmt.Fprint(os.Stderr, strings.Repeat("e", 2*maxTokenCommandStderr))
+		os.Exit(1)
+	case "sleep":
+		time.Sleep(5 * time.Second)
+		os.Exit(0)
+	}
+	fmt.Fprintf(os.Stderr, "unknown helper mode %q", mode)
+	os.Exit(2)
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	t.Setenv("TOKEN_COMMAND_HELPER", "1")
+
+	// The helper is named by a forward-slash path, as configurations
+	// written on any platform may do; on Windows it is converted back.
+	helper := filepath.ToSlash(os.Args[0])
+	name := filepath.FromSlash(helper)
+	argv := func(mode string) []string {
+		return []string{helper, "-test.run=^TestTokenCommandHelper$", "--", mode}
+	}
+
+	tests := []struct {
+		name      string
+		argv      []string
+		timeout   time.Duration
+		want      string
+		wantError string
+	}{
+		{name: "trims output", argv: argv("token"), want: "secret"},
+		{name: "empty output", argv: argv("empty"), wantError: "token command " + name + " printed no token: oops"},
+		{name: "failure", argv: argv("fail"), wantError: "token command " + name + " failed: exit status 3: locked"},
+		{name: "timeout", argv: argv("sleep"), timeout: 300 * time.Millisecond, wantError: "timed out"},
+		{name: "no command", argv: nil, wantError: "no token command"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout := tt.timeout
+			if timeout == 0 {
+				timeout = 10 * time.Second
+			}
+			token, err := CommandTokenSource(tt.argv, timeout)(context.Background())
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantError)
+				}
+				if strings.Contains(err.Error(), "leaked-secret") {
+					t.Errorf("error quotes the command's stdout: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if token != tt.want {
+				t.Errorf("token = %q, want %q", token, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandTokenSourceLongStderr(t *testing.T) {
+	t.Setenv("TOKEN_COMMAND_HELPER", "1")
+
+	argv := []string{filepath.ToSlash(os.Args[0]), "-test.run=^TestTokenCommandHelper$", "--", "long-stderr"}
+	_, err := CommandTokenSource(argv, 10*time.Second)(context.Background())
+	if err == nil {
+		t.Fatal("failing command returned a token")
+	}
+
+	msg := err.Error()
+	if want := ": " + strings.Repeat("e", maxTokenCommandStderr) + "..."; !strings.HasSuffix(msg, want) {
+		t.Errorf("error = %q, want stderr truncated to %d bytes", msg, maxTokenCommandStderr)
+	}
+	if strings.Contains(msg, strings.Repeat("e", maxTokenCommandStderr+1)) {
+		t.Errorf("error quotes more than %d bytes of stderr", maxTokenCommandStderr)
+	}
+	if strings.Contains(msg, "leaked-secret") {
+		t.Errorf("error quotes the command's stdout: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// EditMetaField describes a field on an issue's edit screen.
+type EditMetaField struct {
+	Key        string       `json:"key"`
+	Name       string       `json:"name"`
+	Required   bool         `json:"required"`
+	Operations []string     `json:"operations,omitempty"`
+	Schema     *FieldSchema `json:"schema,omitempty"`
+}
+
+// GetEditMeta returns the fields that can be edited on an issue, keyed by
+// field ID. Fields missing from the edit screen can't be set by an update
+// even when they could be set at create. Results are cached per issue for
+// the life of the client, since screens rarely change during a run.
+func (c *JiraClient) GetEditMeta(ctx context.Context, key string) (map[string]EditMetaField, error) {
+	c.editMetaMu.Lock()
+	fields, ok := c.editMeta[key]
+	c.editMetaMu.Unlock()
+	if ok {
+		return fields, nil
+	}
+
+	body, err := c.doRequest(ctx, "GET", "/issue/"+url.PathEscape(key)+"/editmeta", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta struct {
+		Fields map[string]EditMetaField `json:"fields"`
+	}
+	if err := json.Unmarshal(body, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse edit metadata: %w", err)
+	}
+	if meta.Fields == nil {
+		meta.Fields = map[string]EditMetaField{}
+	}
+
+	c.editMetaMu.Lock()
+	if c.editMeta == nil {
+		c.editMeta = make(map[string]map[string]EditMetaField)
+	}
+	c.editMeta[key] = meta.Fields
+	c.editMetaMu.Unlock()
+
+	return meta.Fields, nil
+}
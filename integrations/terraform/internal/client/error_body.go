@@ -0,0 +1,121 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"mime"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// errorBodyMaxLength caps how much of a non-JSON error body ends up in an
+// error message. Proxies and maintenance pages can return kilobytes of HTML.
+const errorBodyMaxLength = 300
+
+var (
+	htmlTitlePattern   = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	htmlHeadingPattern = regexp.MustCompile(`(?is)<h1[^>]*>(.*?)</h1>`)
+	htmlTagPattern     = regexp.MustCompile(`(?s)<[^>]*>`)
+	htmlNoisePattern   = regexp.MustCompile(`(?is)<(script|style)[^>]*>.*?</(script|style)>`)
+)
+
+// loginPageMarkers identify the Atlassian account and Jira login pages,
+// served in place of API responses when a proxy or SSO gateway doesn't
+// accept the request's credentials.
+var loginPageMarkers = [][]byte{
+	[]byte("id.atlassian.com/login"),
+	[]byte("log in with atlassian account"),
+	[]byte("log in to continue"),
+	[]byte("login.jsp"),
+	[]byte("os_username"),
+}
+
+// errorBodyMessage describes an error response body that isn't a Jira error
+// document. HTML pages are reduced to their title or first heading, login
+// pages are called out as an authentication problem, and anything else is
+// truncated, so diagnostics never carry a whole page.
+func errorBodyMessage(contentType string, body []byte) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	text := strings.TrimSpace(string(body))
+
+	if isHTML(mediaType, body) {
+		if isLoginPage(body) {
+			return fmt.Sprintf("Jira returned a login page (%s) instead of an API response: the credentials were not accepted. "+
+				"Check that email and api_token are valid, and that no proxy or SSO gateway intercepts requests to the Jira API", mediaType)
+		}
+		if mediaType == "" {
+			mediaType = "text/html"
+		}
+		if heading := htmlHeading(body); heading != "" {
+			return fmt.Sprintf("HTML error page (%s): %s", mediaType, truncateErrorBody(heading))
+		}
+		text = collapseSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(htmlNoisePattern.ReplaceAllString(text, " "), " ")))
+		return fmt.Sprintf("HTML error page (%s): %s", mediaType, truncateErrorBody(text))
+	}
+
+	if mediaType == "" || strings.HasSuffix(mediaType, "json") {
+		return truncateErrorBody(text)
+	}
+	return fmt.Sprintf("%s response: %s", mediaType, truncateErrorBody(text))
+}
+
+// isHTML reports whether a body is an HTML page, going by its content type
+// or, when that is missing, by its first tag.
+func isHTML(mediaType string, body []byte) bool {
+	if mediaType == "text/html" || mediaType == "application/xhtml+xml" {
+		return true
+	}
+	if mediaType != "" {
+		return false
+	}
+	start := bytes.ToLower(bytes.TrimSpace(body))
+	return bytes.HasPrefix(start, []byte("<!doctype html")) || bytes.HasPrefix(start, []byte("<html"))
+}
+
+// isLoginPage reports whether an HTML body is an Atlassian or Jira login page.
+func isLoginPage(body []byte) bool {
+	lower := bytes.ToLower(body)
+	for _, marker := range loginPageMarkers {
+		if bytes.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// htmlHeading returns the title of an HTML page, or its first heading when
+// the title is missing or empty.
+func htmlHeading(body []byte) string {
+	for _, pattern := range []*regexp.Regexp{htmlTitlePattern, htmlHeadingPattern} {
+		if m := pattern.FindSubmatch(body); m != nil {
+			text := collapseSpace(html.UnescapeString(htmlTagPattern.ReplaceAllString(string(m[1]), " ")))
+			if text != "" {
+				return text
+			}
+		}
+	}
+	return ""
+}
+
+// collapseSpace trims text and collapses its runs of whitespace.
+func collapseSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// truncateErrorBody shortens text to errorBodyMaxLength bytes without
+// splitting a character, noting how much was left out.
+func truncateErrorBody(text string) string {
+	if len(text) <= errorBodyMaxLength {
+		return text
+	}
+	cut := errorBodyMaxLength
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s… (%d more bytes)", text[:cut], len(text)-cut)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestErrorBodyMessage(t *testing.T) {
+	long := strings.Repeat("é", errorBodyMaxLength)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "html title",
+			contentType: "text/html; charset=utf-8",
+			body:        "<html><head><title>502 Bad   Gateway</title></head><body><h1>Bad Gateway</h1></body></html>",
+			want:        "HTML error page (text/html): 502 Bad Gateway",
+		},
+		{
+			name:        "html heading without title",
+			contentType: "text/html",
+			body:        "<html><head><title> </title></head><body><h1>Service <b>Unavailable</b> &amp; down</h1></body></html>",
+			want:        "HTML error page (text/html): Service Unavailable & down",
+		},
+		{
+			name:        "html text without heading",
+			contentType: "text/html",
+			body:        "<html><style>p{}</style><script>alert(1)</script><p>Request\n blocked</p></html>",
+			want:        "HTML error page (text/html): Request blocked",
+		},
+		{
+			name: "html sniffed without content type",
+			body: "<!DOCTYPE html><html><title>Maintenance</title></html>",
+			want: "HTML error page (text/html): Maintenance",
+		},
+		{
+			name:        "plain text",
+			contentType: "text/plain",
+			body:        "upstream connect error\n",
+			want:        "text/plain response: upstream connect error",
+		},
+		{
+			name:        "json without error messages",
+			contentType: "application/json",
+			body:        `{"status":"down"}`,
+			want:        `{"status":"down"}`,
+		},
+		{
+			name: "unknown body",
+			body: "nope",
+			want: "nope",
+		},
+		{
+			name:        "long body truncated",
+			contentType: "text/plain",
+			body:        long,
+			want:        "text/plain response: " + long[:errorBodyMaxLength] + "… (300 more bytes)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errorBodyMessage(tt.contentType, []byte(tt.body)); got != tt.want {
+				t.Errorf("errorBodyMessage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorBodyMessageLoginPage(t *testing.T) {
+	for _, body := range []string{
+		`<html><form action="https://id.atlassian.com/login"></form></html>`,
+		`<html><title>Log in to continue</title></html>`,
+		`<html><form action="/login.jsp"><input name="os_username"></form></html>`,
+	} {
+		got := errorBodyMessage("text/html", []byte(body))
+		if !strings.HasPrefix(got, "Jira returned a login page (text/html)") {
+			t.Errorf("errorBodyMessage(%q) = %q, want a login page message", body, got)
+		}
+	}
+}
+
+func TestTruncateErrorBody(t *testing.T) {
+	if got := truncateErrorBody("short"); got != "short" {
+		t.Errorf("truncateErrorBody() = %q, want the text unchanged", got)
+	}
+
+	// A two-byte character straddling the limit is left out whole.
+	text := strings.Repeat("x", errorBodyMaxLength-1) + "é" + "tail"
+	got := truncateErrorBody(text)
+	if !utf8.ValidString(got) {
+		t.Errorf("truncateErrorBody() split a character: %q", got)
+	}
+	if want := strings.Repeat("x", errorBodyMaxLength-1) + "… (6 more bytes)"; got != want {
+		t.Errorf("truncateErrorBody() = %q, want %q", got, want)
+	}
+}
+
+func TestAPIErrorSummarizesHTMLBody(t *testing.T) {
+	page := "<html><head><title>504 Gateway Time-out</title></head><body>" + strings.Repeat("<p>padding</p>", 500) + "</body></html>"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusGatewayTimeout)
+		_, _ = w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+
+	_, err = c.GetIssue(context.Background(), "PROJ-1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetIssue() error = %v, want an *APIError", err)
+	}
+	if want := "HTML error page (text/html): 504 Gateway Time-out"; apiErr.Message != want {
+		t.Errorf("Message = %q, want %q", apiErr.Message, want)
+	}
+	if string(apiErr.Body) != page {
+		t.Errorf("Body holds %d bytes, want the %d-byte page as received", len(apiErr.Body), len(page))
+	}
+}
+
+func TestAPIErrorKeepsJiraMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist"]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetIssue(context.Background(), "PROJ-1")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("GetIssue() error = %v, want an *APIError", err)
+	}
+	if !strings.Contains(apiErr.Message, "Issue does not exist") || strings.Contains(apiErr.Message, "{") {
+		t.Errorf("Message = %q, want Jira's error message", apiErr.Message)
+	}
+}
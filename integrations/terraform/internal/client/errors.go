@@ -0,0 +1,84 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError is returned when Jira responds with an error status. Callers
+// branch on it with errors.As or helpers such as IsNotFound rather than by
+// matching the error text.
+type APIError struct {
+	StatusCode int
+
+	// Message describes the failure: Jira's error messages, or a short
+	// summary of a body that isn't a Jira error document, such as an HTML
+	// page from a proxy. Body holds the body as received.
+	Message string
+	Body    []byte
+
+	// Method and Endpoint identify the failed request; Endpoint is the URL
+	// path without the query.
+	Method   string
+	Endpoint string
+
+	// Response is Jira's parsed error body, or nil when the body wasn't a
+	// Jira error document.
+	Response *ErrorResponse
+
+	// RetryAfter is the wait Jira asked for in a Retry-After header, or zero.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API error (%d): %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a Jira 404 response. Authentication,
+// permission, and network failures are never treated as not found.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsAuthError reports whether err is a Jira 401 or 403 response, returned
+// when the credentials are wrong or lack access. Scoped tokens missing a
+// scope fail with a ScopeError instead.
+func IsAuthError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden)
+}
+
+// IsConflict reports whether err is a Jira 409 response, returned when a
+// change conflicts with how the object is used (e.g. deleting a role that
+// schemes still reference).
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
+// HasSubtasks reports whether err is Jira refusing to delete an issue
+// because it has subtasks.
+func HasSubtasks(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+		strings.Contains(strings.ToLower(apiErr.Message), "subtask")
+}
+
+// FieldError returns the message Jira gave for one field of a rejected
+// write (e.g. "timetracking"), and whether err carried one.
+func FieldError(err error, field string) (string, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Response == nil {
+		return "", false
+	}
+	msg, ok := apiErr.Response.Errors[field]
+	return msg, ok
+}
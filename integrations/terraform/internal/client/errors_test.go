@@ -0,0 +1,103 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIErrorHelpers(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		notFound     bool
+		auth         bool
+		conflict     bool
+		subtasks     bool
+		summaryError string
+	}{
+		{name: "not found", status: 404, body: `{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`, notFound: true},
+		{name: "unauthorized", status: 401, body: `{"errorMessages":["Issue does not exist"]}`, auth: true},
+		{name: "forbidden", status: 403, body: `{}`, auth: true},
+		{name: "conflict", status: 409, body: `{"errorMessages":["The role is in use"]}`, conflict: true},
+		{name: "has subtasks", status: 400, body: `{"errorMessages":["The issue has subtasks; delete them first."]}`, subtasks: true},
+		{name: "field error", status: 400, body: `{"errors":{"summary":"You must specify a summary of the issue."}}`, summaryError: "You must specify a summary of the issue."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c, err := NewJiraClient(server.URL, "user", "token", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.GetIssue(context.Background(), "PROJ-1")
+			if err == nil {
+				t.Fatal("GetIssue() error = nil")
+			}
+			// Callers often wrap the error before it reaches the helpers.
+			err = fmt.Errorf("reading PROJ-1: %w", err)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error %T does not wrap *APIError", err)
+			}
+			if apiErr.StatusCode != tt.status || apiErr.Method != http.MethodGet || apiErr.Endpoint != "/rest/api/3/issue/PROJ-1" {
+				t.Errorf("APIError = %d %s %s, want %d GET /rest/api/3/issue/PROJ-1", apiErr.StatusCode, apiErr.Method, apiErr.Endpoint, tt.status)
+			}
+
+			if got := IsNotFound(err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.notFound)
+			}
+			if got := IsAuthError(err); got != tt.auth {
+				t.Errorf("IsAuthError() = %v, want %v", got, tt.auth)
+			}
+			if got := IsConflict(err); got != tt.conflict {
+				t.Errorf("IsConflict() = %v, want %v", got, tt.conflict)
+			}
+			if got := HasSubtasks(err); got != tt.subtasks {
+				t.Errorf("HasSubtasks() = %v, want %v", got, tt.subtasks)
+			}
+			msg, ok := FieldError(err, "summary")
+			if msg != tt.summaryError || ok != (tt.summaryError != "") {
+				t.Errorf("FieldError(summary) = %q, %v, want %q", msg, ok, tt.summaryError)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundIgnoresOtherErrors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+
+	_, err = c.GetIssue(context.Background(), "PROJ-1")
+	if err == nil {
+		t.Fatal("GetIssue() against a closed server succeeded")
+	}
+	if IsNotFound(err) || IsAuthError(err) {
+		t.Errorf("network error %v classified as a Jira response", err)
+	}
+	if IsNotFound(errors.New("404 page not found")) {
+		t.Error("IsNotFound() matched on error text")
+	}
+}
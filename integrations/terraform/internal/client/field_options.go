@@ -0,0 +1,195 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// fieldOptionBatchSize is the maximum number of options the API accepts in
+// a single create or update.
+const fieldOptionBatchSize = 1000
+
+// FieldOption is an option of a select list custom field context.
+// Cascading select lists also have child options, which name their parent
+// in OptionID.
+type FieldOption struct {
+	ID       string `json:"id,omitempty"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+	OptionID string `json:"optionId,omitempty"`
+}
+
+// FieldContext is a custom field context, which sets the field's options
+// and default for some projects and issue types.
+type FieldContext struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// fieldContextPage is a page of custom field contexts.
+type fieldContextPage struct {
+	StartAt    int            `json:"startAt"`
+	MaxResults int            `json:"maxResults"`
+	Total      int            `json:"total"`
+	IsLast     bool           `json:"isLast"`
+	Values     []FieldContext `json:"values"`
+}
+
+// fieldOptionPage is a page of custom field options.
+type fieldOptionPage struct {
+	StartAt    int           `json:"startAt"`
+	MaxResults int           `json:"maxResults"`
+	Total      int           `json:"total"`
+	IsLast     bool          `json:"isLast"`
+	Values     []FieldOption `json:"values"`
+}
+
+// fieldOptionsRequest is the request and response body for creating and
+// updating options.
+type fieldOptionsRequest struct {
+	Options []FieldOption `json:"options"`
+}
+
+// moveFieldOptionsRequest is the request body for reordering options.
+type moveFieldOptionsRequest struct {
+	CustomFieldOptionIDs []string `json:"customFieldOptionIds"`
+	Position             string   `json:"position"`
+}
+
+// OptionInUseError is returned when Jira refuses to delete an option that
+// issues still use.
+type OptionInUseError struct {
+	Value string
+	Err   error
+}
+
+func (e *OptionInUseError) Error() string {
+	return fmt.Sprintf("option %q is still set on issues (%s); migrate those issues to another option "+
+		"(Jira's \"replace option\" action, or DELETE .../option/{id}/issue?replaceWith=) or disable the option instead of removing it",
+		e.Value, e.Err)
+}
+
+func (e *OptionInUseError) Unwrap() error {
+	return e.Err
+}
+
+// fieldOptionsEndpoint returns the options endpoint of a field context.
+func fieldOptionsEndpoint(fieldID, contextID string) string {
+	return "/field/" + url.PathEscape(fieldID) + "/context/" + url.PathEscape(contextID) + "/option"
+}
+
+// GetFieldContexts lists the contexts of a custom field.
+func (c *JiraClient) GetFieldContexts(ctx context.Context, fieldID string) ([]FieldContext, error) {
+	endpoint := "/field/" + url.PathEscape(fieldID) + "/context"
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]FieldContext, int, error) {
+		body, err := c.doRequest(ctx, "GET", endpoint+"?startAt="+strconv.Itoa(startAt), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page fieldContextPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse field contexts: %w", err)
+		}
+
+		if page.IsLast {
+			return page.Values, startAt + len(page.Values), nil
+		}
+		return page.Values, page.Total, nil
+	})
+}
+
+// GetFieldOptions lists the options of a custom field context in display
+// order, including child options of cascading select lists.
+func (c *JiraClient) GetFieldOptions(ctx context.Context, fieldID, contextID string) ([]FieldOption, error) {
+	endpoint := fieldOptionsEndpoint(fieldID, contextID)
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]FieldOption, int, error) {
+		body, err := c.doRequest(ctx, "GET", endpoint+"?startAt="+strconv.Itoa(startAt), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page fieldOptionPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse field options: %w", err)
+		}
+
+		if page.IsLast {
+			return page.Values, startAt + len(page.Values), nil
+		}
+		return page.Values, page.Total, nil
+	})
+}
+
+// CreateFieldOptions adds options to the end of a custom field context and
+// returns them with their IDs.
+func (c *JiraClient) CreateFieldOptions(ctx context.Context, fieldID, contextID string, options []FieldOption) ([]FieldOption, error) {
+	var created []FieldOption
+	for start := 0; start < len(options); start += fieldOptionBatchSize {
+		end := start + fieldOptionBatchSize
+		if end > len(options) {
+			end = len(options)
+		}
+
+		body, err := c.doRequest(ctx, "POST", fieldOptionsEndpoint(fieldID, contextID), fieldOptionsRequest{Options: options[start:end]})
+		if err != nil {
+			return created, err
+		}
+
+		var result fieldOptionsRequest
+		if err := json.Unmarshal(body, &result); err != nil {
+			return created, fmt.Errorf("failed to parse created field options: %w", err)
+		}
+		created = append(created, result.Options...)
+	}
+	return created, nil
+}
+
+// UpdateFieldOptions changes the value or disabled flag of existing options,
+// identified by ID.
+func (c *JiraClient) UpdateFieldOptions(ctx context.Context, fieldID, contextID string, options []FieldOption) error {
+	for start := 0; start < len(options); start += fieldOptionBatchSize {
+		end := start + fieldOptionBatchSize
+		if end > len(options) {
+			end = len(options)
+		}
+
+		if _, err := c.doRequest(ctx, "PUT", fieldOptionsEndpoint(fieldID, contextID), fieldOptionsRequest{Options: options[start:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteFieldOption deletes an option. Jira refuses when issues still use
+// it, which is returned as an *OptionInUseError.
+func (c *JiraClient) DeleteFieldOption(ctx context.Context, fieldID, contextID string, option FieldOption) error {
+	_, err := c.doRequest(ctx, "DELETE", fieldOptionsEndpoint(fieldID, contextID)+"/"+url.PathEscape(option.ID), nil)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusBadRequest || apiErr.StatusCode == http.StatusConflict) {
+		return &OptionInUseError{Value: option.Value, Err: err}
+	}
+	return err
+}
+
+// ReorderFieldOptions moves options, identified by ID, to the top of the
+// list in the given order. Options not listed keep their relative order
+// after them.
+func (c *JiraClient) ReorderFieldOptions(ctx context.Context, fieldID, contextID string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := c.doRequest(ctx, "PUT", fieldOptionsEndpoint(fieldID, contextID)+"/move", moveFieldOptionsRequest{
+		CustomFieldOptionIDs: ids,
+		Position:             "First",
+	})
+	return err
+}
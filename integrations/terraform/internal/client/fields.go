@@ -0,0 +1,316 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// EpicLinkFieldType is the custom field type of the legacy Epic Link field
+// used by company-managed projects that predate fields.parent for epics.
+const EpicLinkFieldType = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+// EpicNameFieldType is the custom field type of the Epic Name field of
+// company-managed projects, where it is required to create an epic.
+// Team-managed projects name epics by their summary instead.
+const EpicNameFieldType = "com.pyxis.greenhopper.jira:gh-epic-label"
+
+// IssueColorFieldType is the custom field type of the Issue color field that
+// holds epic colors in team-managed projects. Company-managed projects color
+// epics through the Agile API instead.
+const IssueColorFieldType = "com.pyxis.greenhopper.jira:jsw-issue-color"
+
+// StoryPointsFieldType is the custom field type of the "Story point
+// estimate" field in team-managed projects. Company-managed projects use a
+// plain number field named StoryPointsFieldName instead. Instances with both
+// kinds of project have both fields, and writing the wrong one succeeds
+// without showing on boards.
+const (
+	StoryPointsFieldType = "com.pyxis.greenhopper.jira:jsw-story-points"
+	StoryPointsFieldName = "Story Points"
+)
+
+// RankFieldType is the custom field type of the Agile rank field, whose
+// LexoRank values sort in rank order as plain strings.
+const RankFieldType = "com.pyxis.greenhopper.jira:gh-lexo-rank"
+
+// CascadingSelectFieldType is the custom field type of cascading select
+// lists, whose values are an option with an optional child option.
+const CascadingSelectFieldType = "com.atlassian.jira.plugin.system.customfieldtypes:cascadingselect"
+
+// StartDateFieldName is the name of the date field Advanced Roadmaps uses
+// for the start of an issue's timeline bar.
+const StartDateFieldName = "Start date"
+
+// customFieldPrefix identifies custom field IDs in issue fields.
+const customFieldPrefix = "customfield_"
+
+// Field describes a system or custom issue field.
+type Field struct {
+	ID     string       `json:"id"`
+	Name   string       `json:"name"`
+	Custom bool         `json:"custom"`
+	Schema *FieldSchema `json:"schema,omitempty"`
+
+	// Scope is set on fields that belong to one team-managed project.
+	Scope *FieldScope `json:"scope,omitempty"`
+}
+
+// FieldScope describes the project a team-managed field belongs to.
+type FieldScope struct {
+	Type    string   `json:"type"`
+	Project *Project `json:"project,omitempty"`
+}
+
+// FieldSchema describes the type of a field.
+type FieldSchema struct {
+	Type     string `json:"type,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty"`
+}
+
+// issueFieldsAlias has IssueFields' fields without its JSON methods.
+type issueFieldsAlias IssueFields
+
+// MarshalJSON encodes the fields, including any custom fields.
+func (f IssueFields) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(issueFieldsAlias(f))
+	if err != nil || (len(f.Custom) == 0 && len(f.Clear) == 0) {
+		return data, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for id, value := range f.Custom {
+		merged[id] = value
+	}
+	for _, id := range f.Clear {
+		merged[id] = json.RawMessage("null")
+	}
+	return json.Marshal(merged)
+}
+
+// UnmarshalJSON decodes the fields, collecting custom fields into Custom.
+func (f *IssueFields) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*issueFieldsAlias)(f)); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for id, value := range all {
+		if !strings.HasPrefix(id, customFieldPrefix) || string(value) == "null" {
+			continue
+		}
+		if f.Custom == nil {
+			f.Custom = make(map[string]json.RawMessage)
+		}
+		f.Custom[id] = value
+	}
+	return nil
+}
+
+// CustomString returns a custom field's value when it is a string.
+func (f *IssueFields) CustomString(id string) (string, bool) {
+	var value string
+	if raw, ok := f.Custom[id]; ok && json.Unmarshal(raw, &value) == nil {
+		return value, true
+	}
+	return "", false
+}
+
+// CustomNumber returns a custom field's value when it is a number.
+func (f *IssueFields) CustomNumber(id string) (float64, bool) {
+	var value float64
+	if raw, ok := f.Custom[id]; ok && json.Unmarshal(raw, &value) == nil {
+		return value, true
+	}
+	return 0, false
+}
+
+// SetCustom sets a custom field's value for create and update requests.
+func (f *IssueFields) SetCustom(id string, value interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode field %s: %w", id, err)
+	}
+	if f.Custom == nil {
+		f.Custom = make(map[string]json.RawMessage)
+	}
+	f.Custom[id] = raw
+	return nil
+}
+
+// Omit removes a field, and any explicit clear of it, from create and
+// update requests, so an update leaves the field unchanged. It handles
+// custom fields and the priority, fixVersions and versions system fields.
+func (f *IssueFields) Omit(id string) {
+	switch id {
+	case "priority":
+		f.Priority = nil
+	case "fixVersions":
+		f.FixVersions = nil
+	case "versions":
+		f.Versions = nil
+	default:
+		delete(f.Custom, id)
+	}
+
+	kept := f.Clear[:0]
+	for _, cleared := range f.Clear {
+		if cleared != id {
+			kept = append(kept, cleared)
+		}
+	}
+	f.Clear = kept
+}
+
+// IsCustomFieldID reports whether id is a custom field ID (customfield_*).
+func IsCustomFieldID(id string) bool {
+	return strings.HasPrefix(id, customFieldPrefix)
+}
+
+// GetFields retrieves every system and custom field. The result is cached
+// per client since field definitions rarely change during a run.
+func (c *JiraClient) GetFields(ctx context.Context) ([]Field, error) {
+	c.fieldsMu.Lock()
+	defer c.fieldsMu.Unlock()
+
+	if c.fields != nil {
+		return c.fields, nil
+	}
+
+	body, err := c.doRequest(ctx, "GET", "/field", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []Field
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse fields: %w", err)
+	}
+
+	c.fields = fields
+	return fields, nil
+}
+
+// GetField returns the field with the given ID, or nil when there is none.
+func (c *JiraClient) GetField(ctx context.Context, id string) (*Field, error) {
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fields {
+		if fields[i].ID == id {
+			return &fields[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// FieldIDByCustomType returns the ID of the first custom field of the given
+// type, or "" when the instance has none.
+func (c *JiraClient) FieldIDByCustomType(ctx context.Context, customType string) (string, error) {
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, field := range fields {
+		if field.Schema != nil && field.Schema.Custom == customType {
+			return field.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// CustomFieldID resolves a custom field ID (customfield_10016) or name to
+// its ID. Names match case-insensitively, and a name shared by several
+// custom fields is an error, since Jira allows duplicate field names.
+func (c *JiraClient) CustomFieldID(ctx context.Context, nameOrID string) (string, error) {
+	if strings.HasPrefix(nameOrID, customFieldPrefix) {
+		return nameOrID, nil
+	}
+
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var matches []string
+	for _, field := range fields {
+		if field.Custom && strings.EqualFold(field.Name, nameOrID) {
+			matches = append(matches, field.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no custom field named %q", nameOrID)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%d custom fields are named %q (%s); use the field ID instead", len(matches), nameOrID, strings.Join(matches, ", "))
+	}
+}
+
+// StoryPointsFieldID returns the ID of the story points field, or "" when
+// the instance has none.
+func (c *JiraClient) StoryPointsFieldID(ctx context.Context) (string, error) {
+	id, err := c.FieldIDByCustomType(ctx, StoryPointsFieldType)
+	if err != nil || id != "" {
+		return id, err
+	}
+
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		if field.Custom && strings.EqualFold(field.Name, StoryPointsFieldName) {
+			return field.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// StartDateFieldID returns the ID of the start date field, or "" when the
+// instance has none. The client's StartDateField, when set, is returned
+// instead of looking the field up by name.
+func (c *JiraClient) StartDateFieldID(ctx context.Context) (string, error) {
+	if c.StartDateField != "" {
+		return c.StartDateField, nil
+	}
+
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, field := range fields {
+		if field.Custom && strings.EqualFold(field.Name, StartDateFieldName) && field.Schema != nil && field.Schema.Type == "date" {
+			return field.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// IsParentHierarchyError reports whether a create or update was rejected
+// because the project doesn't accept fields.parent for this issue, as in
+// company-managed projects that still use the Epic Link field.
+func IsParentHierarchyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "appropriate hierarchy") ||
+		strings.Contains(msg, "parent: field 'parent' cannot be set")
+}
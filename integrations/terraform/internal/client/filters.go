@@ -0,0 +1,64 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Filter represents a saved JQL filter.
+type Filter struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	JQL              string            `json:"jql,omitempty"`
+	SharePermissions []SharePermission `json:"sharePermissions,omitempty"`
+	Self             string            `json:"self,omitempty"`
+}
+
+// SharePermission shares a filter, e.g. with everyone who can browse a
+// project (Type "project").
+type SharePermission struct {
+	Type    string   `json:"type"`
+	Project *Project `json:"project,omitempty"`
+}
+
+// GetFilter retrieves a filter by ID.
+func (c *JiraClient) GetFilter(ctx context.Context, id string) (*Filter, error) {
+	body, err := c.doRequest(ctx, "GET", "/filter/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter Filter
+	if err := json.Unmarshal(body, &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	return &filter, nil
+}
+
+// CreateFilter creates a filter owned by the authenticated user.
+func (c *JiraClient) CreateFilter(ctx context.Context, filter *Filter) (*Filter, error) {
+	body, err := c.doRequest(ctx, "POST", "/filter", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Filter
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created filter: %w", err)
+	}
+
+	return &created, nil
+}
+
+// DeleteFilter deletes a filter.
+func (c *JiraClient) DeleteFilter(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/filter/"+url.PathEscape(id), nil)
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// impactFields are the fields fetched to count what deleting an issue
+// takes with it.
+var impactFields = []string{"summary", "issuetype", "subtasks", "attachment", "comment"}
+
+// IssueImpact describes what deleting an issue affects.
+type IssueImpact struct {
+	Key       string
+	Summary   string
+	IssueType string
+
+	// SubtaskKeys are the issue's subtasks, which Jira deletes with it.
+	SubtaskKeys []string
+
+	// ChildKeys are the non-subtask issues parented to it, such as an
+	// epic's stories, which Jira keeps but detaches.
+	ChildKeys []string
+
+	Attachments int
+	Comments    int
+}
+
+// impactSearchPage is a page of the search used by GetIssueImpacts.
+type impactSearchPage struct {
+	Total  int `json:"total"`
+	Issues []struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary   string     `json:"summary"`
+			IssueType *IssueType `json:"issuetype"`
+			Subtasks  []struct {
+				Key string `json:"key"`
+			} `json:"subtasks"`
+			Attachment []json.RawMessage `json:"attachment"`
+			Comment    struct {
+				Total int `json:"total"`
+			} `json:"comment"`
+			Parent *Parent `json:"parent"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+// GetIssueImpacts reports what deleting each issue would affect, using one
+// search per 100 keys for the issues themselves and one paginated search per
+// batch for their child issues. Keys that don't exist are absent from the
+// result.
+func (c *JiraClient) GetIssueImpacts(ctx context.Context, keys []string) ([]IssueImpact, error) {
+	var impacts []IssueImpact
+
+	for start := 0; start < len(keys); start += keyBatchSize {
+		end := start + keyBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			quoted = append(quoted, QuoteJQL(key))
+		}
+		list := strings.Join(quoted, ", ")
+
+		var page impactSearchPage
+		body := map[string]interface{}{
+			"jql":           "key in (" + list + ")",
+			"maxResults":    end - start,
+			"fields":        impactFields,
+			"validateQuery": validateWarn,
+		}
+		if err := c.doRequestJSON(ctx, "POST", "/search", body, &page); err != nil {
+			return nil, err
+		}
+
+		children, err := c.childIssueKeys(ctx, "parent in ("+list+") AND issuetype not in subTaskIssueTypes()")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range page.Issues {
+			impact := IssueImpact{
+				Key:         issue.Key,
+				Summary:     issue.Fields.Summary,
+				ChildKeys:   children[issue.Key],
+				Attachments: len(issue.Fields.Attachment),
+				Comments:    issue.Fields.Comment.Total,
+			}
+			if issue.Fields.IssueType != nil {
+				impact.IssueType = issue.Fields.IssueType.Name
+			}
+			for _, subtask := range issue.Fields.Subtasks {
+				impact.SubtaskKeys = append(impact.SubtaskKeys, subtask.Key)
+			}
+			impacts = append(impacts, impact)
+		}
+	}
+
+	return impacts, nil
+}
+
+// childIssueKeys returns the keys of the issues matching the JQL, grouped by
+// their parent's key.
+func (c *JiraClient) childIssueKeys(ctx context.Context, jql string) (map[string][]string, error) {
+	type child struct{ parent, key string }
+	children := make(map[string][]string)
+
+	err := paginateEach(ctx, c.PaginationLimit, func(startAt int) ([]child, int, error) {
+		body := map[string]interface{}{
+			"jql":           jql,
+			"startAt":       startAt,
+			"maxResults":    searchPageSize,
+			"fields":        []string{"parent"},
+			"validateQuery": validateWarn,
+		}
+
+		var page impactSearchPage
+		if err := c.doRequestJSON(ctx, "POST", "/search", body, &page); err != nil {
+			return nil, 0, err
+		}
+
+		items := make([]child, 0, len(page.Issues))
+		for _, issue := range page.Issues {
+			item := child{key: issue.Key}
+			if issue.Fields.Parent != nil {
+				item.parent = issue.Fields.Parent.Key
+			}
+			items = append(items, item)
+		}
+		return items, page.Total, nil
+	}, func(item child) error {
+		children[item.parent] = append(children[item.parent], item.key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return children, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// IssueLinkType represents a Jira issue link type (e.g., "Blocks").
+type IssueLinkType struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+	Self    string `json:"self,omitempty"`
+}
+
+// GetIssueLinkTypes retrieves all issue link types defined on the instance.
+// The result is cached per client; CreateIssueLinkType, UpdateIssueLinkType,
+// and DeleteIssueLinkType invalidate the cache.
+func (c *JiraClient) GetIssueLinkTypes(ctx context.Context) ([]IssueLinkType, error) {
+	c.linkTypesMu.Lock()
+	defer c.linkTypesMu.Unlock()
+
+	if c.linkTypes != nil {
+		return c.linkTypes, nil
+	}
+
+	body, err := c.doRequest(ctx, "GET", "/issueLinkType", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse issue link types: %w", err)
+	}
+	if result.IssueLinkTypes == nil {
+		result.IssueLinkTypes = []IssueLinkType{}
+	}
+
+	c.linkTypes = result.IssueLinkTypes
+	return c.linkTypes, nil
+}
+
+// invalidateIssueLinkTypes drops the cached link types.
+func (c *JiraClient) invalidateIssueLinkTypes() {
+	c.linkTypesMu.Lock()
+	c.linkTypes = nil
+	c.linkTypesMu.Unlock()
+}
+
+// FindIssueLinkTypeByPhrase returns the link type whose name, outward phrase
+// ("blocks"), or inward phrase ("is blocked by") matches phrase, ignoring
+// case. reversed is true for an inward phrase match, where the issue
+// written first is the outward issue of the link. Names and outward phrases
+// win over inward phrases, so a type like "Relates" whose phrases are the
+// same is never reversed. It returns nil without an error when nothing
+// matches.
+func (c *JiraClient) FindIssueLinkTypeByPhrase(ctx context.Context, phrase string) (linkType *IssueLinkType, reversed bool, err error) {
+	linkTypes, err := c.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	phrase = strings.TrimSpace(phrase)
+	for i := range linkTypes {
+		if strings.EqualFold(linkTypes[i].Name, phrase) || strings.EqualFold(linkTypes[i].Outward, phrase) {
+			return &linkTypes[i], false, nil
+		}
+	}
+	for i := range linkTypes {
+		if strings.EqualFold(linkTypes[i].Inward, phrase) {
+			return &linkTypes[i], true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// GetIssueLinkType retrieves an issue link type by ID.
+func (c *JiraClient) GetIssueLinkType(ctx context.Context, id string) (*IssueLinkType, error) {
+	body, err := c.doRequest(ctx, "GET", "/issueLinkType/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var linkType IssueLinkType
+	if err := json.Unmarshal(body, &linkType); err != nil {
+		return nil, fmt.Errorf("failed to parse issue link type: %w", err)
+	}
+
+	return &linkType, nil
+}
+
+// CreateIssueLinkType creates a new issue link type.
+func (c *JiraClient) CreateIssueLinkType(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, error) {
+	body, err := c.doRequest(ctx, "POST", "/issueLinkType", linkType)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateIssueLinkTypes()
+
+	var created IssueLinkType
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created issue link type: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateIssueLinkType updates the name and phrases of an issue link type.
+func (c *JiraClient) UpdateIssueLinkType(ctx context.Context, id string, linkType *IssueLinkType) (*IssueLinkType, error) {
+	body, err := c.doRequest(ctx, "PUT", "/issueLinkType/"+id, linkType)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateIssueLinkTypes()
+
+	var updated IssueLinkType
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse updated issue link type: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteIssueLinkType deletes an issue link type. Jira converts any existing
+// links of this type to the default "Relates" type.
+func (c *JiraClient) DeleteIssueLinkType(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/issueLinkType/"+id, nil)
+	if err == nil {
+		c.invalidateIssueLinkTypes()
+	}
+	return err
+}
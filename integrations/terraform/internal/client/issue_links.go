@@ -0,0 +1,157 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// IssueLink represents a link between two issues. Links embedded in an
+// issue's issuelinks field only carry the issue on the other side.
+type IssueLink struct {
+	ID           string         `json:"id,omitempty"`
+	Type         *IssueLinkType `json:"type,omitempty"`
+	InwardIssue  *LinkedIssue   `json:"inwardIssue,omitempty"`
+	OutwardIssue *LinkedIssue   `json:"outwardIssue,omitempty"`
+}
+
+// LinkedIssue identifies an issue on one side of a link.
+type LinkedIssue struct {
+	ID  string `json:"id,omitempty"`
+	Key string `json:"key,omitempty"`
+}
+
+// createIssueLinkRequest is the request body for creating an issue link.
+type createIssueLinkRequest struct {
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  LinkedIssue   `json:"inwardIssue"`
+	OutwardIssue LinkedIssue   `json:"outwardIssue"`
+}
+
+// CreateIssueLink links two issues with the named link type. Jira doesn't
+// return the new link, so the link is looked up from the inward issue.
+func (c *JiraClient) CreateIssueLink(ctx context.Context, linkType, inwardKey, outwardKey string) (*IssueLink, error) {
+	_, err := c.doRequest(ctx, "POST", "/issueLink", createIssueLinkRequest{
+		Type:         IssueLinkType{Name: linkType},
+		InwardIssue:  LinkedIssue{Key: inwardKey},
+		OutwardIssue: LinkedIssue{Key: outwardKey},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := c.FindIssueLink(ctx, linkType, inwardKey, outwardKey)
+	if err != nil {
+		return nil, err
+	}
+	if link == nil {
+		return nil, fmt.Errorf("created %s link from %s to %s but could not find it on %s", linkType, inwardKey, outwardKey, inwardKey)
+	}
+
+	return link, nil
+}
+
+// GetIssueLink retrieves an issue link by ID.
+func (c *JiraClient) GetIssueLink(ctx context.Context, id string) (*IssueLink, error) {
+	body, err := c.doRequest(ctx, "GET", "/issueLink/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var link IssueLink
+	if err := json.Unmarshal(body, &link); err != nil {
+		return nil, fmt.Errorf("failed to parse issue link: %w", err)
+	}
+
+	return &link, nil
+}
+
+// FindIssueLink looks up the link of the named type from inwardKey to
+// outwardKey among the inward issue's links. It returns nil without an error
+// when no such link exists.
+func (c *JiraClient) FindIssueLink(ctx context.Context, linkType, inwardKey, outwardKey string) (*IssueLink, error) {
+	body, err := c.doRequest(ctx, "GET", "/issue/"+inwardKey+"?fields=issuelinks", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Fields struct {
+			IssueLinks []IssueLink `json:"issuelinks"`
+		} `json:"fields"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse issue links: %w", err)
+	}
+
+	// Seen from the inward issue, the link only names its outward side.
+	for i := range result.Fields.IssueLinks {
+		link := &result.Fields.IssueLinks[i]
+		if link.Type == nil || link.OutwardIssue == nil {
+			continue
+		}
+		if strings.EqualFold(link.Type.Name, linkType) && strings.EqualFold(link.OutwardIssue.Key, outwardKey) {
+			link.InwardIssue = &LinkedIssue{Key: inwardKey}
+			return link, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// LinkedIssueDetails is an issue with its links, as returned by
+// GetIssuesWithLinks.
+type LinkedIssueDetails struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary    string      `json:"summary"`
+		Status     *Status     `json:"status"`
+		IssueLinks []IssueLink `json:"issuelinks"`
+	} `json:"fields"`
+}
+
+// GetIssuesWithLinks fetches the summary, status and links of issues by key
+// using batched "key in (...)" searches. Keys that don't exist (or aren't
+// visible) are absent from the result.
+func (c *JiraClient) GetIssuesWithLinks(ctx context.Context, keys []string) ([]LinkedIssueDetails, error) {
+	var issues []LinkedIssueDetails
+
+	for start := 0; start < len(keys); start += keyBatchSize {
+		end := start + keyBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		quoted := make([]string, 0, end-start)
+		for _, key := range keys[start:end] {
+			quoted = append(quoted, QuoteJQL(key))
+		}
+
+		body := map[string]interface{}{
+			"jql":           "key in (" + strings.Join(quoted, ", ") + ")",
+			"maxResults":    end - start,
+			"fields":        []string{"summary", "status", "issuelinks"},
+			"validateQuery": validateWarn,
+		}
+
+		var page struct {
+			Issues []LinkedIssueDetails `json:"issues"`
+		}
+		if err := c.doRequestJSON(ctx, "POST", "/search", body, &page); err != nil {
+			return nil, err
+		}
+		issues = append(issues, page.Issues...)
+	}
+
+	return issues, nil
+}
+
+// DeleteIssueLink deletes an issue link.
+func (c *JiraClient) DeleteIssueLink(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/issueLink/"+id, nil)
+	return err
+}
@@ -0,0 +1,44 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Issue type hierarchy levels. Custom levels above epics are numbered 2 and
+// up.
+const (
+	HierarchyLevelSubtask  = -1
+	HierarchyLevelStandard = 0
+	HierarchyLevelEpic     = 1
+)
+
+// GetIssueTypes lists the issue types available to the user, or only those
+// used by a project when projectKey is set.
+func (c *JiraClient) GetIssueTypes(ctx context.Context, projectKey string) ([]IssueType, error) {
+	endpoint := "/issuetype"
+	if projectKey != "" {
+		project, err := c.GetProject(ctx, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = "/issuetype/project?" + url.Values{"projectId": {project.ID}}.Encode()
+	}
+
+	body, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var issueTypes []IssueType
+	if err := json.Unmarshal(body, &issueTypes); err != nil {
+		return nil, fmt.Errorf("failed to parse issue types: %w", err)
+	}
+
+	return issueTypes, nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import "strings"
+
+// jqlTextSpecial lists characters with special meaning in JQL text searches
+// (the ~ operator), which must be escaped to match literally.
+const jqlTextSpecial = `+-&|!(){}[]^~*?:\/`
+
+// QuoteJQL quotes a value for safe use as a JQL string literal, escaping
+// backslashes and double quotes.
+func QuoteJQL(value string) string {
+	var b strings.Builder
+	b.Grow(len(value) + 2)
+	b.WriteByte('"')
+	for _, r := range value {
+		switch r {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// QuoteJQLText quotes a value for use with the JQL text-search operator (~),
+// additionally escaping characters that the text search treats as syntax.
+// Text search is fuzzy, so callers must still compare results exactly.
+func QuoteJQLText(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(jqlTextSpecial, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return QuoteJQL(b.String())
+}
@@ -0,0 +1,393 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	mdHeading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdBullet      = regexp.MustCompile(`^\s*[-*+]\s+(.*)$`)
+	mdOrdered     = regexp.MustCompile(`^\s*(\d+)[.)]\s+(.*)$`)
+	mdRule        = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	mdFence       = regexp.MustCompile("^\\s*(```|~~~)\\s*(\\S*)")
+	mdInlineToken = regexp.MustCompile("`[^`]+`|\\*\\*[^*]+\\*\\*|__[^_]+__|\\*[^*]+\\*|_[^_]+_|\\[[^\\]]+\\]\\([^)\\s]+\\)")
+)
+
+// MarkdownToADF converts Markdown to Atlassian Document Format. It supports
+// the subset used in runbooks: headings, paragraphs, bullet and ordered
+// lists (nested by indentation), block quotes, fenced code blocks, rules,
+// and bold, italic, code, and link inline marks. Anything else is kept as
+// plain text.
+func MarkdownToADF(markdown string) map[string]interface{} {
+	lines := strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n")
+	content := make([]map[string]interface{}, 0)
+
+	var paragraph []string
+	flush := func() {
+		if len(paragraph) > 0 {
+			content = append(content, adfBlock("paragraph", markdownInline(strings.Join(paragraph, " "))))
+			paragraph = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			flush()
+
+		case mdFence.MatchString(line):
+			flush()
+			m := mdFence.FindStringSubmatch(line)
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), m[1]); i++ {
+				code = append(code, lines[i])
+			}
+			block := adfBlock("codeBlock", nil)
+			if len(code) > 0 {
+				block["content"] = []map[string]interface{}{adfText(strings.Join(code, "\n"), nil)}
+			}
+			if m[2] != "" {
+				block["attrs"] = map[string]interface{}{"language": m[2]}
+			}
+			content = append(content, block)
+
+		case mdHeading.MatchString(trimmed):
+			flush()
+			m := mdHeading.FindStringSubmatch(trimmed)
+			block := adfBlock("heading", markdownInline(m[2]))
+			block["attrs"] = map[string]interface{}{"level": len(m[1])}
+			content = append(content, block)
+
+		case mdRule.MatchString(line):
+			flush()
+			content = append(content, map[string]interface{}{"type": "rule"})
+
+		case mdBullet.MatchString(line), mdOrdered.MatchString(line):
+			flush()
+			var list map[string]interface{}
+			list, i = markdownList(lines, i)
+			i--
+			content = append(content, list)
+
+		case strings.HasPrefix(trimmed, ">"):
+			flush()
+			var quoted []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				quoted = append(quoted, strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")))
+			}
+			i--
+			inner := MarkdownToADF(strings.Join(quoted, "\n"))
+			content = append(content, map[string]interface{}{
+				"type":    "blockquote",
+				"content": inner["content"],
+			})
+
+		default:
+			paragraph = append(paragraph, trimmed)
+		}
+	}
+	flush()
+
+	return map[string]interface{}{
+		"type":    "doc",
+		"version": 1,
+		"content": content,
+	}
+}
+
+// markdownList parses the list starting at lines[i]. Items indented further
+// than the first one start a nested list inside the preceding item. It
+// returns the list and the index of the first line after it.
+func markdownList(lines []string, i int) (map[string]interface{}, int) {
+	indent := markdownIndent(lines[i])
+	ordered := !mdBullet.MatchString(lines[i])
+	pattern := mdBullet
+	if ordered {
+		pattern = mdOrdered
+	}
+
+	list := adfBlock("bulletList", nil)
+	if ordered {
+		list["type"] = "orderedList"
+		if start, err := strconv.Atoi(mdOrdered.FindStringSubmatch(lines[i])[1]); err == nil && start != 1 {
+			list["attrs"] = map[string]interface{}{"order": start}
+		}
+	}
+
+	var items []map[string]interface{}
+	for i < len(lines) && (mdBullet.MatchString(lines[i]) || mdOrdered.MatchString(lines[i])) {
+		lineIndent := markdownIndent(lines[i])
+		if lineIndent < indent {
+			break
+		}
+
+		if lineIndent > indent && len(items) > 0 {
+			var nested map[string]interface{}
+			nested, i = markdownList(lines, i)
+			last := items[len(items)-1]
+			last["content"] = append(last["content"].([]map[string]interface{}), nested)
+			continue
+		}
+
+		// A different marker at the same depth starts a new list.
+		if !pattern.MatchString(lines[i]) || mdBullet.MatchString(lines[i]) == ordered {
+			break
+		}
+
+		m := pattern.FindStringSubmatch(lines[i])
+		items = append(items, map[string]interface{}{
+			"type":    "listItem",
+			"content": []map[string]interface{}{adfBlock("paragraph", markdownInline(m[len(m)-1]))},
+		})
+		i++
+	}
+
+	list["content"] = items
+	return list, i
+}
+
+// markdownIndent returns the indentation of a line in columns, counting a
+// tab as four.
+func markdownIndent(line string) int {
+	columns := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			columns++
+		case '\t':
+			columns += 4
+		default:
+			return columns
+		}
+	}
+	return columns
+}
+
+// ADFToMarkdown converts Atlassian Document Format to Markdown, the inverse
+// of MarkdownToADF for the node types and marks it supports, so converting
+// its output back yields the same document. Other nodes render as their
+// plain text, as in ADFToText. A string (REST API version 2) is returned
+// unchanged.
+func ADFToMarkdown(adf interface{}) string {
+	if str, ok := adf.(string); ok {
+		return str
+	}
+	if adf == nil {
+		return ""
+	}
+
+	// Round-trip through JSON so built and parsed documents share a shape.
+	var doc struct {
+		Content []interface{} `json:"content"`
+	}
+	raw, err := json.Marshal(adf)
+	if err != nil || json.Unmarshal(raw, &doc) != nil {
+		return ""
+	}
+	return markdownBlocks(doc.Content)
+}
+
+// markdownBlocks renders block nodes separated by blank lines.
+func markdownBlocks(nodes []interface{}) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if text := markdownBlock(node); text != "" {
+			blocks = append(blocks, text)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// markdownBlock renders a single block node as Markdown.
+func markdownBlock(node interface{}) string {
+	nodeMap, ok := node.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	content, _ := nodeMap["content"].([]interface{})
+	attrs, _ := nodeMap["attrs"].(map[string]interface{})
+
+	switch nodeMap["type"] {
+	case "paragraph":
+		return markdownInlineText(content)
+	case "heading":
+		level, _ := attrs["level"].(float64)
+		if level < 1 || level > 6 {
+			level = 1
+		}
+		return strings.Repeat("#", int(level)) + " " + markdownInlineText(content)
+	case "bulletList", "orderedList":
+		return markdownListText(nodeMap, "")
+	case "codeBlock":
+		language, _ := attrs["language"].(string)
+		if code := markdownInlineText(content); code != "" {
+			return "```" + language + "\n" + code + "\n```"
+		}
+		return "```" + language + "\n```"
+	case "blockquote":
+		lines := strings.Split(markdownBlocks(content), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight("> "+line, " ")
+		}
+		return strings.Join(lines, "\n")
+	case "rule":
+		return "---"
+	default:
+		return extractText(node)
+	}
+}
+
+// markdownListText renders a bullet or ordered list, indenting nested lists
+// under the text of their item.
+func markdownListText(list map[string]interface{}, indent string) string {
+	ordered := list["type"] == "orderedList"
+	number := 1
+	if attrs, ok := list["attrs"].(map[string]interface{}); ok {
+		if order, ok := attrs["order"].(float64); ok {
+			number = int(order)
+		}
+	}
+
+	items, _ := list["content"].([]interface{})
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		marker := "- "
+		if ordered {
+			marker = strconv.Itoa(number) + ". "
+			number++
+		}
+
+		itemMap, _ := item.(map[string]interface{})
+		children, _ := itemMap["content"].([]interface{})
+		var text, nested []string
+		for _, child := range children {
+			childMap, _ := child.(map[string]interface{})
+			switch childMap["type"] {
+			case "bulletList", "orderedList":
+				nested = append(nested, markdownListText(childMap, indent+strings.Repeat(" ", len(marker))))
+			default:
+				text = append(text, strings.ReplaceAll(markdownBlock(child), "\n", " "))
+			}
+		}
+
+		lines = append(lines, indent+marker+strings.Join(text, " "))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownInlineText renders inline nodes, wrapping text in the Markdown
+// for its marks.
+func markdownInlineText(nodes []interface{}) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		nodeMap, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if nodeMap["type"] != "text" {
+			b.WriteString(extractText(nodeMap))
+			continue
+		}
+
+		text, _ := nodeMap["text"].(string)
+		marks, _ := nodeMap["marks"].([]interface{})
+		var href string
+		var code, strong, em bool
+		for _, mark := range marks {
+			markMap, _ := mark.(map[string]interface{})
+			switch markMap["type"] {
+			case "code":
+				code = true
+			case "strong":
+				strong = true
+			case "em":
+				em = true
+			case "link":
+				attrs, _ := markMap["attrs"].(map[string]interface{})
+				href, _ = attrs["href"].(string)
+			}
+		}
+
+		if code {
+			text = "`" + text + "`"
+		}
+		if em {
+			text = "*" + text + "*"
+		}
+		if strong {
+			text = "**" + text + "**"
+		}
+		if href != "" {
+			text = "[" + text + "](" + href + ")"
+		}
+		b.WriteString(text)
+	}
+	return b.String()
+}
+
+// markdownInline converts inline Markdown to ADF text nodes with marks.
+func markdownInline(text string) []map[string]interface{} {
+	var nodes []map[string]interface{}
+	last := 0
+	for _, loc := range mdInlineToken.FindAllStringIndex(text, -1) {
+		if loc[0] > last {
+			nodes = append(nodes, adfText(text[last:loc[0]], nil))
+		}
+		last = loc[1]
+
+		token := text[loc[0]:loc[1]]
+		switch {
+		case strings.HasPrefix(token, "`"):
+			nodes = append(nodes, adfText(token[1:len(token)-1], adfMark("code", nil)))
+		case strings.HasPrefix(token, "**"), strings.HasPrefix(token, "__"):
+			nodes = append(nodes, adfText(token[2:len(token)-2], adfMark("strong", nil)))
+		case strings.HasPrefix(token, "["):
+			end := strings.Index(token, "](")
+			href := token[end+2 : len(token)-1]
+			nodes = append(nodes, adfText(token[1:end], adfMark("link", map[string]interface{}{"href": href})))
+		default:
+			nodes = append(nodes, adfText(token[1:len(token)-1], adfMark("em", nil)))
+		}
+	}
+	if last < len(text) {
+		nodes = append(nodes, adfText(text[last:], nil))
+	}
+	return nodes
+}
+
+// adfBlock builds a block node with optional inline content.
+func adfBlock(nodeType string, content []map[string]interface{}) map[string]interface{} {
+	block := map[string]interface{}{"type": nodeType}
+	if len(content) > 0 {
+		block["content"] = content
+	}
+	return block
+}
+
+// adfText builds a text node with optional marks.
+func adfText(text string, marks []map[string]interface{}) map[string]interface{} {
+	node := map[string]interface{}{"type": "text", "text": text}
+	if len(marks) > 0 {
+		node["marks"] = marks
+	}
+	return node
+}
+
+// adfMark builds a single-element mark list.
+func adfMark(markType string, attrs map[string]interface{}) []map[string]interface{} {
+	mark := map[string]interface{}{"type": markType}
+	if attrs != nil {
+		mark["attrs"] = attrs
+	}
+	return []map[string]interface{}{mark}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	tests := map[string]string{
+		"heading":         "# Title\n\n###### Smallest",
+		"paragraph marks": "Run `make test` with **care**, *slowly*, per [the guide](https://example.com/guide).",
+		"bullet list":     "- one\n- two\n  - nested\n- three",
+		"ordered list":    "3. third\n4. fourth\n   - nested bullet",
+		"code block":      "```go\nfmt.Println(\"hi\")\n\nreturn\n```",
+		"empty code":      "```\n```",
+		"quote":           "> Quoted\n>\n> Second paragraph",
+		"rule":            "Above\n\n---\n\nBelow",
+	}
+
+	for name, markdown := range tests {
+		t.Run(name, func(t *testing.T) {
+			doc := parseADF(t, MarkdownToADF(markdown))
+			if got := ADFToMarkdown(doc); got != markdown {
+				t.Errorf("ADFToMarkdown(MarkdownToADF()) = %q, want %q", got, markdown)
+			}
+			// The rendered Markdown converts back to the same document.
+			if again := parseADF(t, MarkdownToADF(ADFToMarkdown(doc))); !reflect.DeepEqual(again, doc) {
+				t.Errorf("document changed on a second round trip: %v", again)
+			}
+		})
+	}
+}
+
+func TestMarkdownNormalized(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"star bullets", "* one\n+ two", "- one\n- two"},
+		{"underscore marks", "__bold__ and _em_", "**bold** and *em*"},
+		{"wrapped paragraph", "one\ntwo", "one two"},
+		{"closing hashes", "## Title ##", "## Title"},
+		{"paren ordered list", "1) first", "1. first"},
+		{"tilde fence", "~~~sh\nls\n~~~", "```sh\nls\n```"},
+		{"windows line endings", "one\r\n\r\ntwo", "one\n\ntwo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ADFToMarkdown(parseADF(t, MarkdownToADF(tt.markdown))); got != tt.want {
+				t.Errorf("ADFToMarkdown(MarkdownToADF(%q)) = %q, want %q", tt.markdown, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestADFToMarkdownOtherInput(t *testing.T) {
+	if got := ADFToMarkdown("h1. Wiki"); got != "h1. Wiki" {
+		t.Errorf("ADFToMarkdown(string) = %q, want it unchanged", got)
+	}
+	if got := ADFToMarkdown(nil); got != "" {
+		t.Errorf("ADFToMarkdown(nil) = %q, want empty", got)
+	}
+
+	// Nodes Markdown can't express render as their text.
+	panel := parseADF(t, map[string]interface{}{
+		"type": "doc",
+		"content": []interface{}{map[string]interface{}{
+			"type":    "panel",
+			"content": []interface{}{MarkdownToADF("Careful")["content"].([]map[string]interface{})[0]},
+		}},
+	})
+	if got := ADFToMarkdown(panel); got != "Careful" {
+		t.Errorf("ADFToMarkdown(panel) = %q, want %q", got, "Careful")
+	}
+}
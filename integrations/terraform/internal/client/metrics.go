@@ -0,0 +1,327 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// latencyBuckets is the number of histogram buckets. Bucket i counts
+// requests that took less than 2^i milliseconds; the last bucket is open.
+const latencyBuckets = 18
+
+var (
+	endpointIssueKey = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+	endpointID       = regexp.MustCompile(`^\d+$`)
+)
+
+// Metrics records per-endpoint request counts and latencies for debugging
+// slow applies. A nil *Metrics records nothing, so the client can call it
+// unconditionally. Counters are atomic and each endpoint has its own small
+// histogram lock, so recording never contends on a global lock.
+type Metrics struct {
+	endpoints sync.Map // endpoint string -> *endpointMetrics
+	limiter   [2]priorityMetrics
+
+	nearLimit atomic.Int64
+	rateLimit atomic.Pointer[rateLimitObservation]
+}
+
+// rateLimitObservation is the most recent rate limit state Jira reported.
+type rateLimitObservation struct {
+	status RateLimitStatus
+	at     time.Time
+}
+
+// priorityMetrics holds the rate limiter statistics of one request priority.
+type priorityMetrics struct {
+	requests   atomic.Int64
+	waited     atomic.Int64
+	waitNs     atomic.Int64
+	promotions atomic.Int64
+}
+
+// endpointMetrics holds the statistics of a single endpoint.
+type endpointMetrics struct {
+	count     atomic.Int64
+	errors    atomic.Int64
+	retries   atomic.Int64
+	throttles atomic.Int64
+	totalNs   atomic.Int64
+
+	mu        sync.Mutex
+	histogram [latencyBuckets]int64
+}
+
+// EndpointSummary is the summary of a single endpoint in a metrics report.
+type EndpointSummary struct {
+	Endpoint  string  `json:"endpoint"`
+	Count     int64   `json:"count"`
+	Errors    int64   `json:"errors"`
+	Retries   int64   `json:"retries"`
+	Throttles int64   `json:"throttles"`
+	TotalMs   float64 `json:"total_ms"`
+	P50Ms     float64 `json:"p50_ms"`
+	P95Ms     float64 `json:"p95_ms"`
+}
+
+// PrioritySummary is the rate limiter summary of one request priority in a
+// metrics report. Promotions counts requests let ahead of higher priority
+// ones to prevent starvation.
+type PrioritySummary struct {
+	Requests   int64   `json:"requests"`
+	Waited     int64   `json:"waited"`
+	WaitMs     float64 `json:"wait_ms"`
+	Promotions int64   `json:"promotions"`
+}
+
+// LimiterSummary is the rate limiter section of a metrics report. Reads are
+// low priority and writes high.
+type LimiterSummary struct {
+	High PrioritySummary `json:"high"`
+	Low  PrioritySummary `json:"low"`
+}
+
+// RateLimitSummary is the rate limit section of a metrics report: how many
+// responses carried a near-limit warning, and the last rate limit headers
+// Jira sent. Remaining and Limit are -1 when they weren't reported.
+type RateLimitSummary struct {
+	NearLimitResponses int64  `json:"near_limit_responses"`
+	ObservedAt         string `json:"observed_at,omitempty"`
+	NearLimit          bool   `json:"near_limit"`
+	Remaining          int    `json:"remaining"`
+	Limit              int    `json:"limit"`
+	Reset              string `json:"reset,omitempty"`
+}
+
+// MetricsReport is the JSON document written by WriteFile.
+type MetricsReport struct {
+	GeneratedAt string            `json:"generated_at"`
+	Endpoints   []EndpointSummary `json:"endpoints"`
+	Limiter     LimiterSummary    `json:"limiter"`
+	RateLimit   RateLimitSummary  `json:"rate_limit"`
+}
+
+// NewMetrics creates an empty metrics recorder.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// endpoint returns the statistics for an endpoint, creating them on first use.
+func (m *Metrics) endpoint(method, path string) *endpointMetrics {
+	key := method + " " + endpointTemplate(path)
+	if e, ok := m.endpoints.Load(key); ok {
+		return e.(*endpointMetrics)
+	}
+	e, _ := m.endpoints.LoadOrStore(key, &endpointMetrics{})
+	return e.(*endpointMetrics)
+}
+
+// recordRequest records a completed request and its status code (zero when
+// the request failed before a response arrived).
+func (m *Metrics) recordRequest(method, path string, status int, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+
+	e := m.endpoint(method, path)
+	e.count.Add(1)
+	e.totalNs.Add(int64(elapsed))
+	if status == 0 || status >= 400 {
+		e.errors.Add(1)
+	}
+	if status == 429 {
+		e.throttles.Add(1)
+	}
+
+	bucket := 0
+	for ms := elapsed.Milliseconds(); ms > 0 && bucket < latencyBuckets-1; ms >>= 1 {
+		bucket++
+	}
+
+	e.mu.Lock()
+	e.histogram[bucket]++
+	e.mu.Unlock()
+}
+
+// recordRetry records that a request to an endpoint is being retried.
+func (m *Metrics) recordRetry(method, path string) {
+	if m == nil {
+		return
+	}
+	m.endpoint(method, path).retries.Add(1)
+}
+
+// recordLimiterWait records how a request of the given priority got through
+// the rate limiter.
+func (m *Metrics) recordLimiterWait(priority RequestPriority, waited limiterWait) {
+	if m == nil {
+		return
+	}
+
+	p := &m.limiter[priority]
+	p.requests.Add(1)
+	if waited.delay > 0 {
+		p.waited.Add(1)
+		p.waitNs.Add(int64(waited.delay))
+	}
+	if waited.promoted {
+		p.promotions.Add(1)
+	}
+}
+
+// recordRateLimit records the rate limit state Jira reported on a response.
+func (m *Metrics) recordRateLimit(status RateLimitStatus) {
+	if m == nil {
+		return
+	}
+	if status.NearLimit {
+		m.nearLimit.Add(1)
+	}
+	m.rateLimit.Store(&rateLimitObservation{status: status, at: time.Now()})
+}
+
+// rateLimitSummary returns the report section of the rate limit state.
+func (m *Metrics) rateLimitSummary() RateLimitSummary {
+	summary := RateLimitSummary{
+		NearLimitResponses: m.nearLimit.Load(),
+		Remaining:          -1,
+		Limit:              -1,
+	}
+	observed := m.rateLimit.Load()
+	if observed == nil {
+		return summary
+	}
+
+	summary.ObservedAt = observed.at.UTC().Format(time.RFC3339)
+	summary.NearLimit = observed.status.NearLimit
+	summary.Remaining = observed.status.Remaining
+	summary.Limit = observed.status.Limit
+	if !observed.status.Reset.IsZero() {
+		summary.Reset = observed.status.Reset.UTC().Format(time.RFC3339)
+	}
+	return summary
+}
+
+// summary returns the report section of a priority's statistics.
+func (p *priorityMetrics) summary() PrioritySummary {
+	return PrioritySummary{
+		Requests:   p.requests.Load(),
+		Waited:     p.waited.Load(),
+		WaitMs:     float64(p.waitNs.Load()) / float64(time.Millisecond),
+		Promotions: p.promotions.Load(),
+	}
+}
+
+// Report summarizes the recorded metrics, slowest endpoints (by total time)
+// first. Percentiles are bucket upper bounds, so they are approximate.
+func (m *Metrics) Report() MetricsReport {
+	report := MetricsReport{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Endpoints:   []EndpointSummary{},
+		RateLimit:   RateLimitSummary{Remaining: -1, Limit: -1},
+	}
+	if m == nil {
+		return report
+	}
+
+	m.endpoints.Range(func(key, value any) bool {
+		e := value.(*endpointMetrics)
+
+		e.mu.Lock()
+		histogram := e.histogram
+		e.mu.Unlock()
+
+		report.Endpoints = append(report.Endpoints, EndpointSummary{
+			Endpoint:  key.(string),
+			Count:     e.count.Load(),
+			Errors:    e.errors.Load(),
+			Retries:   e.retries.Load(),
+			Throttles: e.throttles.Load(),
+			TotalMs:   float64(e.totalNs.Load()) / float64(time.Millisecond),
+			P50Ms:     histogramPercentile(histogram, 0.50),
+			P95Ms:     histogramPercentile(histogram, 0.95),
+		})
+		return true
+	})
+
+	sort.Slice(report.Endpoints, func(i, j int) bool {
+		return report.Endpoints[i].TotalMs > report.Endpoints[j].TotalMs
+	})
+	report.Limiter = LimiterSummary{
+		High: m.limiter[RequestPriorityHigh].summary(),
+		Low:  m.limiter[RequestPriorityLow].summary(),
+	}
+	report.RateLimit = m.rateLimitSummary()
+	return report
+}
+
+// WriteFile writes the metrics report as JSON to path.
+func (m *Metrics) WriteFile(path string) error {
+	data, err := json.MarshalIndent(m.Report(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// histogramPercentile returns the upper bound, in milliseconds, of the
+// bucket containing the given percentile.
+func histogramPercentile(histogram [latencyBuckets]int64, p float64) float64 {
+	var total int64
+	for _, n := range histogram {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	rank := int64(p*float64(total)+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	var seen int64
+	for i, n := range histogram {
+		seen += n
+		if seen > rank {
+			return float64(int64(1) << i)
+		}
+	}
+	return float64(int64(1) << (latencyBuckets - 1))
+}
+
+// requestPath returns the path of an absolute request URL.
+func requestPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	return u.Path
+}
+
+// endpointTemplate replaces issue keys and numeric IDs in a request path with
+// placeholders so requests to the same endpoint are grouped together, and so
+// metrics and traces don't carry issue keys.
+func endpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "api":
+			// The REST API version, as in /rest/api/3.
+		case endpointID.MatchString(segment):
+			segments[i] = "{id}"
+		case endpointIssueKey.MatchString(segment):
+			segments[i] = "{key}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
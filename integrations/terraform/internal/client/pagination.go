@@ -0,0 +1,116 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultPaginationLimit is the default maximum number of results any list
+// operation pages through before giving up.
+const DefaultPaginationLimit = 10000
+
+// PaginationLimitError is returned when a listing would exceed the client's
+// pagination limit.
+type PaginationLimitError struct {
+	Limit int
+	Total int
+}
+
+func (e *PaginationLimitError) Error() string {
+	if e.Total > 0 {
+		return fmt.Sprintf("listing returned %d results, more than the pagination limit of %d; narrow the query or raise pagination_limit", e.Total, e.Limit)
+	}
+	return fmt.Sprintf("listing returned more than the pagination limit of %d results; narrow the query or raise pagination_limit", e.Limit)
+}
+
+// offsetFetcher fetches the page starting at startAt. It returns the page
+// items and the total number of results, or a negative total when the
+// endpoint doesn't report one (iteration then stops on an empty page).
+type offsetFetcher[T any] func(startAt int) (items []T, total int, err error)
+
+// tokenFetcher fetches the page identified by token ("" for the first page).
+// It returns the page items and the token of the next page, or "" when the
+// page was the last one.
+type tokenFetcher[T any] func(token string) (items []T, next string, err error)
+
+// paginate collects every item of an offset-paginated listing.
+func paginate[T any](ctx context.Context, limit int, fetch offsetFetcher[T]) ([]T, error) {
+	var all []T
+	err := paginateEach(ctx, limit, fetch, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	return all, err
+}
+
+// paginateEach walks an offset-paginated listing, calling visit for every
+// item so large listings don't have to be held in memory. Errors are wrapped
+// with how far iteration got.
+func paginateEach[T any](ctx context.Context, limit int, fetch offsetFetcher[T], visit func(T) error) error {
+	if limit <= 0 {
+		limit = DefaultPaginationLimit
+	}
+
+	startAt := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("pagination stopped after %d results: %w", startAt, err)
+		}
+
+		items, total, err := fetch(startAt)
+		if err != nil {
+			return fmt.Errorf("pagination stopped after %d results: %w", startAt, err)
+		}
+
+		if total > limit {
+			return &PaginationLimitError{Limit: limit, Total: total}
+		}
+
+		for _, item := range items {
+			if startAt >= limit {
+				return &PaginationLimitError{Limit: limit}
+			}
+			if err := visit(item); err != nil {
+				return fmt.Errorf("pagination stopped after %d results: %w", startAt, err)
+			}
+			startAt++
+		}
+
+		if len(items) == 0 || (total >= 0 && startAt >= total) {
+			return nil
+		}
+	}
+}
+
+// paginateToken collects every item of a token-paginated listing.
+func paginateToken[T any](ctx context.Context, limit int, fetch tokenFetcher[T]) ([]T, error) {
+	if limit <= 0 {
+		limit = DefaultPaginationLimit
+	}
+
+	var all []T
+	token := ""
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("pagination stopped after %d results: %w", len(all), err)
+		}
+
+		items, next, err := fetch(token)
+		if err != nil {
+			return nil, fmt.Errorf("pagination stopped after %d results: %w", len(all), err)
+		}
+
+		if len(all)+len(items) > limit {
+			return nil, &PaginationLimitError{Limit: limit}
+		}
+		all = append(all, items...)
+
+		if next == "" || next == token || len(items) == 0 {
+			return all, nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// pagedInts serves items in pages of size, reporting total (or -1) with
+// each page, and records the offsets requested.
+func pagedInts(items []int, size, total int, offsets *[]int) offsetFetcher[int] {
+	return func(startAt int) ([]int, int, error) {
+		*offsets = append(*offsets, startAt)
+		end := startAt + size
+		if end > len(items) {
+			end = len(items)
+		}
+		if startAt > end {
+			startAt = end
+		}
+		return items[startAt:end], total, nil
+	}
+}
+
+func ints(n int) []int {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return items
+}
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       int
+		size        int
+		total       int
+		limit       int
+		wantOffsets []int
+		wantErr     *PaginationLimitError
+	}{
+		{name: "stops at total", items: 5, size: 2, total: 5, wantOffsets: []int{0, 2, 4}},
+		{name: "total a multiple of the page size", items: 6, size: 2, total: 6, wantOffsets: []int{0, 2, 4}},
+		{name: "single page", items: 2, size: 10, total: 2, wantOffsets: []int{0}},
+		{name: "full single page", items: 10, size: 10, total: 10, wantOffsets: []int{0}},
+		{name: "empty", items: 0, size: 10, total: 0, wantOffsets: []int{0}},
+		{name: "no total stops on empty page", items: 4, size: 2, total: -1, wantOffsets: []int{0, 2, 4}},
+		{name: "total at limit", items: 4, size: 2, total: 4, limit: 4, wantOffsets: []int{0, 2}},
+		{name: "total at limit in one page", items: 5, size: 5, total: 5, limit: 5, wantOffsets: []int{0}},
+		{name: "no total at limit", items: 4, size: 2, total: -1, limit: 4, wantOffsets: []int{0, 2, 4}},
+		{name: "total over limit", items: 5, size: 2, total: 5, limit: 4, wantOffsets: []int{0}, wantErr: &PaginationLimitError{Limit: 4, Total: 5}},
+		{name: "no total over limit", items: 5, size: 2, total: -1, limit: 3, wantOffsets: []int{0, 2}, wantErr: &PaginationLimitError{Limit: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var offsets []int
+			got, err := paginate(context.Background(), tt.limit, pagedInts(ints(tt.items), tt.size, tt.total, &offsets))
+
+			if !reflect.DeepEqual(offsets, tt.wantOffsets) {
+				t.Errorf("offsets = %v, want %v", offsets, tt.wantOffsets)
+			}
+			if tt.wantErr != nil {
+				var limitErr *PaginationLimitError
+				if !errors.As(err, &limitErr) || *limitErr != *tt.wantErr {
+					t.Fatalf("error = %v, want %+v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != tt.items {
+				t.Errorf("got %d items, want %d", len(got), tt.items)
+			}
+		})
+	}
+}
+
+func TestPaginateEachErrors(t *testing.T) {
+	fetchErr := errors.New("boom")
+	calls := 0
+	err := paginateEach(context.Background(), 0, func(startAt int) ([]int, int, error) {
+		calls++
+		if startAt > 0 {
+			return nil, 0, fetchErr
+		}
+		return []int{1, 2}, 10, nil
+	}, func(int) error { return nil })
+	if !errors.Is(err, fetchErr) || !strings.Contains(err.Error(), "after 2 results") {
+		t.Errorf("fetch error = %v, want it wrapped with the progress", err)
+	}
+
+	visitErr := errors.New("stop")
+	err = paginateEach(context.Background(), 0, pagedInts(ints(5), 5, 5, new([]int)), func(item int) error {
+		if item == 3 {
+			return visitErr
+		}
+		return nil
+	})
+	if !errors.Is(err, visitErr) || !strings.Contains(err.Error(), "after 3 results") {
+		t.Errorf("visit error = %v, want it wrapped with the progress", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	err = paginateEach(ctx, 0, func(int) ([]int, int, error) {
+		calls++
+		return nil, 0, nil
+	}, func(int) error { return nil })
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("cancelled error = %v after %d fetches, want context.Canceled before any", err, calls)
+	}
+}
+
+func TestPaginateToken(t *testing.T) {
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		"":  {[]int{1, 2}, "b"},
+		"b": {[]int{3, 4}, "c"},
+		"c": {[]int{5}, ""},
+	}
+	fetch := func(token string) ([]int, string, error) {
+		page := pages[token]
+		return page.items, page.next, nil
+	}
+
+	got, err := paginateToken(context.Background(), 0, fetch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paginateToken() = %v, want %v", got, want)
+	}
+
+	var limitErr *PaginationLimitError
+	if _, err := paginateToken(context.Background(), 4, fetch); !errors.As(err, &limitErr) || limitErr.Limit != 4 {
+		t.Errorf("over limit error = %v, want a *PaginationLimitError", err)
+	}
+	if got, err := paginateToken(context.Background(), 5, fetch); err != nil || len(got) != 5 {
+		t.Errorf("at limit: got %v, %v, want all 5 items", got, err)
+	}
+
+	// A token that doesn't advance ends the listing instead of looping.
+	calls := 0
+	got, err = paginateToken(context.Background(), 0, func(token string) ([]int, string, error) {
+		calls++
+		return []int{calls}, "same", nil
+	})
+	if err != nil || calls != 2 || !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("repeated token: got %v, %v after %d fetches", got, err, calls)
+	}
+}
+
+func TestSearchAllIssuesPages(t *testing.T) {
+	const total = 250
+	var startAts []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			StartAt    int `json:"startAt"`
+			MaxResults int `json:"maxResults"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		startAts = append(startAts, body.StartAt)
+
+		page := SearchResult{StartAt: body.StartAt, MaxResults: body.MaxResults, Total: total}
+		for i := body.StartAt; i < total && i < body.StartAt+body.MaxResults; i++ {
+			page.Issues = append(page.Issues, Issue{Key: fmt.Sprintf("PROJ-%d", i+1)})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues, err := c.SearchAllIssues(context.Background(), "project = PROJ", SearchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != total || issues[total-1].Key != "PROJ-250" {
+		t.Errorf("got %d issues, want %d ending in PROJ-250", len(issues), total)
+	}
+	if want := []int{0, 100, 200}; !reflect.DeepEqual(startAts, want) {
+		t.Errorf("startAt values = %v, want %v", startAts, want)
+	}
+
+	c.PaginationLimit = 200
+	var limitErr *PaginationLimitError
+	if _, err := c.SearchAllIssues(context.Background(), "project = PROJ", SearchOptions{}); !errors.As(err, &limitErr) || limitErr.Total != total {
+		t.Errorf("over limit error = %v, want a *PaginationLimitError with the total", err)
+	}
+
+	c.PaginationLimit = total
+	if issues, err := c.SearchAllIssues(context.Background(), "project = PROJ", SearchOptions{}); err != nil || len(issues) != total {
+		t.Errorf("at limit: got %d issues, %v, want all %d", len(issues), err, total)
+	}
+}
+
+func TestGetBoardsPagesUntilLast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		// The board listing reports no total; only isLast ends it.
+		page := boardPage{StartAt: startAt, IsLast: startAt >= 4}
+		for i := startAt; i < startAt+2 && i < 5; i++ {
+			page.Values = append(page.Values, Board{ID: int64(i + 1)})
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	boards, err := c.GetBoards(context.Background(), "PROJ", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boards) != 5 || boards[4].ID != 5 {
+		t.Errorf("got boards %+v, want IDs 1 to 5", boards)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// GetPriorities lists every priority on the site, in the site's order. It
+// uses the paginated search endpoint, since /priority doesn't report which
+// priority is the default. The result is cached per client since
+// priorities rarely change during a run.
+func (c *JiraClient) GetPriorities(ctx context.Context) ([]Priority, error) {
+	c.prioritiesMu.Lock()
+	defer c.prioritiesMu.Unlock()
+
+	if c.priorities != nil {
+		return c.priorities, nil
+	}
+
+	priorities, err := paginate(ctx, c.PaginationLimit, func(startAt int) ([]Priority, int, error) {
+		body, err := c.doRequest(ctx, "GET", "/priority/search?startAt="+strconv.Itoa(startAt), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total  int        `json:"total"`
+			Values []Priority `json:"values"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse priorities: %w", err)
+		}
+
+		return page.Values, page.Total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if priorities == nil {
+		priorities = []Priority{}
+	}
+
+	c.priorities = priorities
+	return c.priorities, nil
+}
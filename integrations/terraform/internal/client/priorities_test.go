@@ -0,0 +1,68 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetPriorities(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != "/rest/api/3/priority/search" {
+			http.NotFound(w, r)
+			return
+		}
+		// Two priorities per page, three in all.
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		values := []string{
+			`{"id":"1","name":"Highest","statusColor":"#d04437"}`,
+			`{"id":"3","name":"Medium","isDefault":true}`,
+			`{"id":"5","name":"Lowest"}`,
+		}
+		end := startAt + 2
+		if end > len(values) {
+			end = len(values)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":2,"total":3,"values":[%s]}`, startAt, strings.Join(values[startAt:end], ","))
+	}))
+	defer server.Close()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	priorities, err := c.GetPriorities(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Priority{
+		{ID: "1", Name: "Highest", StatusColor: "#d04437"},
+		{ID: "3", Name: "Medium", IsDefault: true},
+		{ID: "5", Name: "Lowest"},
+	}
+	if !reflect.DeepEqual(priorities, want) {
+		t.Errorf("GetPriorities() = %+v, want %+v", priorities, want)
+	}
+
+	// Later calls are served from the cache.
+	if _, err := c.GetPriorities(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("server got %d requests, want 2", n)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ProjectShortcut is a link shown in a project's sidebar.
+type ProjectShortcut struct {
+	ID   string `json:"-"`
+	URL  string `json:"url"`
+	Name string `json:"name"`
+	Icon string `json:"icon,omitempty"`
+}
+
+// projectShortcutJSON is a ProjectShortcut as the API sends it; the ID is a
+// string or a number depending on the deployment.
+type projectShortcutJSON struct {
+	ID   json.Number `json:"id"`
+	URL  string      `json:"url"`
+	Name string      `json:"name"`
+	Icon string      `json:"icon"`
+}
+
+func (s projectShortcutJSON) shortcut() ProjectShortcut {
+	return ProjectShortcut{ID: s.ID.String(), URL: s.URL, Name: s.Name, Icon: s.Icon}
+}
+
+// shortcutsURL returns the URL of a project's shortcuts. Shortcuts belong to
+// the projects API that backs the sidebar, not the platform REST API.
+func (c *JiraClient) shortcutsURL(projectKey string) string {
+	return c.siteURL + "/rest/projects/1.0/project/" + url.PathEscape(projectKey) + "/shortcut"
+}
+
+// GetProjectShortcuts lists a project's shortcuts in sidebar order.
+func (c *JiraClient) GetProjectShortcuts(ctx context.Context, projectKey string) ([]ProjectShortcut, error) {
+	body, err := c.do(ctx, "GET", c.shortcutsURL(projectKey), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page []projectShortcutJSON
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, fmt.Errorf("failed to parse project shortcuts: %w", err)
+	}
+
+	shortcuts := make([]ProjectShortcut, 0, len(page))
+	for _, s := range page {
+		shortcuts = append(shortcuts, s.shortcut())
+	}
+	return shortcuts, nil
+}
+
+// CreateProjectShortcut adds a shortcut to a project's sidebar.
+func (c *JiraClient) CreateProjectShortcut(ctx context.Context, projectKey string, shortcut *ProjectShortcut) (*ProjectShortcut, error) {
+	body, err := c.do(ctx, "POST", c.shortcutsURL(projectKey), shortcut, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var created projectShortcutJSON
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created project shortcut: %w", err)
+	}
+
+	result := created.shortcut()
+	return &result, nil
+}
+
+// UpdateProjectShortcut replaces a shortcut's URL, name, and icon.
+func (c *JiraClient) UpdateProjectShortcut(ctx context.Context, projectKey, id string, shortcut *ProjectShortcut) error {
+	_, err := c.do(ctx, "PUT", c.shortcutsURL(projectKey)+"/"+url.PathEscape(id), shortcut, nil)
+	return err
+}
+
+// DeleteProjectShortcut removes a shortcut from a project's sidebar.
+func (c *JiraClient) DeleteProjectShortcut(ctx context.Context, projectKey, id string) error {
+	_, err := c.do(ctx, "DELETE", c.shortcutsURL(projectKey)+"/"+url.PathEscape(id), nil, nil)
+	return err
+}
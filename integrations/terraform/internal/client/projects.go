@@ -0,0 +1,224 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Project types and templates accepted when creating a project.
+const (
+	ProjectTypeSoftware   = "software"
+	ProjectTypeBusiness   = "business"
+	ProjectTemplateKanban = "com.pyxis.greenhopper.jira:gh-simplified-kanban-classic"
+)
+
+// CreateProjectRequest is the request body for creating a project.
+type CreateProjectRequest struct {
+	Key                string `json:"key"`
+	Name               string `json:"name"`
+	Description        string `json:"description,omitempty"`
+	LeadAccountID      string `json:"leadAccountId"`
+	ProjectTypeKey     string `json:"projectTypeKey"`
+	ProjectTemplateKey string `json:"projectTemplateKey,omitempty"`
+}
+
+// UpdateProjectRequest is the request body for updating a project. Empty
+// fields are left unchanged.
+type UpdateProjectRequest struct {
+	Name          string  `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	LeadAccountID string  `json:"leadAccountId,omitempty"`
+}
+
+// Component represents a project component.
+type Component struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Project     string `json:"project,omitempty"`
+	Self        string `json:"self,omitempty"`
+}
+
+// Version represents a project version.
+type Version struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ProjectID   int64  `json:"projectId,omitempty"`
+	Released    bool   `json:"released,omitempty"`
+	Archived    bool   `json:"archived,omitempty"`
+	Self        string `json:"self,omitempty"`
+}
+
+// projectLookup is a cached result of GetProjectMetadata: the project, or
+// the not-found error Jira answered with.
+type projectLookup struct {
+	project *Project
+	err     error
+}
+
+// GetProjectMetadata retrieves a project with its issue types. Unlike
+// GetProject the result is cached per client, including a project that
+// doesn't exist, for plan-time checks that would otherwise read the same
+// project once per issue. Project writes drop the cached entry.
+func (c *JiraClient) GetProjectMetadata(ctx context.Context, key string) (*Project, error) {
+	c.projectMetadataMu.Lock()
+	defer c.projectMetadataMu.Unlock()
+
+	if lookup, ok := c.projectMetadata[key]; ok {
+		return lookup.project, lookup.err
+	}
+
+	project, err := c.GetProject(ctx, key)
+	if err != nil && !IsNotFound(err) {
+		return nil, err
+	}
+
+	if c.projectMetadata == nil {
+		c.projectMetadata = make(map[string]projectLookup)
+	}
+	c.projectMetadata[key] = projectLookup{project: project, err: err}
+	return project, err
+}
+
+// invalidateProjectMetadata drops the cached metadata of a project.
+func (c *JiraClient) invalidateProjectMetadata(key string) {
+	c.projectMetadataMu.Lock()
+	delete(c.projectMetadata, key)
+	c.projectMetadataMu.Unlock()
+}
+
+// CreateProject creates a project. Jira only returns the new project's ID
+// and key.
+func (c *JiraClient) CreateProject(ctx context.Context, req *CreateProjectRequest) (*Project, error) {
+	body, err := c.doRequest(ctx, "POST", "/project", req)
+	c.invalidateProjectMetadata(req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var created struct {
+		ID   json.Number `json:"id"`
+		Key  string      `json:"key"`
+		Self string      `json:"self"`
+	}
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created project: %w", err)
+	}
+
+	return &Project{ID: created.ID.String(), Key: created.Key, Self: created.Self}, nil
+}
+
+// UpdateProject updates a project's name, description, or lead.
+func (c *JiraClient) UpdateProject(ctx context.Context, key string, req *UpdateProjectRequest) error {
+	_, err := c.doRequest(ctx, "PUT", "/project/"+url.PathEscape(key), req)
+	c.invalidateProjectMetadata(key)
+	return err
+}
+
+// DeleteProject deletes a project with its components, versions, and
+// issues. With enableUndo the project goes to the trash, from which it can
+// be restored for 60 days; otherwise it is deleted permanently.
+func (c *JiraClient) DeleteProject(ctx context.Context, key string, enableUndo bool) error {
+	endpoint := "/project/" + url.PathEscape(key) + "?enableUndo=" + strconv.FormatBool(enableUndo)
+	_, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	c.invalidateProjectMetadata(key)
+	return err
+}
+
+// GetProjectProperty retrieves the value of a project entity property.
+func (c *JiraClient) GetProjectProperty(ctx context.Context, key, property string) (json.RawMessage, error) {
+	body, err := c.doRequest(ctx, "GET", "/project/"+url.PathEscape(key)+"/properties/"+url.PathEscape(property), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse project property: %w", err)
+	}
+
+	return result.Value, nil
+}
+
+// SetProjectProperty sets a project entity property to a JSON value.
+func (c *JiraClient) SetProjectProperty(ctx context.Context, key, property string, value interface{}) error {
+	_, err := c.doRequest(ctx, "PUT", "/project/"+url.PathEscape(key)+"/properties/"+url.PathEscape(property), value)
+	return err
+}
+
+// GetProjectComponents retrieves every component of a project.
+func (c *JiraClient) GetProjectComponents(ctx context.Context, key string) ([]Component, error) {
+	body, err := c.doRequest(ctx, "GET", "/project/"+url.PathEscape(key)+"/components", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var components []Component
+	if err := json.Unmarshal(body, &components); err != nil {
+		return nil, fmt.Errorf("failed to parse components: %w", err)
+	}
+
+	return components, nil
+}
+
+// CreateComponent creates a component in the project named by
+// component.Project.
+func (c *JiraClient) CreateComponent(ctx context.Context, component *Component) (*Component, error) {
+	body, err := c.doRequest(ctx, "POST", "/component", component)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Component
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created component: %w", err)
+	}
+
+	return &created, nil
+}
+
+// DeleteComponent deletes a component. Issues using it keep no component.
+func (c *JiraClient) DeleteComponent(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/component/"+url.PathEscape(id), nil)
+	return err
+}
+
+// GetProjectVersions retrieves every version of a project.
+func (c *JiraClient) GetProjectVersions(ctx context.Context, key string) ([]Version, error) {
+	body, err := c.doRequest(ctx, "GET", "/project/"+url.PathEscape(key)+"/versions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []Version
+	if err := json.Unmarshal(body, &versions); err != nil {
+		return nil, fmt.Errorf("failed to parse versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// CreateVersion creates a version in the project identified by
+// version.ProjectID.
+func (c *JiraClient) CreateVersion(ctx context.Context, version *Version) (*Version, error) {
+	body, err := c.doRequest(ctx, "POST", "/version", version)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Version
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created version: %w", err)
+	}
+
+	return &created, nil
+}
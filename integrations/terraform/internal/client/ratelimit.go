@@ -0,0 +1,392 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultRequestsPerSecond is the default request rate across all of a
+// client's requests, kept below Jira Cloud's per-user rate limits.
+const DefaultRequestsPerSecond = 20
+
+// maxWriteStreak is how many writes a prioritizing limiter lets through in a
+// row while a read is waiting, before the oldest read goes next.
+const maxWriteStreak = 4
+
+// Near-limit slowdown. When Jira reports that the client is close to its
+// rate limit, the limiter stretches its interval by nearLimitFactor until
+// the limit resets, or for nearLimitSlowdown when Jira doesn't say when
+// that is. Resets further out than maxNearLimitSlowdown are capped, so one
+// warning can't stall an apply.
+const (
+	nearLimitFactor      = 4
+	nearLimitSlowdown    = 10 * time.Second
+	maxNearLimitSlowdown = time.Minute
+)
+
+// RequestPriority orders requests waiting on a prioritizing RateLimiter.
+type RequestPriority int
+
+// Request priorities. Writes go ahead of reads, so a long refresh doesn't
+// hold back the changes being applied.
+const (
+	RequestPriorityLow RequestPriority = iota
+	RequestPriorityHigh
+)
+
+// methodPriority returns the priority of a request: reads are low, writes
+// are high.
+func methodPriority(method string) RequestPriority {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return RequestPriorityLow
+	}
+	return RequestPriorityHigh
+}
+
+// RateLimiter is a token bucket that spaces out requests. Every request the
+// client makes waits on it, so concurrent callers share a single budget. A
+// nil *RateLimiter doesn't limit.
+//
+// A prioritizing limiter queues waiting requests instead of serving them in
+// arrival order: high priority requests go first, but after maxWriteStreak
+// of them in a row the oldest waiting low priority request is let through,
+// so reads are never starved.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	burst    float64
+	tokens   float64
+	last     time.Time
+
+	// slowUntil is when a near-limit slowdown ends.
+	slowUntil time.Time
+
+	prioritize bool
+	queues     [2][]*limiterWaiter // indexed by RequestPriority
+	streak     int
+	timer      *time.Timer
+}
+
+// limiterWaiter is a request queued on a prioritizing limiter.
+type limiterWaiter struct {
+	ready    chan struct{}
+	promoted bool
+}
+
+// limiterWait describes how a request got through the limiter.
+type limiterWait struct {
+	// delay is how long the request was held back.
+	delay time.Duration
+
+	// promoted is set for low priority requests let ahead of waiting high
+	// priority ones to prevent starvation.
+	promoted bool
+}
+
+// NewRateLimiter creates a limiter allowing perSecond requests per second,
+// with bursts of up to perSecond requests.
+func NewRateLimiter(perSecond int) *RateLimiter {
+	return &RateLimiter{
+		interval: time.Second / time.Duration(perSecond),
+		burst:    float64(perSecond),
+		tokens:   float64(perSecond),
+		last:     time.Now(),
+	}
+}
+
+// NewPriorityRateLimiter creates a limiter like NewRateLimiter that serves
+// waiting high priority requests before low priority ones.
+func NewPriorityRateLimiter(perSecond int) *RateLimiter {
+	l := NewRateLimiter(perSecond)
+	l.prioritize = true
+	return l
+}
+
+// Wait blocks until a request may be made or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	_, err := l.wait(ctx, RequestPriorityHigh)
+	return err
+}
+
+// wait is Wait for a request of the given priority, additionally reporting
+// how the request got through.
+func (l *RateLimiter) wait(ctx context.Context, priority RequestPriority) (limiterWait, error) {
+	if l == nil {
+		return limiterWait{}, nil
+	}
+	if l.prioritize {
+		return l.waitQueued(ctx, priority)
+	}
+
+	l.mu.Lock()
+	l.refill(time.Now())
+
+	// Take the token now, even if it goes negative; the deficit is the wait.
+	l.tokens--
+	delay := time.Duration(-l.tokens * float64(l.currentInterval(time.Now())))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return limiterWait{}, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return limiterWait{}, ctx.Err()
+	case <-timer.C:
+		return limiterWait{delay: delay}, nil
+	}
+}
+
+// waitQueued queues a request until a token is handed to it.
+func (l *RateLimiter) waitQueued(ctx context.Context, priority RequestPriority) (limiterWait, error) {
+	start := time.Now()
+
+	l.mu.Lock()
+	l.refill(start)
+	if l.tokens >= 1 && len(l.queues[RequestPriorityHigh]) == 0 && len(l.queues[RequestPriorityLow]) == 0 {
+		l.tokens--
+		l.mu.Unlock()
+		return limiterWait{}, nil
+	}
+
+	w := &limiterWaiter{ready: make(chan struct{})}
+	l.queues[priority] = append(l.queues[priority], w)
+	l.schedule()
+	l.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return limiterWait{delay: time.Since(start), promoted: w.promoted}, nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		l.remove(priority, w)
+		l.mu.Unlock()
+		return limiterWait{}, ctx.Err()
+	}
+}
+
+// refill adds the tokens earned since the last refill, at the slowed down
+// rate for the part of that time within a near-limit slowdown. The caller
+// must hold l.mu.
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if l.slowUntil.After(l.last) {
+		slow := l.slowUntil.Sub(l.last)
+		if slow > elapsed {
+			slow = elapsed
+		}
+		l.tokens += float64(slow) / float64(l.interval*nearLimitFactor)
+		elapsed -= slow
+	}
+	l.tokens += float64(elapsed) / float64(l.interval)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+}
+
+// currentInterval returns the time it takes to earn a token at now. The
+// caller must hold l.mu.
+func (l *RateLimiter) currentInterval(now time.Time) time.Duration {
+	if now.Before(l.slowUntil) {
+		return l.interval * nearLimitFactor
+	}
+	return l.interval
+}
+
+// throttle slows the limiter down according to the rate limit state Jira
+// reported on a response, so concurrent requests back off before Jira
+// starts rejecting them instead of each running into a 429. A Retry-After
+// holds every request back for that long; a near-limit warning spends the
+// burst and starts a slowdown.
+func (l *RateLimiter) throttle(status RateLimitStatus) {
+	if l == nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	if status.NearLimit {
+		if l.tokens > 0 {
+			l.tokens = 0
+		}
+
+		until := now.Add(nearLimitSlowdown)
+		if status.Reset.After(now) {
+			until = status.Reset
+		}
+		if until.After(now.Add(maxNearLimitSlowdown)) {
+			until = now.Add(maxNearLimitSlowdown)
+		}
+		if until.After(l.slowUntil) {
+			l.slowUntil = until
+		}
+	}
+
+	if status.RetryAfter > 0 {
+		if deficit := -float64(status.RetryAfter) / float64(l.currentInterval(now)); l.tokens > deficit {
+			l.tokens = deficit
+		}
+	}
+
+	// A dispatch armed before the slowdown would fire early; it refills and
+	// reschedules, so queued requests still wait out the deficit.
+	l.schedule()
+}
+
+// schedule arms the dispatch timer for when the next token is due, unless
+// it is already armed or nothing is queued. The caller must hold l.mu.
+func (l *RateLimiter) schedule() {
+	if l.timer != nil || (len(l.queues[RequestPriorityHigh]) == 0 && len(l.queues[RequestPriorityLow]) == 0) {
+		return
+	}
+
+	delay := time.Duration((1 - l.tokens) * float64(l.currentInterval(time.Now())))
+	if delay < 0 {
+		delay = 0
+	}
+	l.timer = time.AfterFunc(delay, l.dispatch)
+}
+
+// dispatch hands the available tokens to queued requests.
+func (l *RateLimiter) dispatch() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.timer = nil
+	l.refill(time.Now())
+	for l.tokens >= 1 {
+		w := l.next()
+		if w == nil {
+			break
+		}
+		l.tokens--
+		close(w.ready)
+	}
+	l.schedule()
+}
+
+// next dequeues the request to serve next: the oldest high priority one,
+// unless maxWriteStreak of those went ahead of a waiting low priority
+// request. The caller must hold l.mu.
+func (l *RateLimiter) next() *limiterWaiter {
+	high, low := l.queues[RequestPriorityHigh], l.queues[RequestPriorityLow]
+	switch {
+	case len(high) > 0 && (len(low) == 0 || l.streak < maxWriteStreak):
+		l.queues[RequestPriorityHigh] = high[1:]
+		if len(low) > 0 {
+			l.streak++
+		}
+		return high[0]
+	case len(low) > 0:
+		l.queues[RequestPriorityLow] = low[1:]
+		low[0].promoted = len(high) > 0
+		l.streak = 0
+		return low[0]
+	}
+	return nil
+}
+
+// remove drops a cancelled request from its queue. A request that was
+// handed a token at the same time as it was cancelled is no longer queued,
+// and its token is spent. The caller must hold l.mu.
+func (l *RateLimiter) remove(priority RequestPriority, w *limiterWaiter) {
+	queue := l.queues[priority]
+	for i, queued := range queue {
+		if queued == w {
+			l.queues[priority] = append(queue[:i:i], queue[i+1:]...)
+			return
+		}
+	}
+}
+
+// RateLimitStatus is the rate limit state Jira reported on a response.
+type RateLimitStatus struct {
+	// NearLimit is set when Jira warned that the client is close to being
+	// throttled.
+	NearLimit bool
+
+	// Remaining and Limit are the requests left in the current window and
+	// the window's size, or -1 when Jira didn't report them.
+	Remaining int
+	Limit     int
+
+	// Reset is when the current window ends, or zero.
+	Reset time.Time
+
+	// RetryAfter is the wait Jira asked for, or zero.
+	RetryAfter time.Duration
+}
+
+// observeRateLimit feeds the rate limit state reported on a response into
+// the limiter and metrics, and logs it.
+func (c *JiraClient) observeRateLimit(ctx context.Context, span trace.Span, method, endpoint string, status RateLimitStatus) {
+	c.Limiter.throttle(status)
+	c.Metrics.recordRateLimit(status)
+
+	fields := map[string]any{
+		"method":     method,
+		"endpoint":   endpointTemplate(endpoint),
+		"near_limit": status.NearLimit,
+		"remaining":  status.Remaining,
+		"limit":      status.Limit,
+	}
+	if !status.Reset.IsZero() {
+		fields["reset"] = status.Reset.UTC().Format(time.RFC3339)
+	}
+	if status.RetryAfter > 0 {
+		fields["retry_after_ms"] = status.RetryAfter.Milliseconds()
+	}
+	tflog.Debug(ctx, "Jira reported rate limit state", fields)
+
+	if status.NearLimit {
+		addSpanEvent(span, "rate_limit.near_limit", attribute.Int("remaining", status.Remaining))
+	}
+}
+
+// parseRateLimitHeaders reads Jira Cloud's rate limit headers, reporting
+// false when a response carries none of them.
+func parseRateLimitHeaders(header http.Header) (RateLimitStatus, bool) {
+	status := RateLimitStatus{
+		NearLimit:  strings.EqualFold(header.Get("X-RateLimit-NearLimit"), "true"),
+		Remaining:  rateLimitCount(header.Get("X-RateLimit-Remaining")),
+		Limit:      rateLimitCount(header.Get("X-RateLimit-Limit")),
+		RetryAfter: parseRetryAfter(header.Get("Retry-After")),
+	}
+	if reset, err := time.Parse(time.RFC3339, header.Get("X-RateLimit-Reset")); err == nil {
+		status.Reset = reset
+	}
+
+	reported := status.NearLimit || status.Remaining >= 0 || status.Limit >= 0 ||
+		!status.Reset.IsZero() || status.RetryAfter > 0
+	return status, reported
+}
+
+// rateLimitCount parses a rate limit count header, returning -1 when it is
+// absent or malformed.
+func rateLimitCount(value string) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return -1
+	}
+	return n
+}
@@ -0,0 +1,329 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	reset := time.Date(2026, 10, 16, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		header       http.Header
+		want         RateLimitStatus
+		wantReported bool
+	}{
+		{
+			name:   "none",
+			header: http.Header{},
+			want:   RateLimitStatus{Remaining: -1, Limit: -1},
+		},
+		{
+			name: "near limit",
+			header: http.Header{
+				"X-Ratelimit-Nearlimit": {"true"},
+				"X-Ratelimit-Remaining": {"12"},
+				"X-Ratelimit-Limit":     {"350"},
+				"X-Ratelimit-Reset":     {reset.Format(time.RFC3339)},
+			},
+			want:         RateLimitStatus{NearLimit: true, Remaining: 12, Limit: 350, Reset: reset},
+			wantReported: true,
+		},
+		{
+			name:         "retry after",
+			header:       http.Header{"Retry-After": {"5"}},
+			want:         RateLimitStatus{Remaining: -1, Limit: -1, RetryAfter: 5 * time.Second},
+			wantReported: true,
+		},
+		{
+			name:         "zero remaining is reported",
+			header:       http.Header{"X-Ratelimit-Remaining": {"0"}},
+			want:         RateLimitStatus{Remaining: 0, Limit: -1},
+			wantReported: true,
+		},
+		{
+			name: "malformed",
+			header: http.Header{
+				"X-Ratelimit-Nearlimit": {"yes"},
+				"X-Ratelimit-Remaining": {"-3"},
+				"X-Ratelimit-Limit":     {"many"},
+				"X-Ratelimit-Reset":     {"soon"},
+			},
+			want: RateLimitStatus{Remaining: -1, Limit: -1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reported := parseRateLimitHeaders(tt.header)
+			if got != tt.want || reported != tt.wantReported {
+				t.Errorf("parseRateLimitHeaders() = %+v, %v, want %+v, %v", got, reported, tt.want, tt.wantReported)
+			}
+		})
+	}
+}
+
+func TestRateLimiterThrottleNearLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		reset        time.Duration
+		wantSlowdown time.Duration
+	}{
+		{"reset unknown", 0, nearLimitSlowdown},
+		{"reset reported", 3 * time.Second, 3 * time.Second},
+		{"reset capped", time.Hour, maxNearLimitSlowdown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewRateLimiter(100)
+			now := time.Now()
+			status := RateLimitStatus{NearLimit: true}
+			if tt.reset > 0 {
+				status.Reset = now.Add(tt.reset)
+			}
+			l.throttle(status)
+
+			if l.tokens > 0 {
+				t.Errorf("tokens = %v after a near-limit warning, want the burst spent", l.tokens)
+			}
+			if slow := l.slowUntil.Sub(now); slow < tt.wantSlowdown-time.Second || slow > tt.wantSlowdown+time.Second {
+				t.Errorf("slowdown lasts %v, want about %v", slow, tt.wantSlowdown)
+			}
+			if got, want := l.currentInterval(now), l.interval*nearLimitFactor; got != want {
+				t.Errorf("interval during the slowdown = %v, want %v", got, want)
+			}
+			if got := l.currentInterval(l.slowUntil); got != l.interval {
+				t.Errorf("interval after the slowdown = %v, want %v", got, l.interval)
+			}
+		})
+	}
+}
+
+func TestRateLimiterThrottleRetryAfter(t *testing.T) {
+	for _, prioritize := range []bool{false, true} {
+		l := NewRateLimiter(1000)
+		l.prioritize = prioritize
+		l.throttle(RateLimitStatus{RetryAfter: 100 * time.Millisecond})
+
+		start := time.Now()
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if waited := time.Since(start); waited < 80*time.Millisecond {
+			t.Errorf("prioritize=%v: request after Retry-After waited %v, want about 100ms", prioritize, waited)
+		}
+	}
+
+	// A nil limiter ignores rate limit state.
+	var none *RateLimiter
+	none.throttle(RateLimitStatus{NearLimit: true, RetryAfter: time.Hour})
+}
+
+// rateLimitedServer is a fake Jira enforcing a fixed-window rate limit: it
+// accepts capacity requests per window and rejects the rest with a 429.
+// With headers set it reports the limit state on every response, warning
+// once warnAfter requests of the window have been made.
+type rateLimitedServer struct {
+	window    time.Duration
+	capacity  int
+	warnAfter int
+	headers   bool
+
+	mu          sync.Mutex
+	windowStart time.Time
+	count       int
+	rejected    int
+}
+
+func (s *rateLimitedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.windowStart) >= s.window {
+		s.windowStart, s.count = now, 0
+	}
+	s.count++
+
+	if s.headers {
+		remaining := s.capacity - s.count
+		if remaining < 0 {
+			remaining = 0
+		}
+		w.Header().Set("X-RateLimit-Limit", strconv.Itoa(s.capacity))
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Reset", s.windowStart.Add(s.window).UTC().Format(time.RFC3339Nano))
+		if s.count >= s.warnAfter {
+			w.Header().Set("X-RateLimit-NearLimit", "true")
+		}
+	}
+
+	if s.count > s.capacity {
+		s.rejected++
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(`{"key":"PROJ-1"}`))
+}
+
+func TestRateLimitHeadersReduceRejections(t *testing.T) {
+	const requests = 40
+
+	// run makes the requests one after another through a limiter whose
+	// burst alone would overrun the server's window, and returns how many
+	// the server rejected.
+	run := func(headers bool) int {
+		s := &rateLimitedServer{window: 100 * time.Millisecond, capacity: 10, warnAfter: 5, headers: headers}
+		server := httptest.NewServer(s)
+		defer server.Close()
+
+		c, err := NewJiraClient(server.URL, "user", "token", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Limiter = NewRateLimiter(200)
+		c.Retry.MaxAttempts = 1
+
+		for i := 0; i < requests; i++ {
+			_, _ = c.GetIssue(context.Background(), "PROJ-1")
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.rejected
+	}
+
+	without := run(false)
+	with := run(true)
+	t.Logf("429s for %d requests: %d without rate limit headers, %d with them", requests, without, with)
+	if with >= without {
+		t.Errorf("429s with rate limit headers = %d, want fewer than the %d without them", with, without)
+	}
+}
+
+// BenchmarkRateLimiterWait measures a request getting through an unsaturated
+// limiter and having its wait recorded, with debug metrics off and on.
+func BenchmarkRateLimiterWait(b *testing.B) {
+	for _, bench := range []struct {
+		name    string
+		metrics *Metrics
+	}{
+		{"metrics=off", nil},
+		{"metrics=on", NewMetrics()},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			// A token per nanosecond keeps the limiter from ever waiting, so
+			// only its bookkeeping is measured.
+			l := NewRateLimiter(int(time.Second))
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				waited, err := l.wait(ctx, RequestPriorityLow)
+				if err != nil {
+					b.Fatal(err)
+				}
+				bench.metrics.recordLimiterWait(RequestPriorityLow, waited)
+			}
+		})
+	}
+}
+
+// BenchmarkRequestMetrics measures whole requests, which also record
+// per-endpoint metrics, with debug metrics off and on.
+func BenchmarkRequestMetrics(b *testing.B) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"key":"PROJ-1"}`))
+	}))
+	defer server.Close()
+
+	for _, bench := range []struct {
+		name    string
+		metrics *Metrics
+	}{
+		{"metrics=off", nil},
+		{"metrics=on", NewMetrics()},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			c, err := NewJiraClient(server.URL, "user", "token", true)
+			if err != nil {
+				b.Fatal(err)
+			}
+			c.Limiter = NewRateLimiter(int(time.Second))
+			c.Metrics = bench.metrics
+			ctx := context.Background()
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := c.GetIssue(ctx, "PROJ-1"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkPriorityQueueNext measures draining a prioritizing limiter's
+// queues of mixed reads and writes, starvation protection included.
+func BenchmarkPriorityQueueNext(b *testing.B) {
+	const queued = 1000
+	l := NewPriorityRateLimiter(DefaultRequestsPerSecond)
+	waiters := make([]*limiterWaiter, queued)
+	for i := range waiters {
+		waiters[i] = &limiterWaiter{}
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j, w := range waiters {
+			priority := RequestPriorityLow
+			if j%3 != 0 {
+				priority = RequestPriorityHigh
+			}
+			l.queues[priority] = append(l.queues[priority], w)
+		}
+		b.StartTimer()
+
+		for n := 0; n < queued; n++ {
+			if l.next() == nil {
+				b.Fatalf("queue drained after %d of %d requests", n, queued)
+			}
+		}
+	}
+}
+
+// BenchmarkPriorityRateLimiterContended measures concurrent requests of
+// both priorities queueing on a saturated prioritizing limiter.
+func BenchmarkPriorityRateLimiterContended(b *testing.B) {
+	// A million tokens a second without a burst keeps requests queueing
+	// without making the benchmark slow.
+	l := NewPriorityRateLimiter(1000000)
+	l.burst, l.tokens = 1, 0
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		priority := RequestPriorityLow
+		for pb.Next() {
+			if _, err := l.wait(ctx, priority); err != nil {
+				b.Error(err)
+				return
+			}
+			priority = 1 - priority
+		}
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxResponseBytes is the default limit on the decompressed size of a
+// single response body.
+const DefaultMaxResponseBytes = 64 << 20
+
+// ResponseTooLargeError is returned when a response body exceeds the client's
+// MaxResponseBytes. The request is abandoned rather than buffered.
+type ResponseTooLargeError struct {
+	Method   string
+	Endpoint string
+	Limit    int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response to %s %s is larger than the maximum response size of %d MB; narrow the request or raise max_response_mb",
+		e.Method, e.Endpoint, e.Limit>>20)
+}
+
+// limitedBody reads a response body, failing with a ResponseTooLargeError
+// once more than the limit has been read.
+type limitedBody struct {
+	r         io.Reader
+	remaining int64
+	err       *ResponseTooLargeError
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.remaining <= 0 {
+		// Probe for a byte past the limit so a body of exactly the limit
+		// still reads cleanly to EOF.
+		var probe [1]byte
+		n, err := l.r.Read(probe[:])
+		if n > 0 {
+			return 0, l.err
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > l.remaining {
+		p = p[:l.remaining]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// responseBody returns a reader over resp's decompressed body, capped at the
+// client's MaxResponseBytes. The caller still closes resp.Body.
+func (c *JiraClient) responseBody(resp *http.Response, method, endpoint string) (io.Reader, error) {
+	var body io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress response body: %w", err)
+		}
+		body = gz
+	}
+
+	limit := c.MaxResponseBytes
+	if limit <= 0 {
+		limit = DefaultMaxResponseBytes
+	}
+	return &limitedBody{
+		r:         body,
+		remaining: limit,
+		err:       &ResponseTooLargeError{Method: method, Endpoint: endpoint, Limit: limit},
+	}, nil
+}
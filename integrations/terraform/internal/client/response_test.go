@@ -0,0 +1,96 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// BenchmarkEachIssue measures paging through a large search, with each
+// page's long descriptions stream-decoded from plain and gzipped responses.
+func BenchmarkEachIssue(b *testing.B) {
+	const (
+		pages    = 10
+		pageSize = searchPageSize
+		total    = pages * pageSize
+	)
+
+	page := make([]Issue, pageSize)
+	for i := range page {
+		page[i] = Issue{
+			ID:  fmt.Sprint(10000 + i),
+			Key: fmt.Sprintf("PROJ-%d", i+1),
+			Fields: IssueFields{
+				Summary:     fmt.Sprintf("Issue %d", i+1),
+				Description: TextToADF(strings.Repeat("A long description line.\n", 20)),
+			},
+		}
+	}
+	plain, err := json.Marshal(SearchResult{MaxResults: pageSize, Total: total, Issues: page})
+	if err != nil {
+		b.Fatal(err)
+	}
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(plain); err != nil {
+		b.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		b.Fatal(err)
+	}
+
+	for _, bench := range []struct {
+		name string
+		gzip bool
+	}{
+		{"gzip=off", false},
+		{"gzip=on", true},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			// Every page has the same issues; the client only counts them.
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if bench.gzip {
+					w.Header().Set("Content-Encoding", "gzip")
+					_, _ = w.Write(compressed.Bytes())
+					return
+				}
+				_, _ = w.Write(plain)
+			}))
+			defer server.Close()
+
+			c, err := NewJiraClient(server.URL, "user", "token", true)
+			if err != nil {
+				b.Fatal(err)
+			}
+			c.Limiter = nil
+			ctx := context.Background()
+
+			b.SetBytes(int64(len(plain)) * pages)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				visited := 0
+				err := c.EachIssue(ctx, "project = PROJ", SearchOptions{Limit: total}, func(Issue) error {
+					visited++
+					return nil
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if visited != total {
+					b.Fatalf("visited %d issues, want %d", visited, total)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Retry defaults, sized to ride out Jira Cloud's short rate-limit windows
+// without stalling an apply for long.
+const (
+	DefaultRetryMaxAttempts = 4
+	DefaultRetryMaxWait     = 30 * time.Second
+)
+
+// retryBaseDelay is the backoff before the first retry; each further retry
+// doubles it.
+const retryBaseDelay = 500 * time.Millisecond
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including
+	// the first. Values below 2 disable retries.
+	MaxAttempts int
+
+	// MaxWait caps the wait before any single retry. A Retry-After longer
+	// than this ends the retries instead.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy new clients start with.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: DefaultRetryMaxAttempts, MaxWait: DefaultRetryMaxWait}
+}
+
+// shouldRetry reports whether a failed request may be retried. Rate limits
+// are always safe to retry, since Jira rejects the request before acting on
+// it, as are connections that were never established. Other failures are
+// only retried for idempotent methods, so a POST that may have created an
+// issue is never sent twice.
+func shouldRetry(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusTooManyRequests:
+			return true
+		case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return isIdempotent(method)
+		}
+		return false
+	}
+
+	var scopeErr *ScopeError
+	if errors.As(err, &scopeErr) {
+		return false
+	}
+
+	// A failed token source is never run again, so retrying can't help.
+	var tokenErr *TokenError
+	if errors.As(err, &tokenErr) {
+		return false
+	}
+
+	// An oversized response would only be oversized again.
+	var tooLarge *ResponseTooLargeError
+	if errors.As(err, &tooLarge) {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	// Any other transport error may have happened after Jira received the
+	// request.
+	return isIdempotent(method)
+}
+
+// isIdempotent reports whether repeating a request has the same effect as
+// making it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryDelay returns how long to wait before the given retry (1 for the
+// first), and false when the server asked for a longer wait than the policy
+// allows. Without a Retry-After header the delay backs off exponentially
+// with jitter, so parallel requests don't retry in lockstep.
+func (p RetryPolicy) retryDelay(retry int, err error) (time.Duration, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, apiErr.RetryAfter <= p.MaxWait
+	}
+
+	delay := retryBaseDelay << (retry - 1)
+	if delay <= 0 || delay > p.MaxWait {
+		delay = p.MaxWait
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1)), true
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date. It returns zero when the header is absent or malformed.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+	return 0
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
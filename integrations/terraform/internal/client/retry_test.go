@@ -0,0 +1,144 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	status := func(code int) error { return &APIError{StatusCode: code} }
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("connection reset")}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"rate limited write", http.MethodPost, status(http.StatusTooManyRequests), true},
+		{"server error read", http.MethodGet, status(http.StatusBadGateway), true},
+		{"server error idempotent write", http.MethodPut, status(http.StatusServiceUnavailable), true},
+		{"server error create", http.MethodPost, status(http.StatusServiceUnavailable), false},
+		{"client error", http.MethodGet, status(http.StatusBadRequest), false},
+		{"not found", http.MethodGet, status(http.StatusNotFound), false},
+		{"wrapped rate limit", http.MethodPost, fmt.Errorf("paging: %w", status(http.StatusTooManyRequests)), true},
+		{"scope error", http.MethodGet, &ScopeError{}, false},
+		{"token error", http.MethodGet, &TokenError{Err: errors.New("locked")}, false},
+		{"response too large", http.MethodGet, &ResponseTooLargeError{}, false},
+		{"dial failure create", http.MethodPost, fmt.Errorf("request failed: %w", dialErr), true},
+		{"transport failure read", http.MethodGet, fmt.Errorf("request failed: %w", readErr), true},
+		{"transport failure create", http.MethodPost, fmt.Errorf("request failed: %w", readErr), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldRetry(context.Background(), tt.method, tt.err); got != tt.want {
+				t.Errorf("shouldRetry(%s, %v) = %v, want %v", tt.method, tt.err, got, tt.want)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if shouldRetry(ctx, http.MethodGet, status(http.StatusTooManyRequests)) {
+		t.Error("shouldRetry() retried with a cancelled context")
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 4, MaxWait: 2 * time.Second}
+
+	for retry := 1; retry <= 4; retry++ {
+		full := retryBaseDelay << (retry - 1)
+		if full > policy.MaxWait {
+			full = policy.MaxWait
+		}
+		for i := 0; i < 20; i++ {
+			delay, ok := policy.retryDelay(retry, errors.New("reset"))
+			if !ok || delay < full/2 || delay > full {
+				t.Fatalf("retry %d delay = %s, %v, want within [%s, %s]", retry, delay, ok, full/2, full)
+			}
+		}
+	}
+
+	delay, ok := policy.retryDelay(1, &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Second})
+	if !ok || delay != time.Second {
+		t.Errorf("Retry-After delay = %s, %v, want 1s, true", delay, ok)
+	}
+	if _, ok := policy.retryDelay(1, &APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Minute}); ok {
+		t.Error("a Retry-After beyond MaxWait was retried")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter("7"); got != 7*time.Second {
+		t.Errorf("parseRetryAfter(seconds) = %s, want 7s", got)
+	}
+	if got := parseRetryAfter(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)); got < 59*time.Minute || got > time.Hour {
+		t.Errorf("parseRetryAfter(date) = %s, want about an hour", got)
+	}
+	for _, value := range []string{"", "0", "-3", "soon", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)} {
+		if got := parseRetryAfter(value); got != 0 {
+			t.Errorf("parseRetryAfter(%q) = %s, want 0", value, got)
+		}
+	}
+}
+
+func TestRequestRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		retryAfter   string
+		wantRequests int32
+		wantErr      bool
+	}{
+		{name: "read recovers", method: http.MethodGet, statuses: []int{503, 502, 200}, wantRequests: 3},
+		{name: "read gives up", method: http.MethodGet, statuses: []int{503, 503, 503, 503, 200}, wantRequests: 4, wantErr: true},
+		{name: "create not repeated", method: http.MethodPost, statuses: []int{503, 200}, wantRequests: 1, wantErr: true},
+		{name: "rate limited create", method: http.MethodPost, statuses: []int{429, 200}, wantRequests: 2},
+		{name: "Retry-After too long", method: http.MethodGet, statuses: []int{429, 200}, retryAfter: "3600", wantRequests: 1, wantErr: true},
+		{name: "client error", method: http.MethodGet, statuses: []int{400, 200}, wantRequests: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&requests, 1)
+				status := tt.statuses[n-1]
+				if status == http.StatusTooManyRequests && tt.retryAfter != "" {
+					w.Header().Set("Retry-After", tt.retryAfter)
+				}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			c, err := NewJiraClient(server.URL, "user", "token", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Retry.MaxWait = 10 * time.Millisecond
+
+			_, err = c.doRequest(context.Background(), tt.method, "/issue", nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("doRequest() error = %v, want error %v", err, tt.wantErr)
+			}
+			if n := atomic.LoadInt32(&requests); n != tt.wantRequests {
+				t.Errorf("server got %d requests, want %d", n, tt.wantRequests)
+			}
+		})
+	}
+}
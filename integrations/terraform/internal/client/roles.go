@@ -0,0 +1,168 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// ProjectRole represents a Jira project role definition.
+type ProjectRole struct {
+	ID          int64  `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Self        string `json:"self,omitempty"`
+}
+
+// PermissionScheme represents a permission scheme and its grants.
+type PermissionScheme struct {
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Permissions []PermissionGrant `json:"permissions,omitempty"`
+}
+
+// PermissionGrant grants a permission to a holder (a role, group, etc.).
+type PermissionGrant struct {
+	Permission string           `json:"permission"`
+	Holder     PermissionHolder `json:"holder"`
+}
+
+// PermissionHolder identifies who holds a permission grant. For project
+// roles, Parameter is the role ID.
+type PermissionHolder struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+// GetRoles retrieves all project role definitions.
+func (c *JiraClient) GetRoles(ctx context.Context) ([]ProjectRole, error) {
+	body, err := c.doRequest(ctx, "GET", "/role", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []ProjectRole
+	if err := json.Unmarshal(body, &roles); err != nil {
+		return nil, fmt.Errorf("failed to parse project roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// RoleIDByName returns the ID of the project role with the given name, or
+// zero when there is none. Roles are cached per client; CreateRole,
+// UpdateRole, and DeleteRole invalidate the cache.
+func (c *JiraClient) RoleIDByName(ctx context.Context, name string) (int64, error) {
+	c.rolesMu.Lock()
+	defer c.rolesMu.Unlock()
+
+	if c.roleIDs == nil {
+		roles, err := c.GetRoles(ctx)
+		if err != nil {
+			return 0, err
+		}
+		c.roleIDs = make(map[string]int64, len(roles))
+		for _, role := range roles {
+			c.roleIDs[role.Name] = role.ID
+		}
+	}
+
+	return c.roleIDs[name], nil
+}
+
+// invalidateRoles drops the cached role names.
+func (c *JiraClient) invalidateRoles() {
+	c.rolesMu.Lock()
+	c.roleIDs = nil
+	c.rolesMu.Unlock()
+}
+
+// GetRole retrieves a project role definition by ID.
+func (c *JiraClient) GetRole(ctx context.Context, id int64) (*ProjectRole, error) {
+	body, err := c.doRequest(ctx, "GET", "/role/"+strconv.FormatInt(id, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role ProjectRole
+	if err := json.Unmarshal(body, &role); err != nil {
+		return nil, fmt.Errorf("failed to parse project role: %w", err)
+	}
+
+	return &role, nil
+}
+
+// CreateRole creates a project role definition.
+func (c *JiraClient) CreateRole(ctx context.Context, role *ProjectRole) (*ProjectRole, error) {
+	body, err := c.doRequest(ctx, "POST", "/role", role)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateRoles()
+
+	var created ProjectRole
+	if err := json.Unmarshal(body, &created); err != nil {
+		return nil, fmt.Errorf("failed to parse created project role: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateRole replaces the name and description of a project role.
+func (c *JiraClient) UpdateRole(ctx context.Context, id int64, role *ProjectRole) (*ProjectRole, error) {
+	body, err := c.doRequest(ctx, "PUT", "/role/"+strconv.FormatInt(id, 10), role)
+	if err != nil {
+		return nil, err
+	}
+	c.invalidateRoles()
+
+	var updated ProjectRole
+	if err := json.Unmarshal(body, &updated); err != nil {
+		return nil, fmt.Errorf("failed to parse updated project role: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteRole deletes a project role definition. Jira refuses with a 409
+// while permission or notification schemes still reference the role.
+func (c *JiraClient) DeleteRole(ctx context.Context, id int64) error {
+	_, err := c.doRequest(ctx, "DELETE", "/role/"+strconv.FormatInt(id, 10), nil)
+	if err == nil {
+		c.invalidateRoles()
+	}
+	return err
+}
+
+// PermissionSchemesUsingRole returns the names of the permission schemes
+// that grant a permission to the given project role.
+func (c *JiraClient) PermissionSchemesUsingRole(ctx context.Context, id int64) ([]string, error) {
+	body, err := c.doRequest(ctx, "GET", "/permissionscheme?expand=permissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		PermissionSchemes []PermissionScheme `json:"permissionSchemes"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse permission schemes: %w", err)
+	}
+
+	roleID := strconv.FormatInt(id, 10)
+	var names []string
+	for _, scheme := range result.PermissionSchemes {
+		for _, grant := range scheme.Permissions {
+			if grant.Holder.Type == "projectRole" && grant.Holder.Parameter == roleID {
+				names = append(names, scheme.Name)
+				break
+			}
+		}
+	}
+
+	return names, nil
+}
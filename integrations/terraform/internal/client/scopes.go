@@ -0,0 +1,43 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ScopeError is returned when a scoped API token is rejected because it was
+// not granted the scope an endpoint requires. Jira reports this as a 401,
+// which is otherwise indistinguishable from bad credentials.
+type ScopeError struct {
+	Method   string
+	Endpoint string
+}
+
+func (e *ScopeError) Error() string {
+	return fmt.Sprintf("API error (401): the scoped API token is not authorized for %s %s; grant the token the scopes this endpoint requires or use an unscoped token", e.Method, e.Endpoint)
+}
+
+// IsScopeError reports whether err was caused by a scoped API token
+// lacking the scope an endpoint requires.
+func IsScopeError(err error) bool {
+	var scopeErr *ScopeError
+	return errors.As(err, &scopeErr)
+}
+
+// isScopeMismatch recognizes the body Atlassian returns when a scoped API
+// token is used against an endpoint outside its scopes.
+func isScopeMismatch(body []byte) bool {
+	return bytes.Contains(bytes.ToLower(body), []byte("scope does not match"))
+}
+
+// Probe performs a read-only GET against an endpoint and discards the
+// response. It is used to test which endpoint families a token can reach.
+func (c *JiraClient) Probe(ctx context.Context, endpoint string) error {
+	_, err := c.doRequest(ctx, "GET", endpoint, nil)
+	return err
+}
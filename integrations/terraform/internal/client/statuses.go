@@ -0,0 +1,198 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Status scope types accepted by the /statuses API.
+const (
+	StatusScopeGlobal  = "GLOBAL"
+	StatusScopeProject = "PROJECT"
+)
+
+// Status categories accepted by the /statuses API.
+const (
+	StatusCategoryToDo       = "TODO"
+	StatusCategoryInProgress = "IN_PROGRESS"
+	StatusCategoryDone       = "DONE"
+)
+
+// issueStatusCategories maps the status category keys reported on issues to
+// the category names used by the /statuses API.
+var issueStatusCategories = map[string]string{
+	"new":           StatusCategoryToDo,
+	"indeterminate": StatusCategoryInProgress,
+	"done":          StatusCategoryDone,
+}
+
+// Category returns the status category in /statuses API form (TODO,
+// IN_PROGRESS, DONE), or "" when unknown.
+func (s *Status) Category() string {
+	if s.StatusCategory == nil {
+		return ""
+	}
+	return issueStatusCategories[s.StatusCategory.Key]
+}
+
+// WorkflowStatus represents a status definition managed through the
+// /statuses API (as opposed to Status, which is the status of an issue).
+type WorkflowStatus struct {
+	ID             string       `json:"id,omitempty"`
+	Name           string       `json:"name,omitempty"`
+	StatusCategory string       `json:"statusCategory,omitempty"`
+	Description    string       `json:"description,omitempty"`
+	Scope          *StatusScope `json:"scope,omitempty"`
+}
+
+// StatusScope describes whether a status is global or owned by a
+// team-managed project.
+type StatusScope struct {
+	Type    string        `json:"type"`
+	Project *ProjectIDRef `json:"project,omitempty"`
+}
+
+// ProjectIDRef references a project by ID.
+type ProjectIDRef struct {
+	ID string `json:"id"`
+}
+
+// CreateStatusesRequest is the request body for creating statuses.
+type CreateStatusesRequest struct {
+	Scope    StatusScope      `json:"scope"`
+	Statuses []WorkflowStatus `json:"statuses"`
+}
+
+// UpdateStatusesRequest is the request body for updating statuses.
+type UpdateStatusesRequest struct {
+	Statuses []WorkflowStatus `json:"statuses"`
+}
+
+// GetStatuses retrieves statuses by ID. Unknown IDs are omitted from the
+// result rather than reported as errors.
+func (c *JiraClient) GetStatuses(ctx context.Context, ids []string) ([]WorkflowStatus, error) {
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("id", id)
+	}
+
+	body, err := c.doRequest(ctx, "GET", "/statuses?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []WorkflowStatus
+	if err := json.Unmarshal(body, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// CreateStatuses creates one or more statuses in the given scope.
+func (c *JiraClient) CreateStatuses(ctx context.Context, req *CreateStatusesRequest) ([]WorkflowStatus, error) {
+	body, err := c.doRequest(ctx, "POST", "/statuses", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []WorkflowStatus
+	if err := json.Unmarshal(body, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse created statuses: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// UpdateStatuses updates the name, category, and description of statuses.
+func (c *JiraClient) UpdateStatuses(ctx context.Context, req *UpdateStatusesRequest) error {
+	_, err := c.doRequest(ctx, "PUT", "/statuses", req)
+	return err
+}
+
+// DeleteStatuses deletes statuses by ID.
+func (c *JiraClient) DeleteStatuses(ctx context.Context, ids []string) error {
+	query := url.Values{}
+	for _, id := range ids {
+		query.Add("id", id)
+	}
+
+	_, err := c.doRequest(ctx, "DELETE", "/statuses?"+query.Encode(), nil)
+	return err
+}
+
+// GetStatus retrieves a single status by ID. It returns nil without an error
+// when the status does not exist.
+func (c *JiraClient) GetStatus(ctx context.Context, id string) (*WorkflowStatus, error) {
+	statuses, err := c.GetStatuses(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range statuses {
+		if statuses[i].ID == id {
+			return &statuses[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateStatus creates a single status in the given scope.
+func (c *JiraClient) CreateStatus(ctx context.Context, scope StatusScope, status WorkflowStatus) (*WorkflowStatus, error) {
+	statuses, err := c.CreateStatuses(ctx, &CreateStatusesRequest{
+		Scope:    scope,
+		Statuses: []WorkflowStatus{status},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) != 1 {
+		return nil, fmt.Errorf("expected 1 created status, got %d", len(statuses))
+	}
+
+	return &statuses[0], nil
+}
+
+// UpdateStatus updates a single status. The status ID must be set.
+func (c *JiraClient) UpdateStatus(ctx context.Context, status WorkflowStatus) error {
+	return c.UpdateStatuses(ctx, &UpdateStatusesRequest{
+		Statuses: []WorkflowStatus{status},
+	})
+}
+
+// DeleteStatus deletes a single status by ID.
+func (c *JiraClient) DeleteStatus(ctx context.Context, id string) error {
+	return c.DeleteStatuses(ctx, []string{id})
+}
+
+// IssueTypeStatuses lists the statuses an issue type's workflow uses in a
+// project.
+type IssueTypeStatuses struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Subtask  bool     `json:"subtask"`
+	Statuses []Status `json:"statuses"`
+}
+
+// GetProjectStatuses lists the statuses of a project, grouped by issue
+// type.
+func (c *JiraClient) GetProjectStatuses(ctx context.Context, projectKey string) ([]IssueTypeStatuses, error) {
+	body, err := c.doRequest(ctx, "GET", "/project/"+url.PathEscape(projectKey)+"/statuses", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var issueTypes []IssueTypeStatuses
+	if err := json.Unmarshal(body, &issueTypes); err != nil {
+		return nil, fmt.Errorf("failed to parse project statuses: %w", err)
+	}
+
+	return issueTypes, nil
+}
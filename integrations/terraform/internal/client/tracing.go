@@ -0,0 +1,72 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the client's spans.
+const tracerName = "github.com/spectra/terraform-provider-jira/internal/client"
+
+// Option configures optional client behavior in NewJiraClient.
+type Option func(*JiraClient)
+
+// WithTracerProvider traces every request the client makes with tp. Each
+// request gets a client span named after its method and endpoint template
+// (issue keys and IDs replaced with placeholders), carrying the response
+// status and rate-limit events.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *JiraClient) {
+		c.tracer = tp.Tracer(tracerName)
+	}
+}
+
+// startSpan starts the span for a request. It returns nil when tracing is
+// disabled, so untraced clients skip the work entirely.
+func (c *JiraClient) startSpan(ctx context.Context, method, rawURL string) trace.Span {
+	if c.tracer == nil {
+		return nil
+	}
+
+	template := endpointTemplate(requestPath(rawURL))
+
+	_, span := c.tracer.Start(ctx, method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.template", template),
+		),
+	)
+	return span
+}
+
+// finishSpan records the outcome of a request and ends its span. status is
+// zero when no response arrived.
+func finishSpan(span trace.Span, status int, err error) {
+	if span == nil {
+		return
+	}
+
+	if status != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// addSpanEvent adds an event to a request span, if the request is traced.
+func addSpanEvent(span trace.Span, name string, attrs ...attribute.KeyValue) {
+	if span == nil {
+		return
+	}
+	span.AddEvent(name, trace.WithAttributes(attrs...))
+}
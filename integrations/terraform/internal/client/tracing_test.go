@@ -0,0 +1,118 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRequestSpans(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/3/issue/PROJ-12" {
+			w.Header().Set("X-RateLimit-NearLimit", "true")
+			_, _ = w.Write([]byte(`{"key":"PROJ-12"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errorMessages":["Issue does not exist"]}`))
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer func() { _ = provider.Shutdown(context.Background()) }()
+
+	c, err := NewJiraClient(server.URL, "user", "token", true, WithTracerProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+
+	if _, err := c.GetIssue(context.Background(), "PROJ-12"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.doRequest(context.Background(), "DELETE", "/issue/10042", nil); err == nil {
+		t.Fatal("expected the missing issue to fail")
+	}
+
+	// Spans are exported as they end, so only ended spans are seen.
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	tests := []struct {
+		span       tracetest.SpanStub
+		name       string
+		template   string
+		statusCode int64
+		status     codes.Code
+		events     []string
+	}{
+		{spans[0], "GET /rest/api/3/issue/{key}", "/rest/api/3/issue/{key}", 200, codes.Unset, []string{"rate_limit.near_limit"}},
+		// The near-limit warning spent the burst, so the second request
+		// waits; the failure is recorded as an exception event.
+		{spans[1], "DELETE /rest/api/3/issue/{id}", "/rest/api/3/issue/{id}", 404, codes.Error, []string{"rate_limiter.wait", "exception"}},
+	}
+	for _, tt := range tests {
+		span := tt.span
+		if span.Name != tt.name || span.SpanKind != trace.SpanKindClient {
+			t.Errorf("span %q (kind %v), want client span %q", span.Name, span.SpanKind, tt.name)
+		}
+		attrs := attribute.NewSet(span.Attributes...)
+		if got, _ := attrs.Value("url.template"); got.AsString() != tt.template {
+			t.Errorf("%s url.template = %q, want %q", tt.name, got.AsString(), tt.template)
+		}
+		if got, _ := attrs.Value("http.response.status_code"); got.AsInt64() != tt.statusCode {
+			t.Errorf("%s status code = %d, want %d", tt.name, got.AsInt64(), tt.statusCode)
+		}
+		if span.Status.Code != tt.status {
+			t.Errorf("%s status = %v, want %v", tt.name, span.Status.Code, tt.status)
+		}
+		var events []string
+		for _, event := range span.Events {
+			events = append(events, event.Name)
+		}
+		if !reflect.DeepEqual(events, tt.events) {
+			t.Errorf("%s events = %v, want %v", tt.name, events, tt.events)
+		}
+		if span.EndTime.Before(span.StartTime) {
+			t.Errorf("%s ended at %v, before it started at %v", tt.name, span.EndTime, span.StartTime)
+		}
+	}
+}
+
+func TestUntracedClientStartsNoSpan(t *testing.T) {
+	c := &JiraClient{}
+	if span := c.startSpan(context.Background(), "GET", "https://example.atlassian.net/rest/api/3/myself"); span != nil {
+		t.Errorf("startSpan() = %v, want nil without a tracer", span)
+	}
+	// The span helpers accept the nil span.
+	addSpanEvent(nil, "event")
+	finishSpan(nil, 200, nil)
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/3/issue/PROJ-123":            "/rest/api/3/issue/{key}",
+		"/rest/api/2/issue/10001/comment/20002": "/rest/api/2/issue/{id}/comment/{id}",
+		"/rest/agile/1.0/board/7/sprint":        "/rest/agile/1.0/board/{id}/sprint",
+		"/rest/api/3/project/X1_Y-9/statuses":   "/rest/api/3/project/{key}/statuses",
+		"/rest/api/3/search":                    "/rest/api/3/search",
+	}
+	for path, want := range tests {
+		if got := endpointTemplate(path); got != want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
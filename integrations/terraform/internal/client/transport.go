@@ -0,0 +1,128 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultHTTPTimeout bounds a single HTTP request, including reading the
+// response body.
+const DefaultHTTPTimeout = 30 * time.Second
+
+// WithHTTPTimeout sets the timeout of every HTTP request the client makes.
+func WithHTTPTimeout(timeout time.Duration) Option {
+	return func(c *JiraClient) {
+		c.HTTPClient.Timeout = timeout
+	}
+}
+
+// WithProxy routes every request through proxy. Credentials in the URL's
+// user info are sent to the proxy as basic auth. Without this option the
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables apply.
+func WithProxy(proxy *url.URL) Option {
+	return func(c *JiraClient) {
+		c.transport().Proxy = http.ProxyURL(proxy)
+	}
+}
+
+// WithRootCAs verifies server certificates against roots instead of the
+// system certificate pool.
+func WithRootCAs(roots *x509.CertPool) Option {
+	return func(c *JiraClient) {
+		c.tlsConfig().RootCAs = roots
+	}
+}
+
+// WithInsecureSkipVerify disables server certificate verification. It is
+// meant for test instances only.
+func WithInsecureSkipVerify() Option {
+	return func(c *JiraClient) {
+		c.tlsConfig().InsecureSkipVerify = true
+	}
+}
+
+// CertPoolFromPEM returns the system certificate pool extended with every
+// certificate in a PEM bundle. A bundle without certificates, or with one
+// that doesn't parse, is an error.
+func CertPoolFromPEM(bundle []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	count := 0
+	for rest := bundle; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		count++
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %d in the bundle is invalid: %w", count, err)
+		}
+		pool.AddCert(cert)
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("the bundle contains no PEM-encoded certificates")
+	}
+	return pool, nil
+}
+
+// transport returns the client's own HTTP transport, replacing the shared
+// default transport with a copy on first use so options don't leak into
+// other clients.
+func (c *JiraClient) transport() *http.Transport {
+	if transport, ok := c.HTTPClient.Transport.(*http.Transport); ok {
+		return transport
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	c.HTTPClient.Transport = transport
+	return transport
+}
+
+// tlsConfig returns the TLS configuration of the client's transport.
+func (c *JiraClient) tlsConfig() *tls.Config {
+	transport := c.transport()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return transport.TLSClientConfig
+}
+
+// ParseProxyURL validates a proxy URL for WithProxy. Errors never include
+// the URL itself, since it may carry proxy credentials.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("the proxy URL could not be parsed; expected a URL such as http://proxy.example.com:8080")
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	case "":
+		return nil, fmt.Errorf("the proxy URL has no scheme; use http://, https:// or socks5://")
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q; use http, https or socks5", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return nil, fmt.Errorf("the proxy URL %s has no host", u.Redacted())
+	}
+	return u, nil
+}
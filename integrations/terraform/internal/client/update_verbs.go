@@ -0,0 +1,64 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+// Edit verbs accepted in the "update" section of an issue edit.
+const (
+	VerbAdd    = "add"
+	VerbRemove = "remove"
+	VerbSet    = "set"
+	VerbEdit   = "edit"
+)
+
+// FieldOperation is a single verb operation on a field, e.g. {"add": "x"}.
+type FieldOperation map[string]interface{}
+
+// UpdateVerbs maps field names to edit operations for the "update" section
+// of an issue edit, e.g. {"labels": [{"add": "x"}, {"remove": "y"}]}. Verbs
+// modify multi-value fields in place, avoiding read-modify-write races.
+type UpdateVerbs map[string][]FieldOperation
+
+// Omit removes a field from both sections of an update request, so the
+// update leaves the field unchanged. See IssueFields.Omit for the fields it
+// handles.
+func (r *UpdateIssueRequest) Omit(id string) {
+	r.Fields.Omit(id)
+	delete(r.Update, id)
+}
+
+// add appends an operation on a field.
+func (v UpdateVerbs) add(field, verb string, value interface{}) UpdateVerbs {
+	v[field] = append(v[field], FieldOperation{verb: value})
+	return v
+}
+
+// AddLabel adds a label, leaving the issue's other labels untouched.
+func (v UpdateVerbs) AddLabel(label string) UpdateVerbs {
+	return v.add("labels", VerbAdd, label)
+}
+
+// RemoveLabel removes a label, leaving the issue's other labels untouched.
+func (v UpdateVerbs) RemoveLabel(label string) UpdateVerbs {
+	return v.add("labels", VerbRemove, label)
+}
+
+// AddFixVersion adds a fix version by name.
+func (v UpdateVerbs) AddFixVersion(name string) UpdateVerbs {
+	return v.add("fixVersions", VerbAdd, map[string]string{"name": name})
+}
+
+// RemoveFixVersion removes a fix version by name.
+func (v UpdateVerbs) RemoveFixVersion(name string) UpdateVerbs {
+	return v.add("fixVersions", VerbRemove, map[string]string{"name": name})
+}
+
+// AddAffectsVersion adds an affects version by name.
+func (v UpdateVerbs) AddAffectsVersion(name string) UpdateVerbs {
+	return v.add("versions", VerbAdd, map[string]string{"name": name})
+}
+
+// RemoveAffectsVersion removes an affects version by name.
+func (v UpdateVerbs) RemoveAffectsVersion(name string) UpdateVerbs {
+	return v.add("versions", VerbRemove, map[string]string{"name": name})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateVerbsJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		req  UpdateIssueRequest
+		want string
+	}{
+		{
+			name: "verbs only",
+			req: UpdateIssueRequest{
+				Update: UpdateVerbs{}.AddLabel("a").RemoveLabel("b").AddLabel("c"),
+			},
+			want: `{"fields":{},"update":{"labels":[{"add":"a"},{"remove":"b"},{"add":"c"}]}}`,
+		},
+		{
+			name: "mixed fields",
+			req: UpdateIssueRequest{
+				Update: UpdateVerbs{}.
+					AddFixVersion("1.1").
+					RemoveFixVersion("1.0").
+					AddAffectsVersion("0.9").
+					RemoveAffectsVersion("0.8").
+					RemoveLabel("stale"),
+			},
+			want: `{"fields":{},"update":{` +
+				`"fixVersions":[{"add":{"name":"1.1"}},{"remove":{"name":"1.0"}}],` +
+				`"labels":[{"remove":"stale"}],` +
+				`"versions":[{"add":{"name":"0.9"}},{"remove":{"name":"0.8"}}]}}`,
+		},
+		{
+			name: "fields and verbs",
+			req: UpdateIssueRequest{
+				Fields: IssueFields{Summary: "New summary", Clear: []string{"duedate"}},
+				Update: UpdateVerbs{}.AddLabel("x"),
+			},
+			want: `{"fields":{"duedate":null,"summary":"New summary"},"update":{"labels":[{"add":"x"}]}}`,
+		},
+		{
+			name: "no verbs",
+			req:  UpdateIssueRequest{Fields: IssueFields{Summary: "s"}},
+			want: `{"fields":{"summary":"s"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(&tt.req)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateIssueRequestOmit(t *testing.T) {
+	req := UpdateIssueRequest{
+		Fields: IssueFields{
+			Priority: &Priority{Name: "High"},
+			Clear:    []string{"fixVersions", "duedate"},
+		},
+		Update: UpdateVerbs{}.AddFixVersion("1.0").AddLabel("a"),
+	}
+
+	req.Omit("fixVersions")
+	req.Omit("priority")
+
+	got, err := json.Marshal(&req)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `{"fields":{"duedate":null},"update":{"labels":[{"add":"a"}]}}`
+	if string(got) != want {
+		t.Errorf("Marshal() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// restSuffixes are API paths users commonly paste along with the site URL.
+// Anything from one of these onwards is stripped.
+var restSuffixes = []string{"/rest/api/", "/rest/agile/", "/rest/"}
+
+// cloudHostSuffix identifies Jira Cloud sites, which never have a context path.
+const cloudHostSuffix = ".atlassian.net"
+
+// URLError is returned when a Jira URL can't be used.
+type URLError struct {
+	URL    string
+	Reason string
+}
+
+func (e *URLError) Error() string {
+	return fmt.Sprintf("invalid Jira URL %q: %s", e.URL, e.Reason)
+}
+
+// IsURLError reports whether err was caused by an unusable Jira URL.
+func IsURLError(err error) bool {
+	var urlErr *URLError
+	return errors.As(err, &urlErr)
+}
+
+// NormalizeURL validates a Jira site URL and reduces it to the site root (plus
+// context path for self-hosted instances). A missing scheme defaults to https;
+// plain http is rejected unless allowHTTP is set. When a REST path or, on Jira
+// Cloud, a UI path was stripped, it is returned as stripped so callers can warn.
+func NormalizeURL(raw string, allowHTTP bool) (site, stripped string, err error) {
+	input := strings.TrimSpace(raw)
+	if input == "" {
+		return "", "", &URLError{URL: raw, Reason: "the URL is empty"}
+	}
+
+	if !strings.Contains(input, "://") {
+		input = "https://" + input
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		return "", "", &URLError{URL: raw, Reason: err.Error()}
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !allowHTTP {
+			return "", "", &URLError{URL: raw, Reason: "http is not allowed; use https, or set allow_http for test servers"}
+		}
+	default:
+		return "", "", &URLError{URL: raw, Reason: fmt.Sprintf("unsupported scheme %q; use https", u.Scheme)}
+	}
+
+	if u.Host == "" {
+		return "", "", &URLError{URL: raw, Reason: "the URL has no host"}
+	}
+	if u.User != nil {
+		return "", "", &URLError{URL: u.Redacted(), Reason: "credentials must not be embedded in the URL; use email and api_token"}
+	}
+	if u.RawQuery != "" || u.ForceQuery {
+		return "", "", &URLError{URL: raw, Reason: "the URL must not contain a query string"}
+	}
+	if u.Fragment != "" {
+		return "", "", &URLError{URL: raw, Reason: "the URL must not contain a fragment"}
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	lower := strings.ToLower(path)
+	for _, suffix := range restSuffixes {
+		if i := strings.Index(lower+"/", suffix); i >= 0 {
+			stripped = path[i:]
+			path = path[:i]
+			break
+		}
+	}
+	if strings.HasSuffix(strings.ToLower(u.Hostname()), cloudHostSuffix) && path != "" {
+		stripped = path + stripped
+		path = ""
+	}
+
+	return u.Scheme + "://" + u.Host + path, stripped, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// userBulkBatchSize is the maximum number of account IDs accepted by a
+// single bulk user request.
+const userBulkBatchSize = 90
+
+// GetUsersBulk retrieves users by account ID in as few requests as
+// possible. Account IDs without a profile (e.g. deleted users) are omitted
+// from the result.
+func (c *JiraClient) GetUsersBulk(ctx context.Context, accountIDs []string) ([]User, error) {
+	var users []User
+
+	for start := 0; start < len(accountIDs); start += userBulkBatchSize {
+		end := start + userBulkBatchSize
+		if end > len(accountIDs) {
+			end = len(accountIDs)
+		}
+		batch := accountIDs[start:end]
+
+		page, err := paginate(ctx, c.PaginationLimit, func(startAt int) ([]User, int, error) {
+			query := url.Values{}
+			query.Set("startAt", strconv.Itoa(startAt))
+			query.Set("maxResults", strconv.Itoa(userBulkBatchSize))
+			for _, id := range batch {
+				query.Add("accountId", id)
+			}
+
+			body, err := c.doRequest(ctx, "GET", "/user/bulk?"+query.Encode(), nil)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			var result struct {
+				Total  int    `json:"total"`
+				IsLast bool   `json:"isLast"`
+				Values []User `json:"values"`
+			}
+			if err := json.Unmarshal(body, &result); err != nil {
+				return nil, 0, fmt.Errorf("failed to parse users: %w", err)
+			}
+
+			return result.Values, result.Total, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page...)
+	}
+
+	return users, nil
+}
+
+// ResolveDisplayNames maps account IDs to display names using batched bulk
+// user lookups. Account IDs without a profile render as "Former user (id)".
+func (c *JiraClient) ResolveDisplayNames(ctx context.Context, accountIDs []string) (map[string]string, error) {
+	unique := make([]string, 0, len(accountIDs))
+	seen := make(map[string]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	users, err := c.GetUsersBulk(ctx, unique)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(unique))
+	for _, user := range users {
+		if user.DisplayName != "" {
+			names[user.AccountID] = user.DisplayName
+		}
+	}
+
+	for _, id := range unique {
+		if _, ok := names[id]; !ok {
+			names[id] = fmt.Sprintf("Former user (%s)", id)
+		}
+	}
+
+	return names, nil
+}
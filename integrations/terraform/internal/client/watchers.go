@@ -0,0 +1,46 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetWatchers lists the users watching an issue. Jira only includes the
+// watchers when the caller has the "View Voters and Watchers" project
+// permission, and answers 403 otherwise.
+func (c *JiraClient) GetWatchers(ctx context.Context, issueKey string) ([]User, error) {
+	var result struct {
+		Watchers []User `json:"watchers"`
+	}
+	if err := c.doRequestJSON(ctx, "GET", "/issue/"+issueKey+"/watchers", nil, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Watchers, nil
+}
+
+// AddWatcher adds a user to an issue's watchers. The user is an account ID,
+// or a username on REST API version 2. Adding someone other than the caller
+// requires the "Manage Watchers" project permission.
+func (c *JiraClient) AddWatcher(ctx context.Context, issueKey, user string) error {
+	// The request body is the bare JSON string.
+	_, err := c.doRequest(ctx, "POST", "/issue/"+issueKey+"/watchers", user)
+	return err
+}
+
+// RemoveWatcher removes a user, given as for AddWatcher, from an issue's
+// watchers.
+func (c *JiraClient) RemoveWatcher(ctx context.Context, issueKey, user string) error {
+	query := url.Values{}
+	if c.UsesADF() {
+		query.Set("accountId", user)
+	} else {
+		query.Set("username", user)
+	}
+
+	_, err := c.doRequest(ctx, "DELETE", "/issue/"+issueKey+"/watchers?"+query.Encode(), nil)
+	return err
+}
@@ -0,0 +1,166 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// MarkdownToWiki converts Markdown to Jira wiki markup, the rich-text
+// format of REST API version 2. It supports what MarkdownToADF does, going
+// through the same parse.
+func MarkdownToWiki(markdown string) string {
+	return ADFToWiki(MarkdownToADF(markdown))
+}
+
+// ADFToWiki converts Atlassian Document Format to Jira wiki markup. Nodes
+// without a wiki form render as their plain text, as in ADFToText. A string
+// is returned unchanged.
+func ADFToWiki(adf interface{}) string {
+	if str, ok := adf.(string); ok {
+		return str
+	}
+	if adf == nil {
+		return ""
+	}
+
+	// Round-trip through JSON so built and parsed documents share a shape.
+	var doc struct {
+		Content []interface{} `json:"content"`
+	}
+	raw, err := json.Marshal(adf)
+	if err != nil || json.Unmarshal(raw, &doc) != nil {
+		return ""
+	}
+	return wikiBlocks(doc.Content)
+}
+
+// wikiBlocks renders block nodes separated by blank lines.
+func wikiBlocks(nodes []interface{}) string {
+	blocks := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if text := wikiBlock(node); text != "" {
+			blocks = append(blocks, text)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// wikiBlock renders a single block node as wiki markup.
+func wikiBlock(node interface{}) string {
+	nodeMap, ok := node.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	content, _ := nodeMap["content"].([]interface{})
+	attrs, _ := nodeMap["attrs"].(map[string]interface{})
+
+	switch nodeMap["type"] {
+	case "paragraph":
+		return wikiInlineText(content)
+	case "heading":
+		level, _ := attrs["level"].(float64)
+		if level < 1 || level > 6 {
+			level = 1
+		}
+		return "h" + strconv.Itoa(int(level)) + ". " + wikiInlineText(content)
+	case "bulletList", "orderedList":
+		return wikiListText(nodeMap, "")
+	case "codeBlock":
+		open := "{code}"
+		if language, _ := attrs["language"].(string); language != "" {
+			open = "{code:" + language + "}"
+		}
+		return open + "\n" + wikiInlineText(content) + "\n{code}"
+	case "blockquote":
+		return "{quote}\n" + wikiBlocks(content) + "\n{quote}"
+	case "rule":
+		return "----"
+	default:
+		return extractText(node)
+	}
+}
+
+// wikiListText renders a bullet or ordered list. Wiki markup nests lists by
+// repeating markers, so prefix carries the markers of the enclosing lists.
+// Ordered lists always number from one.
+func wikiListText(list map[string]interface{}, prefix string) string {
+	marker := prefix + "*"
+	if list["type"] == "orderedList" {
+		marker = prefix + "#"
+	}
+
+	items, _ := list["content"].([]interface{})
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		itemMap, _ := item.(map[string]interface{})
+		children, _ := itemMap["content"].([]interface{})
+		var text, nested []string
+		for _, child := range children {
+			childMap, _ := child.(map[string]interface{})
+			switch childMap["type"] {
+			case "bulletList", "orderedList":
+				nested = append(nested, wikiListText(childMap, marker))
+			default:
+				text = append(text, strings.ReplaceAll(wikiBlock(child), "\n", " "))
+			}
+		}
+
+		lines = append(lines, marker+" "+strings.Join(text, " "))
+		lines = append(lines, nested...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wikiInlineText renders inline nodes, wrapping text in the wiki markup for
+// its marks.
+func wikiInlineText(nodes []interface{}) string {
+	var b strings.Builder
+	for _, node := range nodes {
+		nodeMap, ok := node.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if nodeMap["type"] != "text" {
+			b.WriteString(extractText(nodeMap))
+			continue
+		}
+
+		text, _ := nodeMap["text"].(string)
+		marks, _ := nodeMap["marks"].([]interface{})
+		var href string
+		var code, strong, em bool
+		for _, mark := range marks {
+			markMap, _ := mark.(map[string]interface{})
+			switch markMap["type"] {
+			case "code":
+				code = true
+			case "strong":
+				strong = true
+			case "em":
+				em = true
+			case "link":
+				attrs, _ := markMap["attrs"].(map[string]interface{})
+				href, _ = attrs["href"].(string)
+			}
+		}
+
+		if code {
+			text = "{{" + text + "}}"
+		}
+		if em {
+			text = "_" + text + "_"
+		}
+		if strong {
+			text = "*" + text + "*"
+		}
+		if href != "" {
+			text = "[" + text + "|" + href + "]"
+		}
+		b.WriteString(text)
+	}
+	return b.String()
+}
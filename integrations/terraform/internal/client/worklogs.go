@@ -0,0 +1,85 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Worklog represents time logged against a Jira issue.
+type Worklog struct {
+	ID               string      `json:"id,omitempty"`
+	Author           *User       `json:"author,omitempty"`
+	Comment          interface{} `json:"comment,omitempty"`
+	Started          string      `json:"started,omitempty"`
+	TimeSpent        string      `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int64       `json:"timeSpentSeconds,omitempty"`
+	Created          string      `json:"created,omitempty"`
+	Updated          string      `json:"updated,omitempty"`
+	Self             string      `json:"self,omitempty"`
+}
+
+// GetWorklogs retrieves every worklog on an issue.
+func (c *JiraClient) GetWorklogs(ctx context.Context, issueKey string) ([]Worklog, error) {
+	return paginate(ctx, c.PaginationLimit, func(startAt int) ([]Worklog, int, error) {
+		endpoint := "/issue/" + issueKey + "/worklog?startAt=" + strconv.Itoa(startAt)
+		body, err := c.doRequest(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var page struct {
+			Total    int       `json:"total"`
+			Worklogs []Worklog `json:"worklogs"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse worklogs: %w", err)
+		}
+
+		return page.Worklogs, page.Total, nil
+	})
+}
+
+// AddWorklog logs time against an issue. Set either TimeSpent, in Jira's
+// duration format (e.g. "3h 30m"), or TimeSpentSeconds. Started is
+// formatted with FormatTime, and Jira uses the current time when it is
+// empty.
+func (c *JiraClient) AddWorklog(ctx context.Context, issueKey string, worklog *Worklog) (*Worklog, error) {
+	var created Worklog
+	if err := c.doRequestJSON(ctx, "POST", "/issue/"+issueKey+"/worklog", worklog, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetWorklog retrieves a single worklog on an issue.
+func (c *JiraClient) GetWorklog(ctx context.Context, issueKey, id string) (*Worklog, error) {
+	var worklog Worklog
+	if err := c.doRequestJSON(ctx, "GET", "/issue/"+issueKey+"/worklog/"+id, nil, &worklog); err != nil {
+		return nil, err
+	}
+
+	return &worklog, nil
+}
+
+// UpdateWorklog replaces the time spent, start time, and comment of a
+// worklog.
+func (c *JiraClient) UpdateWorklog(ctx context.Context, issueKey, id string, worklog *Worklog) (*Worklog, error) {
+	var updated Worklog
+	if err := c.doRequestJSON(ctx, "PUT", "/issue/"+issueKey+"/worklog/"+id, worklog, &updated); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteWorklog deletes a worklog from an issue.
+func (c *JiraClient) DeleteWorklog(ctx context.Context, issueKey, id string) error {
+	_, err := c.doRequest(ctx, "DELETE", "/issue/"+issueKey+"/worklog/"+id, nil)
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxBusinessDays bounds n, about 380 years, since days are counted one at a
+// time and a huge n would stall the plan.
+const maxBusinessDays = 100000
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &AddBusinessDaysFunction{}
+
+// NewAddBusinessDaysFunction creates a new add_business_days function.
+func NewAddBusinessDaysFunction() function.Function {
+	return &AddBusinessDaysFunction{}
+}
+
+// AddBusinessDaysFunction defines the function implementation.
+type AddBusinessDaysFunction struct{}
+
+// Metadata returns the function name.
+func (f *AddBusinessDaysFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "add_business_days"
+}
+
+// Definition defines the function's parameters and return type.
+func (f *AddBusinessDaysFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Adds business days to a date.",
+		MarkdownDescription: `
+Returns the date ` + "`n`" + ` business days after ` + "`date`" + `, skipping Saturdays, Sundays and any
+of the given holidays. A negative ` + "`n`" + ` counts backwards, and zero returns ` + "`date`" + ` unchanged.
+
+Dates are calendar dates in YYYY-MM-DD format, the same format as ` + "`due_date`" + `, so the result
+doesn't depend on any time zone.
+
+` + "```hcl" + `
+resource "jira_issue" "follow_up" {
+  project    = "PROJ"
+  summary    = "Follow up on sprint goals"
+  issue_type = "Task"
+  due_date   = provider::jira::add_business_days(var.sprint_start, 5, ["2024-12-25", "2024-12-26"])
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "date",
+				Description: "The start date, in YYYY-MM-DD format.",
+			},
+			function.Int64Parameter{
+				Name:        "n",
+				Description: fmt.Sprintf("The number of business days to add; negative to subtract. At most %d either way.", maxBusinessDays),
+			},
+		},
+		VariadicParameter: function.ListParameter{
+			Name:        "holidays",
+			Description: "Optional list of holiday dates, in YYYY-MM-DD format, that aren't business days.",
+			ElementType: types.StringType,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the result.
+func (f *AddBusinessDaysFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		dateArg      string
+		n            int64
+		holidayLists [][]string
+	)
+	resp.Error = req.Arguments.Get(ctx, &dateArg, &n, &holidayLists)
+	if resp.Error != nil {
+		return
+	}
+
+	date, err := parseFunctionDate(dateArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if n > maxBusinessDays || n < -maxBusinessDays {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%d is outside the supported range of -%d to %d business days", n, maxBusinessDays, maxBusinessDays))
+		return
+	}
+
+	holidays := make(map[time.Time]bool)
+	for _, list := range holidayLists {
+		for _, value := range list {
+			holiday, err := parseFunctionDate(value)
+			if err != nil {
+				resp.Error = function.NewArgumentFuncError(2, "invalid holiday: "+err.Error())
+				return
+			}
+			holidays[holiday] = true
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, addBusinessDays(date, n, holidays).Format(dueDateLayout))
+}
+
+// addBusinessDays moves n business days from date, backwards when n is
+// negative. Weekends and holidays are skipped.
+func addBusinessDays(date time.Time, n int64, holidays map[time.Time]bool) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		if isBusinessDay(date, holidays) {
+			n--
+		}
+	}
+	return date
+}
+
+// isBusinessDay reports whether date is a weekday that isn't a holiday.
+func isBusinessDay(date time.Time, holidays map[time.Time]bool) bool {
+	switch date.Weekday() {
+	case time.Saturday, time.Sunday:
+		return false
+	}
+	return !holidays[date]
+}
+
+// parseFunctionDate parses a YYYY-MM-DD function argument as midnight UTC,
+// so date arithmetic never crosses a daylight saving change.
+func parseFunctionDate(value string) (time.Time, error) {
+	date, err := time.ParseInLocation(dueDateLayout, value, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a valid date in YYYY-MM-DD format", value)
+	}
+	return date, nil
+}
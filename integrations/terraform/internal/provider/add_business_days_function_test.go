@@ -0,0 +1,117 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	holidays := map[time.Time]bool{
+		mustFunctionDate(t, "2024-12-25"): true,
+		mustFunctionDate(t, "2024-12-26"): true,
+	}
+	newYear := map[time.Time]bool{mustFunctionDate(t, "2025-01-01"): true}
+
+	tests := []struct {
+		name     string
+		date     string
+		n        int64
+		holidays map[time.Time]bool
+		want     string
+	}{
+		{"zero", "2024-06-08", 0, nil, "2024-06-08"},
+		{"within week", "2024-06-03", 3, nil, "2024-06-06"},
+		{"over weekend", "2024-06-07", 1, nil, "2024-06-10"},
+		{"from saturday", "2024-06-08", 1, nil, "2024-06-10"},
+		{"two weeks", "2024-06-03", 10, nil, "2024-06-17"},
+		{"backwards over weekend", "2024-06-10", -1, nil, "2024-06-07"},
+		{"backwards from sunday", "2024-06-09", -2, nil, "2024-06-06"},
+		{"skips holidays", "2024-12-24", 1, holidays, "2024-12-27"},
+		{"backwards skips holidays", "2024-12-27", -1, holidays, "2024-12-24"},
+		{"holiday on weekend", "2024-12-20", 1, map[time.Time]bool{mustFunctionDate(t, "2024-12-21"): true}, "2024-12-23"},
+		{"into new year", "2024-12-31", 1, newYear, "2025-01-02"},
+		{"across year end", "2024-12-30", 5, newYear, "2025-01-07"},
+		{"backwards into old year", "2025-01-02", -1, newYear, "2024-12-31"},
+		{"backwards across year end", "2025-01-06", -3, newYear, "2024-12-31"},
+		{"new year without holiday", "2024-12-31", 1, nil, "2025-01-01"},
+		{"leap day", "2024-02-28", 2, nil, "2024-03-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addBusinessDays(mustFunctionDate(t, tt.date), tt.n, tt.holidays).Format(dueDateLayout)
+			if got != tt.want {
+				t.Errorf("addBusinessDays(%s, %d) = %s, want %s", tt.date, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddBusinessDaysFunctionRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      string
+		n         int64
+		holidays  []string
+		want      string
+		wantError string
+	}{
+		{name: "holidays", date: "2024-12-24", n: 2, holidays: []string{"2024-12-25", "2024-12-26"}, want: "2024-12-30"},
+		{name: "at bound", date: "2024-01-01", n: maxBusinessDays, want: "2407-04-23"},
+		{name: "above bound", date: "2024-01-01", n: maxBusinessDays + 1, wantError: "outside the supported range"},
+		{name: "below bound", date: "2024-01-01", n: -maxBusinessDays - 1, wantError: "outside the supported range"},
+		{name: "invalid date", date: "2024-13-01", n: 1, wantError: "not a valid date"},
+		{name: "invalid holiday", date: "2024-01-01", n: 1, holidays: []string{"soon"}, wantError: "invalid holiday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				holidayTypes  []attr.Type
+				holidayValues []attr.Value
+			)
+			if tt.holidays != nil {
+				holidayTypes = []attr.Type{types.ListType{ElemType: types.StringType}}
+				holidayValues = []attr.Value{types.ListValueMust(types.StringType, stringValues(tt.holidays))}
+			}
+			variadic := types.TupleValueMust(holidayTypes, holidayValues)
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.date), types.Int64Value(tt.n), variadic}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			(&AddBusinessDaysFunction{}).Run(context.Background(), req, resp)
+
+			if tt.wantError != "" {
+				if resp.Error == nil || !strings.Contains(resp.Error.Error(), tt.wantError) {
+					t.Fatalf("Run() error = %v, want one containing %q", resp.Error, tt.wantError)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run() error = %v", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("Run() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustFunctionDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	date, err := parseFunctionDate(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return date
+}
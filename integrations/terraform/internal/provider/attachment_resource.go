@@ -0,0 +1,347 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AttachmentResource{}
+var _ resource.ResourceWithModifyPlan = &AttachmentResource{}
+var _ resource.ResourceWithValidateConfig = &AttachmentResource{}
+
+// NewAttachmentResource creates a new attachment resource.
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+// AttachmentResource defines the resource implementation.
+type AttachmentResource struct {
+	client *client.JiraClient
+}
+
+// AttachmentResourceModel describes the resource data model.
+type AttachmentResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	IssueKey    types.String `tfsdk:"issue_key"`
+	FilePath    types.String `tfsdk:"file_path"`
+	Content     types.String `tfsdk:"content"`
+	Filename    types.String `tfsdk:"filename"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Size        types.Int64  `tfsdk:"size"`
+	MimeType    types.String `tfsdk:"mime_type"`
+}
+
+// Metadata returns the resource type name.
+func (r *AttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_attachment"
+}
+
+// Schema defines the schema for the resource.
+func (r *AttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a file as an attachment to a Jira issue." + scopesNote("jira_attachment"),
+		MarkdownDescription: `
+Uploads a file as an attachment to a Jira issue, from a local file or from inline content.
+Jira attachments can't be edited, so any change, including a change to the file's content,
+uploads a new attachment and deletes the old one.
+
+The file is read at plan time and only its SHA-256 hash is stored in state. If the
+attachment is deleted outside Terraform, it is uploaded again on the next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_attachment" "diagram" {
+  issue_key = jira_issue.tracking.key
+  file_path = "${path.module}/out/architecture.svg"
+}
+
+resource "jira_attachment" "sbom" {
+  issue_key = jira_issue.tracking.key
+  filename  = "sbom.json"
+  content   = jsonencode(local.sbom)
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The attachment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to attach the file to (e.g., PROJ-123). Changing this uploads a new attachment.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_path": schema.StringAttribute{
+				Description: "Path of the file to upload. Conflicts with content.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content to upload instead of a file. Requires filename.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filename": schema.StringAttribute{
+				Description: "Name of the attachment in Jira. Defaults to the base name of file_path.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "SHA-256 of the uploaded content, used to detect changes.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the attachment in bytes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"mime_type": schema.StringAttribute{
+				Description: "MIME type Jira detected for the attachment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig requires a filename for inline content.
+func (r *AttachmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AttachmentResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Content.IsNull() && data.Filename.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("filename"),
+			"Missing Filename",
+			"A filename must be set when the attachment is uploaded from content.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *AttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan hashes the content to upload, and replaces the attachment when
+// the hash differs from the uploaded one.
+func (r *AttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.FilePath.IsUnknown() || plan.Content.IsUnknown() {
+		return
+	}
+
+	if plan.Filename.IsUnknown() && !plan.FilePath.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("filename"), filepath.Base(plan.FilePath.ValueString()))...)
+	}
+
+	_, hash, err := attachmentContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to Read Attachment File", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), hash)...)
+
+	if !req.State.Raw.IsNull() && hash != state.ContentHash.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+// Create uploads the attachment and sets the initial Terraform state.
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, hash, err := attachmentContent(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file_path"), "Unable to Read Attachment File", err.Error())
+		return
+	}
+	if !data.ContentHash.IsUnknown() && hash != data.ContentHash.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file_path"),
+			"Attachment File Changed",
+			fmt.Sprintf("%s changed after the plan was created; run plan again.", data.FilePath.ValueString()),
+		)
+		return
+	}
+	if data.Filename.IsUnknown() {
+		data.Filename = types.StringValue(filepath.Base(data.FilePath.ValueString()))
+	}
+
+	tflog.Debug(ctx, "Uploading Jira attachment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"filename":  data.Filename.ValueString(),
+		"size":      len(content),
+	})
+
+	attachment, err := r.client.AddAttachment(ctx, data.IssueKey.ValueString(), data.Filename.ValueString(), content)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to upload attachment", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(attachment.ID)
+	data.ContentHash = types.StringValue(hash)
+	data.Size = types.Int64Value(attachment.Size)
+	data.MimeType = stringOrNull(attachment.MimeType)
+
+	tflog.Info(ctx, "Uploaded Jira attachment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        attachment.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira attachment", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	attachment, err := r.client.GetAttachment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read attachment", err.Error())
+		return
+	}
+
+	data.Size = types.Int64Value(attachment.Size)
+	data.MimeType = stringOrNull(attachment.MimeType)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, since every change uploads a new
+// attachment; it only carries the state forward.
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira attachment", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteAttachment(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete attachment", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira attachment", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// attachmentContent returns the content to upload, from file_path or
+// content, with its hash.
+func attachmentContent(data AttachmentResourceModel) ([]byte, string, error) {
+	content := []byte(data.Content.ValueString())
+	if !data.FilePath.IsNull() {
+		name := data.FilePath.ValueString()
+		var err error
+		content, err = os.ReadFile(name)
+		if err != nil {
+			resolved, absErr := filepath.Abs(name)
+			if absErr != nil {
+				resolved = name
+			}
+			return nil, "", fmt.Errorf("could not read %s (resolved to %s): %w", name, resolved, err)
+		}
+	}
+
+	sum := sha256.Sum256(content)
+	return content, "sha256:" + hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BoardDataSource{}
+
+// NewBoardDataSource creates a new board data source.
+func NewBoardDataSource() datasource.DataSource {
+	return &BoardDataSource{}
+}
+
+// BoardDataSource defines the data source implementation.
+type BoardDataSource struct {
+	client *client.JiraClient
+}
+
+// BoardDataSourceModel describes the data source data model.
+type BoardDataSourceModel struct {
+	Project types.String `tfsdk:"project"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	ID      types.Int64  `tfsdk:"id"`
+}
+
+// Metadata returns the data source type name.
+func (d *BoardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_board"
+}
+
+// Schema defines the schema for the data source.
+func (d *BoardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single Jira Software board by project, name, and type." + scopesNote("data.jira_board"),
+		MarkdownDescription: `
+Looks up a single Jira Software board, for example to get the ` + "`board_id`" + ` of a
+` + "`jira_sprint`" + `. The filters are those of ` + "`jira_boards`" + `; the read fails unless exactly
+one board matches.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_board" "team" {
+  project = "PROJ"
+  name    = "PROJ board"
+}
+
+resource "jira_sprint" "next" {
+  board_id = data.jira_board.team.id
+  name     = "Sprint 43"
+}
+` + "```" + `
+`,
+		Attributes: boardFilterAttributes(map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Description: "The board ID.",
+				Computed:    true,
+			},
+		}),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BoardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BoardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BoardDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira board", map[string]any{
+		"project": data.Project.ValueString(),
+		"name":    data.Name.ValueString(),
+		"type":    data.Type.ValueString(),
+	})
+
+	boards, err := findBoards(ctx, d.client, data.Project, data.Name, data.Type)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read boards", err.Error())
+		return
+	}
+
+	switch len(boards) {
+	case 0:
+		resp.Diagnostics.AddError("Board Not Found", "No board matches the given project, name, and type.")
+		return
+	case 1:
+	default:
+		matches := make([]string, len(boards))
+		for i, board := range boards {
+			matches[i] = fmt.Sprintf("%s (%d)", board.Name.ValueString(), board.ID.ValueInt64())
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Boards Found",
+			fmt.Sprintf("%d boards match: %s. Narrow the filters, or use jira_boards.", len(boards), strings.Join(matches, ", ")),
+		)
+		return
+	}
+
+	data.ID = boards[0].ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &BoardsDataSource{}
+
+// NewBoardsDataSource creates a new boards data source.
+func NewBoardsDataSource() datasource.DataSource {
+	return &BoardsDataSource{}
+}
+
+// BoardsDataSource defines the data source implementation.
+type BoardsDataSource struct {
+	client *client.JiraClient
+}
+
+// BoardsDataSourceModel describes the data source data model.
+type BoardsDataSourceModel struct {
+	Project types.String `tfsdk:"project"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Boards  []BoardModel `tfsdk:"boards"`
+}
+
+// BoardModel describes a single board.
+type BoardModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Type    types.String `tfsdk:"type"`
+	Project types.String `tfsdk:"project"`
+}
+
+// Metadata returns the data source type name.
+func (d *BoardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_boards"
+}
+
+// Schema defines the schema for the data source.
+func (d *BoardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Jira Software boards, optionally filtered by project, name, and type." + scopesNote("data.jira_boards"),
+		MarkdownDescription: `
+Lists Jira Software boards through the Agile API, paging through every match. Filters
+combine; ` + "`name`" + ` matches the whole board name, ignoring case.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_boards" "proj" {
+  project = "PROJ"
+  type    = "scrum"
+}
+
+output "board_ids" {
+  value = { for b in data.jira_boards.proj.boards : b.name => b.id }
+}
+` + "```" + `
+`,
+		Attributes: boardFilterAttributes(map[string]schema.Attribute{
+			"boards": schema.ListNestedAttribute{
+				Description: "The matching boards, in the order Jira returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: boardAttributes(),
+				},
+			},
+		}),
+	}
+}
+
+// boardFilterAttributes adds the board filter attributes shared by
+// jira_boards and jira_board to attrs.
+func boardFilterAttributes(attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["project"] = schema.StringAttribute{
+		Description: "Only boards of this project key.",
+		Optional:    true,
+	}
+	attrs["name"] = schema.StringAttribute{
+		Description: "Only boards with exactly this name, ignoring case.",
+		Optional:    true,
+	}
+	attrs["type"] = schema.StringAttribute{
+		Description: "Only boards of this type: scrum, kanban, or simple.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(client.BoardTypeScrum, client.BoardTypeKanban, "simple"),
+		},
+	}
+	return attrs
+}
+
+// boardAttributes returns the computed attributes describing a board.
+func boardAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "The board ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The board name.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The board type: scrum, kanban, or simple.",
+			Computed:    true,
+		},
+		"project": schema.StringAttribute{
+			Description: "Key of the project the board belongs to, or null for boards not located in a project.",
+			Computed:    true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *BoardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *BoardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BoardsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira boards", map[string]any{
+		"project": data.Project.ValueString(),
+		"name":    data.Name.ValueString(),
+		"type":    data.Type.ValueString(),
+	})
+
+	boards, err := findBoards(ctx, d.client, data.Project, data.Name, data.Type)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read boards", err.Error())
+		return
+	}
+
+	data.Boards = boards
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findBoards lists the boards matching the filters. Jira's name filter
+// matches substrings, so names are compared exactly here.
+func findBoards(ctx context.Context, c *client.JiraClient, project, name, boardType types.String) ([]BoardModel, error) {
+	boards, err := c.GetBoards(ctx, project.ValueString(), boardType.ValueString(), name.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]BoardModel, 0, len(boards))
+	for _, board := range boards {
+		if !name.IsNull() && !strings.EqualFold(board.Name, name.ValueString()) {
+			continue
+		}
+		projectKey := types.StringNull()
+		if board.Location != nil && board.Location.ProjectKey != "" {
+			projectKey = types.StringValue(board.Location.ProjectKey)
+		}
+		models = append(models, BoardModel{
+			ID:      types.Int64Value(board.ID),
+			Name:    types.StringValue(board.Name),
+			Type:    types.StringValue(board.Type),
+			Project: projectKey,
+		})
+	}
+	return models, nil
+}
@@ -0,0 +1,313 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// bulkLabelWorkers is the number of issues edited concurrently. All edits
+// still go through the client's rate limiter.
+const bulkLabelWorkers = 4
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BulkLabelResource{}
+
+// NewBulkLabelResource creates a new bulk label resource.
+func NewBulkLabelResource() resource.Resource {
+	return &BulkLabelResource{}
+}
+
+// BulkLabelResource defines the resource implementation.
+type BulkLabelResource struct {
+	client *client.JiraClient
+}
+
+// BulkLabelResourceModel describes the resource data model.
+type BulkLabelResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	JQL          types.String `tfsdk:"jql"`
+	AddLabels    types.Set    `tfsdk:"add_labels"`
+	RemoveLabels types.Set    `tfsdk:"remove_labels"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+
+	MatchedCount  types.Int64 `tfsdk:"matched_count"`
+	ModifiedCount types.Int64 `tfsdk:"modified_count"`
+	FailedKeys    types.List  `tfsdk:"failed_keys"`
+}
+
+// Metadata returns the resource type name.
+func (r *BulkLabelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_label"
+}
+
+// Schema defines the schema for the resource.
+func (r *BulkLabelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	labelValidators := []validator.Set{
+		setvalidator.SizeAtLeast(1),
+		setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^\S+$`), "labels can't contain whitespace")),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Adds or removes labels on every issue matching a JQL query, once at create time." + scopesNote("jira_bulk_label"),
+		MarkdownDescription: `
+Adds or removes labels on every issue matching a JQL query. The edit runs once when the
+resource is created; change ` + "`triggers`" + ` (or any other argument) to run it again.
+Destroying the resource only removes it from state; labels are not reverted.
+
+Labels are edited with Jira's ` + "`update`" + ` verbs, so other labels on each issue are left
+untouched. Failures on individual issues don't fail the apply; they are reported in
+` + "`failed_keys`" + ` and a warning.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_bulk_label" "decommission_legacy_api" {
+  jql           = "project = OPS AND component = legacy-api AND statusCategory != Done"
+  add_labels    = ["decommissioned"]
+  remove_labels = ["legacy-api-oncall"]
+
+  triggers = {
+    run = "2024-06-01"
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of this bulk edit run.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"jql": schema.StringAttribute{
+				Description: "JQL query selecting the issues to edit.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"add_labels": schema.SetAttribute{
+				Description: "Labels to add to every matching issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: append(labelValidators,
+					setvalidator.AtLeastOneOf(path.MatchRoot("remove_labels")),
+				),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"remove_labels": schema.SetAttribute{
+				Description: "Labels to remove from every matching issue.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  labelValidators,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that re-run the edit when changed.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"matched_count": schema.Int64Attribute{
+				Description: "Number of issues the JQL matched.",
+				Computed:    true,
+			},
+			"modified_count": schema.Int64Attribute{
+				Description: "Number of issues edited successfully.",
+				Computed:    true,
+			},
+			"failed_keys": schema.ListAttribute{
+				Description: "Keys of issues that could not be edited.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *BulkLabelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create runs the bulk edit and records its results.
+func (r *BulkLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BulkLabelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var add, remove []string
+	if !data.AddLabels.IsNull() {
+		resp.Diagnostics.Append(data.AddLabels.ElementsAs(ctx, &add, false)...)
+	}
+	if !data.RemoveLabels.IsNull() {
+		resp.Diagnostics.Append(data.RemoveLabels.ElementsAs(ctx, &remove, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	verbs := client.UpdateVerbs{}
+	for _, label := range add {
+		verbs.AddLabel(label)
+	}
+	for _, label := range remove {
+		verbs.RemoveLabel(label)
+	}
+
+	tflog.Debug(ctx, "Searching issues for bulk label edit", map[string]any{
+		"jql": data.JQL.ValueString(),
+	})
+
+	keys, err := r.client.SearchIssueKeys(ctx, data.JQL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to search issues", err.Error())
+		return
+	}
+
+	failed := r.applyVerbs(ctx, keys, verbs)
+
+	data.ID = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 10))
+	data.MatchedCount = types.Int64Value(int64(len(keys)))
+	data.ModifiedCount = types.Int64Value(int64(len(keys) - len(failed)))
+
+	failedKeys := make([]string, 0, len(failed))
+	for key := range failed {
+		failedKeys = append(failedKeys, key)
+	}
+	sort.Strings(failedKeys)
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, failedKeys)
+	resp.Diagnostics.Append(diags...)
+	data.FailedKeys = list
+
+	if len(failedKeys) > 0 {
+		first := failedKeys[0]
+		resp.Diagnostics.AddWarning(
+			"Some issues were not relabeled",
+			fmt.Sprintf("%d of %d matching issue(s) could not be edited. The first failure was %s: %s",
+				len(failedKeys), len(keys), first, failed[first]),
+		)
+	}
+
+	tflog.Info(ctx, "Applied bulk label edit", map[string]any{
+		"matched":  len(keys),
+		"modified": len(keys) - len(failed),
+		"failed":   len(failed),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// applyVerbs edits the issues concurrently and returns the failures by key.
+func (r *BulkLabelResource) applyVerbs(ctx context.Context, keys []string, verbs client.UpdateVerbs) map[string]string {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		failed = make(map[string]string)
+		work   = make(chan string)
+	)
+
+	for i := 0; i < bulkLabelWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				if err := r.client.UpdateIssueVerbs(ctx, key, verbs); err != nil {
+					mu.Lock()
+					failed[key] = err.Error()
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i, key := range keys {
+		if ctx.Err() != nil {
+			// Record the issues never attempted so the results stay accurate.
+			mu.Lock()
+			for _, skipped := range keys[i:] {
+				failed[skipped] = ctx.Err().Error()
+			}
+			mu.Unlock()
+			break
+		}
+		work <- key
+	}
+	close(work)
+	wg.Wait()
+
+	return failed
+}
+
+// Read keeps the recorded results; the edit is a one-time action.
+func (r *BulkLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BulkLabelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, since every argument forces a new
+// run; it only carries the state forward.
+func (r *BulkLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BulkLabelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the resource from state. Labels are not reverted.
+func (r *BulkLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing Jira bulk label edit from state; labels are not reverted")
+}
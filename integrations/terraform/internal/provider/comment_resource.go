@@ -0,0 +1,273 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CommentResource{}
+var _ resource.ResourceWithImportState = &CommentResource{}
+
+// NewCommentResource creates a new comment resource.
+func NewCommentResource() resource.Resource {
+	return &CommentResource{}
+}
+
+// CommentResource defines the resource implementation.
+type CommentResource struct {
+	client *client.JiraClient
+	links  *linkRewriter
+}
+
+// CommentResourceModel describes the resource data model.
+type CommentResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	IssueKey        types.String `tfsdk:"issue_key"`
+	Body            types.String `tfsdk:"body"`
+	AuthorAccountID types.String `tfsdk:"author_account_id"`
+	Created         types.String `tfsdk:"created"`
+}
+
+// Metadata returns the resource type name.
+func (r *CommentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_comment"
+}
+
+// Schema defines the schema for the resource.
+func (r *CommentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a comment on a Jira issue." + scopesNote("jira_comment"),
+		MarkdownDescription: `
+Manages a comment on a Jira issue. The body is plain text; blank lines separate paragraphs.
+
+If the comment or its issue is deleted outside Terraform, the comment is removed from state
+and recreated on the next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_comment" "provisioned" {
+  issue_key = jira_issue.story.key
+  body      = "Provisioned by Terraform, see ${var.run_url}"
+}
+` + "```" + `
+
+## Import
+
+Comments can be imported using the issue key and comment ID separated by a colon:
+
+` + "```bash" + `
+terraform import jira_comment.provisioned PROJ-123:10001
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The comment ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to comment on (e.g., PROJ-123). Changing this forces a new comment.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Description: "The comment text.",
+				Required:    true,
+			},
+			"author_account_id": schema.StringAttribute{
+				Description: "The account ID of the comment author.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Description: "When the comment was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CommentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.links = providerData.LinkRewriter
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *CommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CommentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+	})
+
+	comment, err := r.client.AddComment(ctx, data.IssueKey.ValueString(), r.client.FormatText(r.links.expand(data.Body.ValueString())))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create comment", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(comment.ID)
+	setCommentMetadata(&data, comment)
+
+	tflog.Info(ctx, "Created Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        comment.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *CommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CommentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	// Jira answers 404 both for a deleted comment and a deleted issue.
+	comment, err := r.client.GetComment(ctx, data.IssueKey.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read comment", err.Error())
+		return
+	}
+
+	data.Body = r.links.restore(client.ADFToText(comment.Body), data.Body)
+	setCommentMetadata(&data, comment)
+
+	resp.Diagnostics.Append(preserveADF(ctx, resp.Private, comment.Body)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update replaces the comment body in place.
+func (r *CommentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CommentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	body, diags := restoreADF(ctx, req.Private, r.client.FormatText(r.links.expand(data.Body.ValueString())))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	comment, err := r.client.UpdateComment(ctx, data.IssueKey.ValueString(), data.ID.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update comment", err.Error())
+		return
+	}
+
+	setCommentMetadata(&data, comment)
+
+	tflog.Info(ctx, "Updated Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *CommentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CommentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteComment(ctx, data.IssueKey.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete comment", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira comment", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+}
+
+// ImportState imports a comment from an "<issue key>:<comment id>" ID.
+func (r *CommentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	issueKey, id, ok := strings.Cut(req.ID, ":")
+	if !ok || issueKey == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <issue key>:<comment id> (e.g., PROJ-123:10001), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_key"), issueKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setCommentMetadata copies the computed author and creation time of a
+// comment into the model.
+func setCommentMetadata(data *CommentResourceModel, comment *client.Comment) {
+	data.AuthorAccountID = userAccountID(comment.Author)
+	data.Created = stringOrNull(comment.Created)
+}
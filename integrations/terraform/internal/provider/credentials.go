@@ -0,0 +1,127 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// validateCredentials checks the credentials by fetching the current user,
+// so a wrong token or email fails configure instead of the first resource
+// operation. The request goes through the configured client, honoring its
+// timeout and retry settings. Common setup mistakes are recognized by their
+// responses and reported with how to fix them. Failures other than those
+// and rejected credentials are only warned about, since the resources will
+// report them in context.
+func validateCredentials(ctx context.Context, jiraClient *client.JiraClient, url, email string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	user, err := jiraClient.GetCurrentUser(ctx)
+	mistake := credentialMistakeFor(err)
+	switch {
+	case err == nil:
+		tflog.Debug(ctx, "Validated Jira credentials", map[string]any{
+			"account_id": user.AccountID,
+		})
+	case errors.As(err, new(*client.TokenError)):
+		diags.AddError("Unable to Obtain Jira API Token", err.Error())
+	case mistake != nil:
+		diags.AddError(mistake.summary, fmt.Sprintf(mistake.detail, url, email)+"\n\nJira responded: "+err.Error())
+	case client.IsAuthError(err):
+		diags.AddError(
+			"Invalid Jira Credentials",
+			fmt.Sprintf("Jira at %s rejected the API token for %s: %s\n\n"+
+				"Check that the email matches the account the token was created for and that the token hasn't been revoked or expired.",
+				url, email, err.Error()),
+		)
+	case client.IsScopeError(err):
+		// A scoped token without read:jira-user can't read itself; the
+		// token is still valid.
+		tflog.Debug(ctx, "Skipped credential validation for scoped token", map[string]any{
+			"error": err.Error(),
+		})
+	default:
+		diags.AddWarning(
+			"Unable to Validate Jira Credentials",
+			fmt.Sprintf("Could not check the credentials for %s at %s: %s", email, url, err.Error()),
+		)
+	}
+
+	return diags
+}
+
+// credentialMistake is a common setup mistake, recognized by the response
+// the credential check gets when it is made.
+type credentialMistake struct {
+	// status, endpoint and body identify the response: its status code, a
+	// substring of the request path, and a lowercase substring of the
+	// error body. Empty strings match anything.
+	status   int
+	endpoint string
+	body     string
+
+	// summary and detail make up the diagnostic. detail is a format taking
+	// the site URL and the email, and says how to fix the mistake.
+	summary string
+	detail  string
+}
+
+// credentialMistakes lists the recognized setup mistakes, most specific
+// first.
+var credentialMistakes = []credentialMistake{
+	{
+		status:  http.StatusForbidden,
+		body:    "basic auth with password is not allowed",
+		summary: "Jira API Token Not Recognized",
+		detail: "Jira at %s treated the api_token for %s as a password, which happens when the token " +
+			"belongs to a different Atlassian account than the email, or was copied incompletely.\n\n" +
+			"Create a token while signed in as %[2]s at https://id.atlassian.com/manage-profile/security/api-tokens " +
+			"and check that email is that account's address.",
+	},
+	{
+		status:  http.StatusUnauthorized,
+		body:    "basic authentication with passwords is deprecated",
+		summary: "Jira Password Used Instead of API Token",
+		detail: "Jira at %s rejected the credentials for %s because api_token holds an account password. " +
+			"Jira Cloud only accepts API tokens for basic authentication.\n\n" +
+			"Create an API token at https://id.atlassian.com/manage-profile/security/api-tokens and use it as api_token.",
+	},
+	{
+		status:   http.StatusNotFound,
+		endpoint: "/rest/api/3/",
+		summary:  "Jira Cloud API Not Available",
+		detail: "%s has no Jira Cloud REST API (version 3), so the credentials for %s could not be checked. " +
+			"This usually means url points at Jira Server or Data Center, or at a path below the site root.\n\n" +
+			"Set url to the site root. For Jira Server and Data Center, set api_version to \"2\" (or \"auto\") " +
+			"and use the Jira username as email.",
+	},
+}
+
+// credentialMistakeFor returns the setup mistake that err, from the
+// credential check, is a symptom of, or nil if it isn't a recognized one.
+func credentialMistakeFor(err error) *credentialMistake {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	body := strings.ToLower(string(apiErr.Body))
+	for i := range credentialMistakes {
+		mistake := &credentialMistakes[i]
+		if mistake.status == apiErr.StatusCode &&
+			strings.Contains(apiErr.Endpoint, mistake.endpoint) &&
+			strings.Contains(body, mistake.body) {
+			return mistake
+		}
+	}
+	return nil
+}
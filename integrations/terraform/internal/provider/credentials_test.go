@@ -0,0 +1,138 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+func TestCredentialMistakeFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		endpoint string
+		body     string
+		want     string
+	}{
+		{
+			name:     "token treated as password",
+			status:   http.StatusForbidden,
+			endpoint: "/rest/api/3/myself",
+			body:     `{"message":"Basic auth with password is not allowed on this instance"}`,
+			want:     "Jira API Token Not Recognized",
+		},
+		{
+			name:     "password instead of token",
+			status:   http.StatusUnauthorized,
+			endpoint: "/rest/api/3/myself",
+			body:     "Basic authentication with passwords is deprecated. For more information, see the docs.",
+			want:     "Jira Password Used Instead of API Token",
+		},
+		{
+			name:     "no version 3 API",
+			status:   http.StatusNotFound,
+			endpoint: "/rest/api/3/myself",
+			body:     "<html><title>Not Found</title></html>",
+			want:     "Jira Cloud API Not Available",
+		},
+		{
+			name:     "forbidden for another reason",
+			status:   http.StatusForbidden,
+			endpoint: "/rest/api/3/myself",
+			body:     `{"errorMessages":["You do not have permission"]}`,
+		},
+		{
+			name:     "unauthorized for another reason",
+			status:   http.StatusUnauthorized,
+			endpoint: "/rest/api/3/myself",
+			body:     `{"errorMessages":["Client must be authenticated"]}`,
+		},
+		{
+			name:     "password message with another status",
+			status:   http.StatusBadRequest,
+			endpoint: "/rest/api/3/myself",
+			body:     "Basic authentication with passwords is deprecated.",
+		},
+		{
+			name:     "not found on another endpoint",
+			status:   http.StatusNotFound,
+			endpoint: "/rest/api/2/myself",
+			body:     "<html><title>Not Found</title></html>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &client.APIError{StatusCode: tt.status, Method: "GET", Endpoint: tt.endpoint, Body: []byte(tt.body), Message: tt.body}
+			mistake := credentialMistakeFor(err)
+			switch {
+			case tt.want == "" && mistake != nil:
+				t.Errorf("credentialMistakeFor() = %q, want no mistake", mistake.summary)
+			case tt.want != "" && (mistake == nil || mistake.summary != tt.want):
+				t.Errorf("credentialMistakeFor() = %+v, want %q", mistake, tt.want)
+			}
+		})
+	}
+
+	if mistake := credentialMistakeFor(context.DeadlineExceeded); mistake != nil {
+		t.Errorf("credentialMistakeFor(non-API error) = %q, want no mistake", mistake.summary)
+	}
+}
+
+func TestValidateCredentialsMistake(t *testing.T) {
+	const email = "dev@example.com"
+
+	for _, mistake := range credentialMistakes {
+		t.Run(mistake.summary, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/3/myself" {
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+				w.WriteHeader(mistake.status)
+				_, _ = w.Write([]byte(mistake.body))
+			}))
+			defer server.Close()
+
+			c, err := client.NewJiraClient(server.URL, email, "token", true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Retry.MaxAttempts = 1
+
+			diags := validateCredentials(context.Background(), c, server.URL, email)
+			if len(diags) != 1 || !diags.HasError() || diags[0].Summary() != mistake.summary {
+				t.Fatalf("diagnostics = %v, want the %q error", diags, mistake.summary)
+			}
+
+			detail := diags[0].Detail()
+			if !strings.Contains(detail, server.URL) || !strings.Contains(detail, email) {
+				t.Errorf("detail doesn't name the site and email: %q", detail)
+			}
+			if strings.Contains(detail, "%!") {
+				t.Errorf("detail has a formatting error: %q", detail)
+			}
+			if !strings.Contains(detail, "Jira responded: ") {
+				t.Errorf("detail doesn't include Jira's response: %q", detail)
+			}
+		})
+	}
+}
+
+func TestCredentialMistakeDetailNamesEmail(t *testing.T) {
+	// The token mistake repeats the email with an explicit argument index.
+	detail := fmt.Sprintf(credentialMistakes[0].detail, "https://example.atlassian.net", "dev@example.com")
+	if want := "Create a token while signed in as dev@example.com at https://id.atlassian.com/"; !strings.Contains(detail, want) {
+		t.Errorf("detail = %q, want it to contain %q", detail, want)
+	}
+	if strings.Contains(detail, "%!") {
+		t.Errorf("detail has a formatting error: %q", detail)
+	}
+}
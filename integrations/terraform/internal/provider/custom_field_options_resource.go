@@ -0,0 +1,450 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomFieldOptionsResource{}
+var _ resource.ResourceWithImportState = &CustomFieldOptionsResource{}
+var _ resource.ResourceWithValidateConfig = &CustomFieldOptionsResource{}
+
+// NewCustomFieldOptionsResource creates a new custom field options resource.
+func NewCustomFieldOptionsResource() resource.Resource {
+	return &CustomFieldOptionsResource{}
+}
+
+// CustomFieldOptionsResource defines the resource implementation.
+type CustomFieldOptionsResource struct {
+	client *client.JiraClient
+}
+
+// CustomFieldOptionsResourceModel describes the resource data model.
+type CustomFieldOptionsResourceModel struct {
+	ID        types.String             `tfsdk:"id"`
+	FieldID   types.String             `tfsdk:"field_id"`
+	ContextID types.String             `tfsdk:"context_id"`
+	Options   []CustomFieldOptionModel `tfsdk:"options"`
+	OptionIDs types.Map                `tfsdk:"option_ids"`
+}
+
+// CustomFieldOptionModel describes one option of a select list.
+type CustomFieldOptionModel struct {
+	Value    types.String `tfsdk:"value"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+// Metadata returns the resource type name.
+func (r *CustomFieldOptionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field_options"
+}
+
+// Schema defines the schema for the resource.
+func (r *CustomFieldOptionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the ordered option list of a select list custom field context." + scopesNote("jira_custom_field_options"),
+		MarkdownDescription: `
+Manages the ordered option list of a select list (single or multi) custom field context. The
+resource owns the whole list: options missing from the configuration are deleted, new ones are
+added, and the list is reordered to match. Options are matched by value, so changing a value
+deletes the old option and creates a new one.
+
+Disable an option to hide it from new selections while keeping it on existing issues. Jira
+refuses to delete an option that issues still use; migrate those issues to another option first,
+or disable it instead.
+
+Child options of cascading select lists are not managed.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_custom_field_options" "affected_service" {
+  field_id   = "customfield_10050"
+  context_id = "10100"
+
+  options = [
+    for service in local.services : { value = service.name, disabled = service.retired }
+  ]
+}
+` + "```" + `
+
+## Import
+
+Option lists can be imported using the field ID and context ID separated by a colon:
+
+` + "```bash" + `
+terraform import jira_custom_field_options.affected_service customfield_10050:10100
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The field ID and context ID, separated by a colon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				Description: "ID of the select list custom field (e.g. customfield_10050). Changing this forces a new resource.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^customfield_\d+$`), "must be a custom field ID such as customfield_10050"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context_id": schema.StringAttribute{
+				Description: "ID of the field context whose options are managed. Changing this forces a new resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"options": schema.ListNestedAttribute{
+				Description: "The options in display order. Values must be unique.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The option text.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Hide the option from new selections while keeping it on existing issues. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"option_ids": schema.MapAttribute{
+				Description: "Option IDs keyed by value, for setting the field on issues by ID.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects duplicate option values, which Jira doesn't allow
+// within a context.
+func (r *CustomFieldOptionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(data.Options))
+	for i, option := range data.Options {
+		if option.Value.IsNull() || option.Value.IsUnknown() {
+			continue
+		}
+		value := option.Value.ValueString()
+		if seen[value] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("options").AtListIndex(i).AtName("value"),
+				"Duplicate Option",
+				fmt.Sprintf("The option %q is listed more than once. Option values must be unique within a field context.", value),
+			)
+		}
+		seen[value] = true
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *CustomFieldOptionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create converges the context's options to the configured list.
+func (r *CustomFieldOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira custom field options", map[string]any{
+		"field_id":   data.FieldID.ValueString(),
+		"context_id": data.ContextID.ValueString(),
+		"options":    len(data.Options),
+	})
+
+	data.ID = types.StringValue(data.FieldID.ValueString() + ":" + data.ContextID.ValueString())
+	r.converge(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Created Jira custom field options", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the option list, in Jira's order.
+func (r *CustomFieldOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira custom field options", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	options, err := r.client.GetFieldOptions(ctx, data.FieldID.ValueString(), data.ContextID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read custom field options", err.Error())
+		return
+	}
+
+	data.Options = nil
+	ids := make(map[string]string)
+	for _, option := range topLevelOptions(options) {
+		data.Options = append(data.Options, CustomFieldOptionModel{
+			Value:    types.StringValue(option.Value),
+			Disabled: types.BoolValue(option.Disabled),
+		})
+		ids[option.Value] = option.ID
+	}
+
+	optionIDs, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.OptionIDs = optionIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update converges the context's options to the configured list.
+func (r *CustomFieldOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira custom field options", map[string]any{
+		"id":      data.ID.ValueString(),
+		"options": len(data.Options),
+	})
+
+	r.converge(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira custom field options", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the managed options.
+func (r *CustomFieldOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomFieldOptionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira custom field options", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	fieldID, contextID := data.FieldID.ValueString(), data.ContextID.ValueString()
+	options, err := r.client.GetFieldOptions(ctx, fieldID, contextID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read custom field options", err.Error())
+		return
+	}
+
+	managed := make(map[string]bool, len(data.Options))
+	for _, option := range data.Options {
+		managed[option.Value.ValueString()] = true
+	}
+	for _, option := range topLevelOptions(options) {
+		if !managed[option.Value] {
+			continue
+		}
+		if err := r.client.DeleteFieldOption(ctx, fieldID, contextID, option); err != nil && !client.IsNotFound(err) {
+			addOptionDeleteError(&resp.Diagnostics, err)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted Jira custom field options", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports an option list by field ID and context ID.
+func (r *CustomFieldOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fieldID, contextID, ok := strings.Cut(req.ID, ":")
+	if !ok || fieldID == "" || contextID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected a field ID and context ID such as customfield_10050:10100, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), fieldID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context_id"), contextID)...)
+}
+
+// converge creates, updates, deletes and reorders options until the
+// context matches data.Options, then records the option IDs. Options are
+// created before any are deleted so a failed delete leaves every
+// configured option in place.
+func (r *CustomFieldOptionsResource) converge(ctx context.Context, data *CustomFieldOptionsResourceModel, diags *diag.Diagnostics) {
+	fieldID, contextID := data.FieldID.ValueString(), data.ContextID.ValueString()
+	current, err := r.client.GetFieldOptions(ctx, fieldID, contextID)
+	if err != nil {
+		diags.AddError("Failed to read custom field options", err.Error())
+		return
+	}
+	current = topLevelOptions(current)
+
+	byValue := make(map[string]client.FieldOption, len(current))
+	for _, option := range current {
+		byValue[option.Value] = option
+	}
+
+	var create, update []client.FieldOption
+	wanted := make(map[string]bool, len(data.Options))
+	for _, option := range data.Options {
+		value, disabled := option.Value.ValueString(), option.Disabled.ValueBool()
+		wanted[value] = true
+		existing, ok := byValue[value]
+		switch {
+		case !ok:
+			create = append(create, client.FieldOption{Value: value, Disabled: disabled})
+		case existing.Disabled != disabled:
+			update = append(update, client.FieldOption{ID: existing.ID, Value: value, Disabled: disabled})
+		}
+	}
+
+	created, err := r.client.CreateFieldOptions(ctx, fieldID, contextID, create)
+	if err != nil {
+		diags.AddError("Failed to create custom field options", err.Error())
+		return
+	}
+	if err := r.client.UpdateFieldOptions(ctx, fieldID, contextID, update); err != nil {
+		diags.AddError("Failed to update custom field options", err.Error())
+		return
+	}
+
+	// order tracks the list as Jira now has it: surviving options in their
+	// old order, then the new ones.
+	var order []string
+	for _, option := range current {
+		if wanted[option.Value] {
+			order = append(order, option.ID)
+			continue
+		}
+		if err := r.client.DeleteFieldOption(ctx, fieldID, contextID, option); err != nil && !client.IsNotFound(err) {
+			addOptionDeleteError(diags, err)
+			return
+		}
+	}
+	for _, option := range created {
+		byValue[option.Value] = option
+		order = append(order, option.ID)
+	}
+
+	ids := make(map[string]string, len(data.Options))
+	desired := make([]string, 0, len(data.Options))
+	for _, option := range data.Options {
+		id := byValue[option.Value.ValueString()].ID
+		ids[option.Value.ValueString()] = id
+		desired = append(desired, id)
+	}
+
+	if strings.Join(order, ",") != strings.Join(desired, ",") {
+		if err := r.client.ReorderFieldOptions(ctx, fieldID, contextID, desired); err != nil {
+			diags.AddError("Failed to reorder custom field options", err.Error())
+			return
+		}
+	}
+
+	optionIDs, mapDiags := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(mapDiags...)
+	data.OptionIDs = optionIDs
+}
+
+// topLevelOptions drops the child options of cascading select lists.
+func topLevelOptions(options []client.FieldOption) []client.FieldOption {
+	var top []client.FieldOption
+	for _, option := range options {
+		if option.OptionID == "" {
+			top = append(top, option)
+		}
+	}
+	return top
+}
+
+// addOptionDeleteError reports a failed option delete, explaining how to
+// proceed when the option is still in use.
+func addOptionDeleteError(diags *diag.Diagnostics, err error) {
+	var inUse *client.OptionInUseError
+	if errors.As(err, &inUse) {
+		diags.AddError("Custom field option is still in use", inUse.Error())
+		return
+	}
+	diags.AddError("Failed to delete custom field option", err.Error())
+}
@@ -0,0 +1,240 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// cascadePrefix starts the custom_fields shorthand for cascading select
+// values: "cascade:Parent/Child", or "cascade:Parent" for no child.
+const cascadePrefix = "cascade:"
+
+// resolveCustomFields maps custom_fields keys, which may be field names or
+// IDs, to field IDs and their JSON values. Cascading select shorthand is
+// expanded to the JSON Jira expects.
+func resolveCustomFields(ctx context.Context, c *client.JiraClient, values types.Map) (map[string]json.RawMessage, error) {
+	resolved := make(map[string]json.RawMessage, len(values.Elements()))
+	for key, value := range values.Elements() {
+		id, err := c.CustomFieldID(ctx, key)
+		if err != nil {
+			return nil, fmt.Errorf("custom_fields[%q]: %w", key, err)
+		}
+		str, _ := value.(types.String)
+		if !strings.HasPrefix(str.ValueString(), cascadePrefix) {
+			resolved[id] = json.RawMessage(str.ValueString())
+			continue
+		}
+
+		field, err := c.GetField(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("custom_fields[%q]: %w", key, err)
+		}
+		if field == nil || field.Schema == nil || field.Schema.Custom != client.CascadingSelectFieldType {
+			return nil, fmt.Errorf("custom_fields[%q]: the %s syntax only applies to cascading select fields", key, cascadePrefix)
+		}
+		raw, err := json.Marshal(cascadeValue(str.ValueString()))
+		if err != nil {
+			return nil, fmt.Errorf("custom_fields[%q]: %w", key, err)
+		}
+		resolved[id] = raw
+	}
+	return resolved, nil
+}
+
+// cascadeValue expands cascading select shorthand. The first slash
+// separates the parent from the child option; parents containing a slash
+// need the JSON form.
+func cascadeValue(shorthand string) map[string]interface{} {
+	parent, child, hasChild := strings.Cut(strings.TrimPrefix(shorthand, cascadePrefix), "/")
+	value := map[string]interface{}{"value": parent}
+	if hasChild {
+		value["child"] = map[string]interface{}{"value": child}
+	}
+	return value
+}
+
+// cascadeShorthand renders a cascading select value read from Jira as
+// shorthand. It reports false for anything that isn't a selected option.
+func cascadeShorthand(value interface{}) (string, bool) {
+	option, _ := value.(map[string]interface{})
+	parent, ok := option["value"].(string)
+	if !ok {
+		return "", false
+	}
+	child, _ := option["child"].(map[string]interface{})
+	if childValue, ok := child["value"].(string); ok {
+		return cascadePrefix + parent + "/" + childValue, true
+	}
+	return cascadePrefix + parent, true
+}
+
+// cascadeOptionsHint lists the options of the cascading select fields set
+// with shorthand, to explain a write Jira rejected. Fields whose options
+// can't be read are skipped, since this only decorates another error.
+func cascadeOptionsHint(ctx context.Context, c *client.JiraClient, values types.Map) string {
+	var hints []string
+	for key, value := range values.Elements() {
+		str, _ := value.(types.String)
+		if !strings.HasPrefix(str.ValueString(), cascadePrefix) {
+			continue
+		}
+		id, err := c.CustomFieldID(ctx, key)
+		if err != nil {
+			continue
+		}
+		contexts, err := c.GetFieldContexts(ctx, id)
+		if err != nil {
+			continue
+		}
+
+		var allowed []string
+		for _, fieldContext := range contexts {
+			options, err := c.GetFieldOptions(ctx, id, fieldContext.ID)
+			if err != nil {
+				continue
+			}
+			parents := make(map[string]string, len(options))
+			for _, option := range options {
+				if option.OptionID == "" {
+					parents[option.ID] = option.Value
+					allowed = append(allowed, option.Value)
+				}
+			}
+			for _, option := range options {
+				if parent, ok := parents[option.OptionID]; ok && option.OptionID != "" {
+					allowed = append(allowed, parent+"/"+option.Value)
+				}
+			}
+		}
+		if len(allowed) > 0 {
+			hints = append(hints, fmt.Sprintf("Allowed values for custom_fields[%q]: %s", key, strings.Join(allowed, ", ")))
+		}
+	}
+	if len(hints) == 0 {
+		return ""
+	}
+	return "\n\n" + strings.Join(hints, "\n")
+}
+
+// setCustomFields adds resolved custom field values to a create or update
+// request.
+func setCustomFields(fields *client.IssueFields, values map[string]json.RawMessage) {
+	if len(values) == 0 {
+		return
+	}
+	if fields.Custom == nil {
+		fields.Custom = make(map[string]json.RawMessage, len(values))
+	}
+	for id, value := range values {
+		fields.Custom[id] = value
+	}
+}
+
+// clearRemovedCustomFields clears custom fields that were in state but are
+// no longer configured. Fields still set under another key (a name swapped
+// for its ID) are left alone.
+func clearRemovedCustomFields(ctx context.Context, c *client.JiraClient, fields *client.IssueFields, planned, prior types.Map) error {
+	for key := range prior.Elements() {
+		if _, ok := planned.Elements()[key]; ok {
+			continue
+		}
+		id, err := c.CustomFieldID(ctx, key)
+		if err != nil {
+			return fmt.Errorf("custom_fields[%q]: %w", key, err)
+		}
+		if _, ok := fields.Custom[id]; !ok {
+			fields.Clear = append(fields.Clear, id)
+		}
+	}
+	return nil
+}
+
+// readCustomFields refreshes the custom fields tracked in state. Only keys
+// already in state are read, so the many other custom fields on an issue
+// stay out of it.
+func readCustomFields(ctx context.Context, c *client.JiraClient, prior types.Map, fields client.IssueFields) (types.Map, error) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+
+	values := make(map[string]attr.Value, len(prior.Elements()))
+	for key, value := range prior.Elements() {
+		id, err := c.CustomFieldID(ctx, key)
+		if err != nil {
+			return prior, fmt.Errorf("custom_fields[%q]: %w", key, err)
+		}
+
+		remote, ok := fields.Custom[id]
+		if !ok {
+			remote = json.RawMessage("null")
+		}
+		str, _ := value.(types.String)
+		values[key] = types.StringValue(customFieldValue(remote, str.ValueString()))
+	}
+
+	return types.MapValueMust(types.StringType, values), nil
+}
+
+// customFieldValue returns the configured JSON while Jira's value still
+// matches it, so formatting and the extra keys Jira adds don't show as
+// drift. Otherwise it returns Jira's value, compacted.
+func customFieldValue(remote json.RawMessage, configured string) string {
+	var got, want interface{}
+	if strings.HasPrefix(configured, cascadePrefix) && json.Unmarshal(remote, &got) == nil {
+		if shorthand, ok := cascadeShorthand(got); ok {
+			return shorthand
+		}
+	}
+	if json.Unmarshal(remote, &got) == nil && json.Unmarshal([]byte(configured), &want) == nil && jsonContains(got, want) {
+		return configured
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, remote); err != nil {
+		return string(remote)
+	}
+	return buf.String()
+}
+
+// jsonContains reports whether got holds everything in want. Objects only
+// need the keys want lists, since Jira decorates values such as select
+// options with self links and IDs; arrays must match element for element.
+func jsonContains(got, want interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range w {
+			if !jsonContains(g[key], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !jsonContains(g[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
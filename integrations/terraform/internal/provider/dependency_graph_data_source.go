@@ -0,0 +1,395 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Defaults for the jira_dependency_graph data source.
+const (
+	defaultDependencyDepth    = 3
+	defaultDependencyLinkType = "Blocks"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DependencyGraphDataSource{}
+
+// NewDependencyGraphDataSource creates a new dependency graph data source.
+func NewDependencyGraphDataSource() datasource.DataSource {
+	return &DependencyGraphDataSource{}
+}
+
+// DependencyGraphDataSource defines the data source implementation.
+type DependencyGraphDataSource struct {
+	client *client.JiraClient
+}
+
+// DependencyGraphDataSourceModel describes the data source data model.
+type DependencyGraphDataSourceModel struct {
+	RootKeys types.Set             `tfsdk:"root_keys"`
+	Depth    types.Int64           `tfsdk:"depth"`
+	LinkType types.String          `tfsdk:"link_type"`
+	Nodes    []DependencyNodeModel `tfsdk:"nodes"`
+	Edges    []DependencyEdgeModel `tfsdk:"edges"`
+}
+
+// DependencyNodeModel describes an issue in the graph.
+type DependencyNodeModel struct {
+	Key     types.String `tfsdk:"key"`
+	Summary types.String `tfsdk:"summary"`
+	Status  types.String `tfsdk:"status"`
+	Depth   types.Int64  `tfsdk:"depth"`
+}
+
+// DependencyEdgeModel describes a link between two issues in the graph.
+type DependencyEdgeModel struct {
+	From  types.String `tfsdk:"from"`
+	To    types.String `tfsdk:"to"`
+	Cycle types.Bool   `tfsdk:"cycle"`
+}
+
+// dependencyEdge is a directed edge, from the blocking issue to the
+// blocked one.
+type dependencyEdge struct {
+	from, to string
+}
+
+// Metadata returns the data source type name.
+func (d *DependencyGraphDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dependency_graph"
+}
+
+// Schema defines the schema for the data source.
+func (d *DependencyGraphDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Walks blocking links out from a set of issues and returns the dependency graph as nodes and edges." + scopesNote("data.jira_dependency_graph"),
+		MarkdownDescription: `
+Walks "blocks" / "is blocked by" links out from a set of root issues, in both directions, up
+to ` + "`depth`" + ` links away, and returns the graph as ` + "`nodes`" + ` and ` + "`edges`" + `. Each
+level is fetched with batched JQL searches.
+
+Edges point from the blocking issue to the blocked one and only connect issues in the graph.
+Edges that close a cycle are marked with ` + "`cycle = true`" + `; the walk itself never revisits
+an issue, so cycles always terminate. Nodes are ordered by depth, then key, and edges by key,
+so the output is stable between plans.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_dependency_graph" "release" {
+  root_keys = ["REL-1", "REL-2"]
+  depth     = 4
+}
+
+resource "local_file" "release_dot" {
+  filename = "release.dot"
+  content = templatefile("${path.module}/graph.dot.tftpl", {
+    nodes = data.jira_dependency_graph.release.nodes
+    edges = data.jira_dependency_graph.release.edges
+  })
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"root_keys": schema.SetAttribute{
+				Description: "Keys of the issues to start the walk from.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Description: "How many links away from the roots to walk. 0 returns only the roots. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"link_type": schema.StringAttribute{
+				Description: "Name of the link type to follow (matched case-insensitively). Defaults to Blocks.",
+				Optional:    true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "Issues in the graph, ordered by depth, then key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "The issue key.",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "The issue summary.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "The issue status.",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Number of links between the issue and the nearest root (0 for roots).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				Description: "Links between issues in the graph, ordered by from, then to.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Description: "Key of the blocking issue.",
+							Computed:    true,
+						},
+						"to": schema.StringAttribute{
+							Description: "Key of the blocked issue.",
+							Computed:    true,
+						},
+						"cycle": schema.BoolAttribute{
+							Description: "Whether the edge closes a dependency cycle.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DependencyGraphDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read walks the links breadth first, one batched search per level.
+func (d *DependencyGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DependencyGraphDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roots []string
+	resp.Diagnostics.Append(data.RootKeys.ElementsAs(ctx, &roots, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(roots)
+
+	maxDepth := int64(defaultDependencyDepth)
+	if !data.Depth.IsNull() {
+		maxDepth = data.Depth.ValueInt64()
+	}
+	linkType := defaultDependencyLinkType
+	if !data.LinkType.IsNull() {
+		linkType = data.LinkType.ValueString()
+	}
+
+	tflog.Debug(ctx, "Reading Jira dependency graph", map[string]any{
+		"root_keys": roots,
+		"depth":     maxDepth,
+		"link_type": linkType,
+	})
+
+	depth := make(map[string]int64)
+	nodes := make(map[string]DependencyNodeModel)
+	edges := make(map[dependencyEdge]bool)
+
+	for _, key := range roots {
+		depth[key] = 0
+	}
+
+	frontier := roots
+	for level := int64(0); len(frontier) > 0; level++ {
+		issues, err := d.client.GetIssuesWithLinks(ctx, frontier)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read linked issues", err.Error())
+			return
+		}
+
+		var next []string
+		for _, issue := range issues {
+			nodes[issue.Key] = DependencyNodeModel{
+				Key:     types.StringValue(issue.Key),
+				Summary: types.StringValue(issue.Fields.Summary),
+				Status:  statusName(issue.Fields.Status),
+				Depth:   types.Int64Value(level),
+			}
+
+			for _, link := range issue.Fields.IssueLinks {
+				if link.Type == nil || !strings.EqualFold(link.Type.Name, linkType) {
+					continue
+				}
+
+				// Seen from this issue, an outward link points at an issue
+				// it blocks and an inward link at an issue blocking it.
+				var neighbor string
+				switch {
+				case link.OutwardIssue != nil:
+					neighbor = link.OutwardIssue.Key
+					edges[dependencyEdge{from: issue.Key, to: neighbor}] = true
+				case link.InwardIssue != nil:
+					neighbor = link.InwardIssue.Key
+					edges[dependencyEdge{from: neighbor, to: issue.Key}] = true
+				default:
+					continue
+				}
+
+				if _, seen := depth[neighbor]; !seen && level < maxDepth {
+					depth[neighbor] = level + 1
+					next = append(next, neighbor)
+				}
+			}
+		}
+
+		if level == 0 {
+			var missing []string
+			for _, key := range roots {
+				if _, ok := nodes[key]; !ok {
+					missing = append(missing, key)
+				}
+			}
+			if len(missing) > 0 {
+				resp.Diagnostics.AddError(
+					"Root Issues Not Found",
+					fmt.Sprintf("These root issues don't exist or aren't visible: %s.", strings.Join(missing, ", ")),
+				)
+				return
+			}
+		}
+
+		sort.Strings(next)
+		frontier = next
+	}
+
+	data.Nodes = sortedDependencyNodes(nodes)
+	data.Edges = dependencyEdges(nodes, edges)
+
+	tflog.Info(ctx, "Read Jira dependency graph", map[string]any{
+		"nodes": len(data.Nodes),
+		"edges": len(data.Edges),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// statusName returns a status name, or null without a status.
+func statusName(status *client.Status) types.String {
+	if status == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(status.Name)
+}
+
+// sortedDependencyNodes orders nodes by depth, then key.
+func sortedDependencyNodes(nodes map[string]DependencyNodeModel) []DependencyNodeModel {
+	sorted := make([]DependencyNodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		sorted = append(sorted, node)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Depth.ValueInt64() != sorted[j].Depth.ValueInt64() {
+			return sorted[i].Depth.ValueInt64() < sorted[j].Depth.ValueInt64()
+		}
+		return sorted[i].Key.ValueString() < sorted[j].Key.ValueString()
+	})
+	return sorted
+}
+
+// dependencyEdges returns the edges between issues in the graph, ordered by
+// from, then to, marking the edges that close a cycle. Cycle edges are the
+// back edges of a depth-first search visiting issues in key order, so the
+// marking is stable and removing every marked edge leaves the graph acyclic.
+func dependencyEdges(nodes map[string]DependencyNodeModel, all map[dependencyEdge]bool) []DependencyEdgeModel {
+	var edges []dependencyEdge
+	successors := make(map[string][]string)
+	for edge := range all {
+		if _, ok := nodes[edge.from]; !ok {
+			continue
+		}
+		if _, ok := nodes[edge.to]; !ok {
+			continue
+		}
+		edges = append(edges, edge)
+		successors[edge.from] = append(successors[edge.from], edge.to)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].from != edges[j].from {
+			return edges[i].from < edges[j].from
+		}
+		return edges[i].to < edges[j].to
+	})
+
+	keys := make([]string, 0, len(nodes))
+	for key := range nodes {
+		keys = append(keys, key)
+		sort.Strings(successors[key])
+	}
+	sort.Strings(keys)
+
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[string]int, len(keys))
+	cycle := make(map[dependencyEdge]bool)
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = onStack
+		for _, to := range successors[key] {
+			switch state[to] {
+			case onStack:
+				cycle[dependencyEdge{from: key, to: to}] = true
+			case unvisited:
+				visit(to)
+			}
+		}
+		state[key] = done
+	}
+	for _, key := range keys {
+		if state[key] == unvisited {
+			visit(key)
+		}
+	}
+
+	models := make([]DependencyEdgeModel, 0, len(edges))
+	for _, edge := range edges {
+		models = append(models, DependencyEdgeModel{
+			From:  types.StringValue(edge.from),
+			To:    types.StringValue(edge.to),
+			Cycle: types.BoolValue(cycle[edge]),
+		})
+	}
+	return models
+}
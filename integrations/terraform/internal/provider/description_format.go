@@ -0,0 +1,193 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+	"github.com/spectra/terraform-provider-jira/internal/textdiff"
+)
+
+// blankLineRuns matches runs of blank lines, which Jira collapses.
+var blankLineRuns = regexp.MustCompile(`\n{3,}`)
+
+// Values of description_format.
+const (
+	descriptionFormatPlain    = "plain"
+	descriptionFormatMarkdown = "markdown"
+)
+
+// Long description changes get a diff preview warning in the plan.
+const (
+	descriptionDiffMinLength = 1000
+	descriptionDiffMinLines  = 20
+	descriptionDiffContext   = 2
+	descriptionDiffMaxHunks  = 5
+	descriptionDiffMaxLine   = 200
+)
+
+// descriptionText handles the description of issues and subtasks: the
+// description_format attribute, converting the text for Jira, mapping it
+// back to state, and previewing long changes in plans. Every resource with
+// a description uses one, so their text handling can't drift apart.
+type descriptionText struct {
+	client *client.JiraClient
+	links  *linkRewriter
+
+	// compactDiffs summarizes change previews to hunk headers.
+	compactDiffs bool
+}
+
+// newDescriptionText returns the description handling configured on the
+// provider.
+func newDescriptionText(providerData *ProviderData) descriptionText {
+	return descriptionText{
+		client:       providerData.Client,
+		links:        providerData.LinkRewriter,
+		compactDiffs: providerData.CompactDescriptionDiffs,
+	}
+}
+
+// descriptionFormatAttribute returns the schema of description_format.
+func descriptionFormatAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "How description is interpreted: plain (the default) sends it as plain text, markdown converts headings, lists, code blocks, quotes, rules, emphasis and links to rich text and reads them back as Markdown.",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(descriptionFormatPlain),
+		Validators: []validator.String{
+			stringvalidator.OneOf(descriptionFormatPlain, descriptionFormatMarkdown),
+		},
+	}
+}
+
+// forCreate converts a configured description for a create request.
+func (d descriptionText) forCreate(description, format types.String) interface{} {
+	return formatDescription(d.client, d.links, description, format)
+}
+
+// setForUpdate sets the description of an update request. Rich content
+// that plain text can't represent is spliced back from private state;
+// Markdown carries its own rich content, so nothing is spliced into it. A
+// removed description is cleared when hadDescription is set, since leaving
+// it out of the update would keep the old text in Jira.
+func (d descriptionText) setForUpdate(ctx context.Context, private privateStateReader, fields *client.IssueFields, description, format types.String, hadDescription bool) diag.Diagnostics {
+	if description.IsNull() {
+		if hadDescription {
+			fields.Clear = append(fields.Clear, "description")
+		}
+		return nil
+	}
+
+	converted := formatDescription(d.client, d.links, description, format)
+	if format.ValueString() == descriptionFormatMarkdown {
+		fields.Description = converted
+		return nil
+	}
+
+	converted, diags := restoreADF(ctx, private, converted)
+	fields.Description = converted
+	return diags
+}
+
+// read maps the description Jira holds to state, defaulting a missing
+// description_format (on import) to plain, and records the rich content
+// plain text can't represent in private state.
+func (d descriptionText) read(ctx context.Context, private privateStateWriter, remote interface{}, description, format *types.String) diag.Diagnostics {
+	if format.IsNull() {
+		*format = types.StringValue(descriptionFormatPlain)
+	}
+
+	if remote != nil {
+		*description = readDescription(d.links, remote, *description, *format)
+	} else {
+		*description = types.StringNull()
+	}
+	return preserveADF(ctx, private, remote)
+}
+
+// previewDiff attaches a diff of a long description change to the plan as a
+// warning, since Terraform shows both full values.
+func (d descriptionText) previewDiff(prior, planned types.String, resp *resource.ModifyPlanResponse) {
+	before, after := prior.ValueString(), planned.ValueString()
+	if len(before) < descriptionDiffMinLength && len(after) < descriptionDiffMinLength &&
+		strings.Count(before, "\n") < descriptionDiffMinLines && strings.Count(after, "\n") < descriptionDiffMinLines {
+		return
+	}
+
+	diff := textdiff.Unified(before, after, textdiff.Options{
+		Context:       descriptionDiffContext,
+		MaxHunks:      descriptionDiffMaxHunks,
+		MaxLineLength: descriptionDiffMaxLine,
+		HeadersOnly:   d.compactDiffs,
+	})
+	if diff == "" {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("description"),
+		"Description Change Preview",
+		"The description changes as follows:\n\n"+diff,
+	)
+}
+
+// formatDescription converts a configured description to the form Jira
+// expects, parsing it as Markdown when description_format asks for it.
+func formatDescription(jiraClient *client.JiraClient, links *linkRewriter, description, format types.String) interface{} {
+	text := links.expand(description.ValueString())
+	if format.ValueString() == descriptionFormatMarkdown {
+		return jiraClient.FormatMarkdown(text)
+	}
+	return jiraClient.FormatText(text)
+}
+
+// readDescription maps a description read from Jira back to state. In
+// Markdown mode the document is rendered back to Markdown. Many texts
+// produce the same document (* or - bullets, trailing spaces), and Jira
+// drops trailing blank paragraphs and repeated hard breaks, so the prior
+// value is kept whenever it converts to what Jira holds up to whitespace.
+// Like linkRewriter.restore, anything else is real drift.
+func readDescription(links *linkRewriter, remote interface{}, prior, format types.String) types.String {
+	text := client.ADFToText(remote)
+	canonical := func(s string) string { return client.ADFToText(client.TextToADF(s)) }
+	if format.ValueString() == descriptionFormatMarkdown {
+		text = client.ADFToMarkdown(remote)
+		canonical = func(s string) string { return client.ADFToMarkdown(client.MarkdownToADF(s)) }
+		// Version 2 holds the wiki markup the Markdown was sent as.
+		if _, ok := remote.(string); ok {
+			canonical = client.MarkdownToWiki
+		}
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		expanded := links.expand(prior.ValueString())
+		normalized := normalizeDescription(text)
+		if normalizeDescription(expanded) == normalized || normalizeDescription(canonical(expanded)) == normalized {
+			return prior
+		}
+	}
+	return types.StringValue(text)
+}
+
+// normalizeDescription removes the whitespace differences Jira introduces:
+// trailing spaces, runs of blank lines, and blank lines at either end.
+func normalizeDescription(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Trim(blankLineRuns.ReplaceAllString(strings.Join(lines, "\n"), "\n\n"), "\n")
+}
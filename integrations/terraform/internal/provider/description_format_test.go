@@ -0,0 +1,172 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+func TestReadDescription(t *testing.T) {
+	plain := types.StringValue(descriptionFormatPlain)
+	markdown := types.StringValue(descriptionFormatMarkdown)
+
+	tests := []struct {
+		name   string
+		remote interface{}
+		prior  types.String
+		format types.String
+		want   types.String
+	}{
+		{
+			name:   "version 3 plain",
+			remote: client.TextToADF("Hello"),
+			prior:  types.StringValue("Hello  \n"),
+			format: plain,
+			want:   types.StringValue("Hello  \n"),
+		},
+		{
+			name:   "version 3 plain whitespace normalized by Jira",
+			remote: client.TextToADF("Steps\n\nRun it"),
+			prior:  types.StringValue("Steps  \n\n\n\nRun it\n\n"),
+			format: plain,
+			want:   types.StringValue("Steps  \n\n\n\nRun it\n\n"),
+		},
+		{
+			name:   "version 3 plain keeps equivalent checklist",
+			remote: client.TextToADF("- [x] Done"),
+			prior:  types.StringValue("- [X] Done"),
+			format: plain,
+			want:   types.StringValue("- [X] Done"),
+		},
+		{
+			name:   "version 3 plain drift",
+			remote: client.TextToADF("Edited in Jira"),
+			prior:  types.StringValue("Hello"),
+			format: plain,
+			want:   types.StringValue("Edited in Jira"),
+		},
+		{
+			name:   "version 3 markdown keeps equivalent prior",
+			remote: client.MarkdownToADF("* one\n* two"),
+			prior:  types.StringValue("- one\n- two"),
+			format: markdown,
+			want:   types.StringValue("- one\n- two"),
+		},
+		{
+			name:   "version 3 markdown drift",
+			remote: client.MarkdownToADF("- one\n- **two**"),
+			prior:  types.StringValue("- one\n- two"),
+			format: markdown,
+			want:   types.StringValue("- one\n- **two**"),
+		},
+		{
+			name:   "version 3 markdown import",
+			remote: client.MarkdownToADF("# Runbook"),
+			prior:  types.StringNull(),
+			format: markdown,
+			want:   types.StringValue("# Runbook"),
+		},
+		{
+			name:   "version 2 plain",
+			remote: "h1. Wiki",
+			prior:  types.StringValue("h1. Wiki"),
+			format: plain,
+			want:   types.StringValue("h1. Wiki"),
+		},
+		{
+			name:   "version 2 markdown sent as wiki",
+			remote: "h2. Steps\n\n* *one*\n* two",
+			prior:  types.StringValue("## Steps\n\n- **one**\n- two"),
+			format: markdown,
+			want:   types.StringValue("## Steps\n\n- **one**\n- two"),
+		},
+		{
+			name:   "version 2 markdown drift",
+			remote: "h2. Edited in Jira",
+			prior:  types.StringValue("## Steps"),
+			format: markdown,
+			want:   types.StringValue("h2. Edited in Jira"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readDescription(nil, tt.remote, tt.prior, tt.format); !got.Equal(tt.want) {
+				t.Errorf("readDescription() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescriptionPreviewDiff(t *testing.T) {
+	lines := make([]string, descriptionDiffMinLines+5)
+	for i := range lines {
+		lines[i] = "Step"
+	}
+	long := strings.Join(lines, "\n")
+	changed := strings.Replace(long, "Step", "First step", 1)
+
+	tests := []struct {
+		name    string
+		prior   string
+		planned string
+		compact bool
+		want    string
+	}{
+		{name: "short change", prior: "old", planned: "new"},
+		{name: "long text unchanged", prior: long, planned: long},
+		{name: "long change", prior: long, planned: changed, want: "@@ -1,3 +1,3 @@\n-Step\n+First step\n Step\n Step\n"},
+		{name: "compact", prior: long, planned: changed, compact: true, want: "@@ -1,3 +1,3 @@ (+1 -1)\n"},
+		{name: "long line", prior: strings.Repeat("x", descriptionDiffMinLength), planned: "y", want: "+y\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.ModifyPlanResponse{}
+			descriptionText{compactDiffs: tt.compact}.previewDiff(types.StringValue(tt.prior), types.StringValue(tt.planned), resp)
+
+			if tt.want == "" {
+				if len(resp.Diagnostics) != 0 {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(resp.Diagnostics) != 1 || resp.Diagnostics.HasError() {
+				t.Fatalf("diagnostics = %v, want a single warning", resp.Diagnostics)
+			}
+			if detail := resp.Diagnostics[0].Detail(); !strings.HasSuffix(detail, tt.want) {
+				t.Errorf("preview = %q, want it to end with %q", detail, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"unchanged", "One\n\nTwo", "One\n\nTwo"},
+		{"trailing spaces and tabs", "One  \nTwo\t", "One\nTwo"},
+		{"blank line runs", "One\n\n\n\nTwo", "One\n\nTwo"},
+		{"whitespace-only lines", "One\n  \n\t\nTwo", "One\n\nTwo"},
+		{"blank lines at the ends", "\n\nOne\n\n", "One"},
+		{"windows line endings", "One\r\n\r\nTwo\r\n", "One\n\nTwo"},
+		{"leading indentation kept", "  - nested", "  - nested"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeDescription(tt.text); got != tt.want {
+				t.Errorf("normalizeDescription(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
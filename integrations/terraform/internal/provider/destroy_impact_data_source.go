@@ -0,0 +1,250 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// destroyImpactSchemaVersion is bumped whenever the report changes shape.
+const destroyImpactSchemaVersion = 1
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DestroyImpactDataSource{}
+
+// NewDestroyImpactDataSource creates a new destroy impact data source.
+func NewDestroyImpactDataSource() datasource.DataSource {
+	return &DestroyImpactDataSource{}
+}
+
+// DestroyImpactDataSource defines the data source implementation.
+type DestroyImpactDataSource struct {
+	client *client.JiraClient
+}
+
+// DestroyImpactDataSourceModel describes the data source data model.
+type DestroyImpactDataSourceModel struct {
+	IssueKeys   types.Set    `tfsdk:"issue_keys"`
+	JSON        types.String `tfsdk:"json"`
+	MissingKeys types.List   `tfsdk:"missing_keys"`
+}
+
+// destroyImpactReport is the JSON report produced by the data source.
+type destroyImpactReport struct {
+	SchemaVersion int                  `json:"schema_version"`
+	Issues        []destroyImpactIssue `json:"issues"`
+	Totals        destroyImpactTotals  `json:"totals"`
+	MissingKeys   []string             `json:"missing_keys"`
+}
+
+// destroyImpactIssue is a single issue in the report.
+type destroyImpactIssue struct {
+	Key         string   `json:"key"`
+	Summary     string   `json:"summary"`
+	IssueType   string   `json:"issue_type"`
+	SubtaskKeys []string `json:"subtask_keys"`
+	ChildKeys   []string `json:"child_keys"`
+	Attachments int      `json:"attachments"`
+	Comments    int      `json:"comments"`
+}
+
+// destroyImpactTotals sums the report over every issue.
+type destroyImpactTotals struct {
+	Issues      int `json:"issues"`
+	Subtasks    int `json:"subtasks"`
+	Children    int `json:"children"`
+	Attachments int `json:"attachments"`
+	Comments    int `json:"comments"`
+}
+
+// Metadata returns the data source type name.
+func (d *DestroyImpactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destroy_impact"
+}
+
+// Schema defines the schema for the data source.
+func (d *DestroyImpactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports what destroying a set of managed issues would remove from Jira, including subtasks, attachments and comments." + scopesNote("data.jira_destroy_impact"),
+		MarkdownDescription: `
+Reports what destroying the given issues would remove from Jira, as a JSON document for
+rendering into a pull request before a refactor or ` + "`terraform destroy`" + `. It reads only; nothing
+is deleted. Issues are fetched in batches of 100 keys per search, with one more search per
+batch for child issues.
+
+Subtasks, attachments and comments are deleted along with their issue. Child issues (such
+as an epic's stories) are kept but lose their parent. Children linked through the legacy
+Epic Link field are not counted.
+
+## Output Schema
+
+The ` + "`json`" + ` document has the following stable shape (` + "`schema_version`" + ` is bumped on any change):
+
+` + "```json" + `
+{
+  "schema_version": 1,
+  "issues": [
+    {
+      "key": "PROJ-100",
+      "summary": "Checkout redesign",
+      "issue_type": "Epic",
+      "subtask_keys": [],
+      "child_keys": ["PROJ-101", "PROJ-102"],
+      "attachments": 3,
+      "comments": 12
+    }
+  ],
+  "totals": {
+    "issues": 1,
+    "subtasks": 0,
+    "children": 2,
+    "attachments": 3,
+    "comments": 12
+  },
+  "missing_keys": []
+}
+` + "```" + `
+
+Issues are sorted by key; key lists are never null.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_destroy_impact" "stories" {
+  issue_keys = [for issue in jira_issue.stories : issue.key]
+}
+
+resource "local_file" "destroy_impact" {
+  filename = "${path.module}/destroy-impact.json"
+  content  = data.jira_destroy_impact.stories.json
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"issue_keys": schema.SetAttribute{
+				Description: "Keys of the managed issues to report on.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"json": schema.StringAttribute{
+				Description: "The report as a JSON document.",
+				Computed:    true,
+			},
+			"missing_keys": schema.ListAttribute{
+				Description: "Requested keys that don't exist or aren't visible to the provider.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DestroyImpactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *DestroyImpactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DestroyImpactDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(keys)
+
+	tflog.Debug(ctx, "Reading Jira destroy impact", map[string]any{
+		"count": len(keys),
+	})
+
+	impacts, err := d.client.GetIssueImpacts(ctx, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read destroy impact", err.Error())
+		return
+	}
+
+	report := newDestroyImpactReport(keys, impacts)
+	encoded, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode destroy impact", err.Error())
+		return
+	}
+	data.JSON = types.StringValue(string(encoded))
+
+	missing, diags := types.ListValueFrom(ctx, types.StringType, report.MissingKeys)
+	resp.Diagnostics.Append(diags...)
+	data.MissingKeys = missing
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newDestroyImpactReport builds the report for the requested keys, in key
+// order, from the impacts Jira returned.
+func newDestroyImpactReport(keys []string, impacts []client.IssueImpact) destroyImpactReport {
+	byKey := make(map[string]client.IssueImpact, len(impacts))
+	for _, impact := range impacts {
+		byKey[impact.Key] = impact
+	}
+
+	report := destroyImpactReport{
+		SchemaVersion: destroyImpactSchemaVersion,
+		Issues:        make([]destroyImpactIssue, 0, len(keys)),
+		MissingKeys:   []string{},
+	}
+	for _, key := range keys {
+		impact, ok := byKey[key]
+		if !ok {
+			report.MissingKeys = append(report.MissingKeys, key)
+			continue
+		}
+
+		issue := destroyImpactIssue{
+			Key:         impact.Key,
+			Summary:     impact.Summary,
+			IssueType:   impact.IssueType,
+			SubtaskKeys: append([]string{}, impact.SubtaskKeys...),
+			ChildKeys:   append([]string{}, impact.ChildKeys...),
+			Attachments: impact.Attachments,
+			Comments:    impact.Comments,
+		}
+		sort.Strings(issue.SubtaskKeys)
+		sort.Strings(issue.ChildKeys)
+		report.Issues = append(report.Issues, issue)
+
+		report.Totals.Issues++
+		report.Totals.Subtasks += len(issue.SubtaskKeys)
+		report.Totals.Children += len(issue.ChildKeys)
+		report.Totals.Attachments += issue.Attachments
+		report.Totals.Comments += issue.Comments
+	}
+	return report
+}
@@ -0,0 +1,313 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// exportSchemaVersion is bumped whenever the export document changes shape.
+const exportSchemaVersion = 1
+
+// exportCSVHeader lists the CSV columns, in order.
+var exportCSVHeader = []string{"key", "id", "project", "issue_type", "summary", "status", "priority", "labels", "parent_key", "reporter_account_id", "creator_account_id"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ExportDataSource{}
+
+// NewExportDataSource creates a new export data source.
+func NewExportDataSource() datasource.DataSource {
+	return &ExportDataSource{}
+}
+
+// ExportDataSource defines the data source implementation.
+type ExportDataSource struct {
+	client *client.JiraClient
+}
+
+// ExportDataSourceModel describes the data source data model.
+type ExportDataSourceModel struct {
+	Keys        types.List   `tfsdk:"keys"`
+	IncludeCSV  types.Bool   `tfsdk:"include_csv"`
+	JSON        types.String `tfsdk:"json"`
+	CSV         types.String `tfsdk:"csv"`
+	MissingKeys types.List   `tfsdk:"missing_keys"`
+}
+
+// exportDocument is the JSON document produced by the data source.
+type exportDocument struct {
+	SchemaVersion int           `json:"schema_version"`
+	Issues        []exportIssue `json:"issues"`
+	MissingKeys   []string      `json:"missing_keys"`
+}
+
+// exportIssue is a single issue in the export document.
+type exportIssue struct {
+	Key               string   `json:"key"`
+	ID                string   `json:"id"`
+	Project           string   `json:"project"`
+	IssueType         string   `json:"issue_type"`
+	Summary           string   `json:"summary"`
+	Status            string   `json:"status"`
+	Priority          string   `json:"priority"`
+	Labels            []string `json:"labels"`
+	ParentKey         string   `json:"parent_key"`
+	ReporterAccountID string   `json:"reporter_account_id"`
+	CreatorAccountID  string   `json:"creator_account_id"`
+}
+
+// Metadata returns the data source type name.
+func (d *ExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *ExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exports a snapshot of issues as a JSON document (and optionally CSV) for release artifacts." + scopesNote("data.jira_export"),
+		MarkdownDescription: `
+Exports a snapshot of the given issues as a single JSON document, and optionally CSV,
+suitable for writing with ` + "`local_file`" + ` as a release or audit artifact. Issues are fetched
+in batches of 100 keys per search.
+
+## Output Schema
+
+The ` + "`json`" + ` document has the following stable shape (` + "`schema_version`" + ` is bumped on any change):
+
+` + "```json" + `
+{
+  "schema_version": 1,
+  "issues": [
+    {
+      "key": "PROJ-123",
+      "id": "10042",
+      "project": "PROJ",
+      "issue_type": "Story",
+      "summary": "User login",
+      "status": "In Progress",
+      "priority": "Medium",
+      "labels": ["auth"],
+      "parent_key": "PROJ-100",
+      "reporter_account_id": "5b10...",
+      "creator_account_id": "5b10..."
+    }
+  ],
+  "missing_keys": []
+}
+` + "```" + `
+
+Issues appear in the order their keys were given; absent values are empty strings. The
+CSV uses the same field names as its header row, with labels joined by ` + "`;`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_export" "release" {
+  keys        = concat([for i in jira_issue.stories : i.key], [for s in jira_subtask.tasks : s.key])
+  include_csv = true
+}
+
+resource "local_file" "release_issues" {
+  filename = "${path.module}/release-issues.json"
+  content  = data.jira_export.release.json
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListAttribute{
+				Description: "Issue keys to export. Duplicates are exported once.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"include_csv": schema.BoolAttribute{
+				Description: "Also render the export as CSV.",
+				Optional:    true,
+			},
+			"json": schema.StringAttribute{
+				Description: "The export as a JSON document.",
+				Computed:    true,
+			},
+			"csv": schema.StringAttribute{
+				Description: "The export as CSV, with a header row. Null unless include_csv is set.",
+				Computed:    true,
+			},
+			"missing_keys": schema.ListAttribute{
+				Description: "Requested keys that don't exist or aren't visible to the provider.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var requested []string
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &requested, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := make([]string, 0, len(requested))
+	seen := make(map[string]bool, len(requested))
+	for _, key := range requested {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	tflog.Debug(ctx, "Exporting Jira issues", map[string]any{
+		"count": len(keys),
+	})
+
+	issues, err := d.client.SearchIssuesByKey(ctx, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to search issues", err.Error())
+		return
+	}
+
+	byKey := make(map[string]*client.Issue, len(issues))
+	for i := range issues {
+		byKey[issues[i].Key] = &issues[i]
+	}
+
+	doc := exportDocument{
+		SchemaVersion: exportSchemaVersion,
+		Issues:        make([]exportIssue, 0, len(keys)),
+		MissingKeys:   []string{},
+	}
+	for _, key := range keys {
+		issue, ok := byKey[key]
+		if !ok {
+			doc.MissingKeys = append(doc.MissingKeys, key)
+			continue
+		}
+		doc.Issues = append(doc.Issues, newExportIssue(issue))
+	}
+
+	encoded, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode export", err.Error())
+		return
+	}
+	data.JSON = types.StringValue(string(encoded))
+
+	data.CSV = types.StringNull()
+	if data.IncludeCSV.ValueBool() {
+		rendered, err := renderExportCSV(doc.Issues)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to encode export", err.Error())
+			return
+		}
+		data.CSV = types.StringValue(rendered)
+	}
+
+	missing, diags := types.ListValueFrom(ctx, types.StringType, doc.MissingKeys)
+	resp.Diagnostics.Append(diags...)
+	data.MissingKeys = missing
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newExportIssue flattens an issue into its export representation.
+func newExportIssue(issue *client.Issue) exportIssue {
+	out := exportIssue{
+		Key:     issue.Key,
+		ID:      issue.ID,
+		Summary: issue.Fields.Summary,
+		Labels:  issue.Fields.Labels,
+	}
+	if out.Labels == nil {
+		out.Labels = []string{}
+	}
+	if issue.Fields.Project != nil {
+		out.Project = issue.Fields.Project.Key
+	}
+	if issue.Fields.IssueType != nil {
+		out.IssueType = issue.Fields.IssueType.Name
+	}
+	if issue.Fields.Status != nil {
+		out.Status = issue.Fields.Status.Name
+	}
+	if issue.Fields.Priority != nil {
+		out.Priority = issue.Fields.Priority.Name
+	}
+	if issue.Fields.Parent != nil {
+		out.ParentKey = issue.Fields.Parent.Key
+	}
+	if issue.Fields.Reporter != nil {
+		out.ReporterAccountID = issue.Fields.Reporter.AccountID
+	}
+	if issue.Fields.Creator != nil {
+		out.CreatorAccountID = issue.Fields.Creator.AccountID
+	}
+	return out
+}
+
+// renderExportCSV renders exported issues as CSV with a header row.
+func renderExportCSV(issues []exportIssue) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(exportCSVHeader); err != nil {
+		return "", err
+	}
+	for _, issue := range issues {
+		record := []string{
+			issue.Key,
+			issue.ID,
+			issue.Project,
+			issue.IssueType,
+			issue.Summary,
+			issue.Status,
+			issue.Priority,
+			strings.Join(issue.Labels, ";"),
+			issue.ParentKey,
+			issue.ReporterAccountID,
+			issue.CreatorAccountID,
+		}
+		if err := w.Write(record); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
@@ -0,0 +1,52 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// removeMissingIssue handles a managed issue that reads as not found. Jira
+// also answers 404 for issues in projects the account can no longer browse,
+// so before the issue is removed from state (and planned for recreation) its
+// project is read as well. When that fails with a 404 or 403 too, the
+// account has most likely lost access to the project and the refresh fails
+// instead, unless force is set.
+func removeMissingIssue(ctx context.Context, c *client.JiraClient, key string, force bool, resp *resource.ReadResponse) {
+	project, _, _ := strings.Cut(key, "-")
+	if force || project == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	_, err := c.GetProject(ctx, project)
+	switch {
+	case err == nil:
+		tflog.Info(ctx, "Jira issue no longer exists", map[string]any{
+			"key": key,
+		})
+		resp.State.RemoveResource(ctx)
+	case client.IsNotFound(err) || client.IsAuthError(err):
+		resp.Diagnostics.AddError(
+			"Lost Access to Project",
+			fmt.Sprintf("Issue %s and its project %s both read as not found, so the provider's account has most likely "+
+				"lost access to the project; Jira reports issues an account can't see as not found. Refusing to treat "+
+				"the issue as deleted, which would plan to recreate it.\n\n"+
+				"Give the account the Browse Projects permission in %s again. If the project was deleted, set "+
+				"remove_issues_without_project_access in the provider configuration to remove its issues from state.",
+				key, project, project),
+		)
+	default:
+		resp.Diagnostics.AddError(
+			"Failed to read issue",
+			fmt.Sprintf("Issue %s reads as not found, and checking access to project %s failed: %s", key, project, err),
+		)
+	}
+}
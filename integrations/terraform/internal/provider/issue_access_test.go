@@ -0,0 +1,95 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+func TestRemoveMissingIssue(t *testing.T) {
+	ctx := context.Background()
+	var projectReads int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&projectReads, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/LIVE":
+			_, _ = w.Write([]byte(`{"key":"LIVE"}`))
+		case "/rest/api/3/project/DENIED":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errorMessages":["Forbidden"]}`))
+		case "/rest/api/3/project/BROKEN":
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"errorMessages":["Internal error"]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["No project could be found"]}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+
+	var schemaResp resource.SchemaResponse
+	(&IssueResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name        string
+		key         string
+		force       bool
+		wantRemoved bool
+		wantError   string
+		wantReads   int32
+	}{
+		{name: "issue deleted", key: "LIVE-1", wantRemoved: true, wantReads: 1},
+		{name: "project gone", key: "GONE-1", wantError: "Lost Access to Project", wantReads: 1},
+		{name: "project forbidden", key: "DENIED-1", wantError: "Lost Access to Project", wantReads: 1},
+		{name: "project check failed", key: "BROKEN-1", wantError: "Failed to read issue", wantReads: 1},
+		{name: "forced", key: "GONE-1", force: true, wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atomic.StoreInt32(&projectReads, 0)
+			resp := resource.ReadResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			if diags := resp.State.SetAttribute(ctx, path.Root("key"), types.StringValue(tt.key)); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			removeMissingIssue(ctx, c, tt.key, tt.force, &resp)
+
+			if removed := resp.State.Raw.IsNull(); removed != tt.wantRemoved {
+				t.Errorf("removed from state = %v, want %v", removed, tt.wantRemoved)
+			}
+			var summaries []string
+			for _, d := range resp.Diagnostics.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			if (tt.wantError == "" && len(summaries) != 0) || (tt.wantError != "" && (len(summaries) != 1 || summaries[0] != tt.wantError)) {
+				t.Errorf("errors = %v, want %q", summaries, tt.wantError)
+			}
+			if n := atomic.LoadInt32(&projectReads); n != tt.wantReads {
+				t.Errorf("project read %d times, want %d", n, tt.wantReads)
+			}
+		})
+	}
+}
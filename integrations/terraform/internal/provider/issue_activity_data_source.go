@@ -0,0 +1,315 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Activity types reported by the jira_issue_activity data source.
+const (
+	activityTypeChange  = "change"
+	activityTypeComment = "comment"
+)
+
+// activitySummaryLength caps the length of comment summaries, in runes.
+const activitySummaryLength = 200
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssueActivityDataSource{}
+
+// NewIssueActivityDataSource creates a new issue activity data source.
+func NewIssueActivityDataSource() datasource.DataSource {
+	return &IssueActivityDataSource{}
+}
+
+// IssueActivityDataSource defines the data source implementation.
+type IssueActivityDataSource struct {
+	client *client.JiraClient
+}
+
+// IssueActivityDataSourceModel describes the data source data model.
+type IssueActivityDataSourceModel struct {
+	IssueKey   types.String         `tfsdk:"issue_key"`
+	Since      types.String         `tfsdk:"since"`
+	Activities []IssueActivityModel `tfsdk:"activities"`
+}
+
+// IssueActivityModel describes a single activity entry.
+type IssueActivityModel struct {
+	Type              types.String `tfsdk:"type"`
+	ID                types.String `tfsdk:"id"`
+	AuthorAccountID   types.String `tfsdk:"author_account_id"`
+	AuthorDisplayName types.String `tfsdk:"author_display_name"`
+	Timestamp         types.String `tfsdk:"timestamp"`
+	Summary           types.String `tfsdk:"summary"`
+}
+
+// activity is an activity entry with its parsed timestamp, for sorting.
+type activity struct {
+	at    time.Time
+	model IssueActivityModel
+}
+
+// Metadata returns the data source type name.
+func (d *IssueActivityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_activity"
+}
+
+// Schema defines the schema for the data source.
+func (d *IssueActivityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists field changes and comments on a Jira issue as a single timeline." + scopesNote("data.jira_issue_activity"),
+		MarkdownDescription: `
+Lists field changes and comments on a Jira issue as a single timeline, oldest first.
+
+Timestamps are normalized to UTC (RFC 3339). Entries with the same timestamp are ordered
+changes before comments, then by ID, so the list is stable between plans. Set ` + "`since`" + `
+to an RFC 3339 timestamp, or to a duration such as ` + "`168h`" + ` counted back from now, to
+only list recent activity.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_issue_activity" "week" {
+  issue_key = "OPS-42"
+  since     = "168h"
+}
+
+resource "jira_issue" "status_report" {
+  project     = "OPS"
+  summary     = "Weekly status: OPS-42"
+  issue_type  = "Task"
+  description = join("\n", [
+    for a in data.jira_issue_activity.week.activities :
+    "${a.timestamp} ${a.type}: ${a.summary}"
+  ])
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"issue_key": schema.StringAttribute{
+				Description: "The Jira issue key (e.g., PROJ-123).",
+				Required:    true,
+			},
+			"since": schema.StringAttribute{
+				Description: "Only list activity at or after this time: an RFC 3339 timestamp, or a duration (e.g., 168h) counted back from now.",
+				Optional:    true,
+			},
+			"activities": schema.ListNestedAttribute{
+				Description: "The issue activity, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The activity type: change or comment.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The changelog entry or comment ID.",
+							Computed:    true,
+						},
+						"author_account_id": schema.StringAttribute{
+							Description: "Account ID of the author. Null for changes made by Jira itself.",
+							Computed:    true,
+						},
+						"author_display_name": schema.StringAttribute{
+							Description: "Display name of the author, as reported by Jira.",
+							Computed:    true,
+						},
+						"timestamp": schema.StringAttribute{
+							Description: "When the activity happened, in UTC (RFC 3339).",
+							Computed:    true,
+						},
+						"summary": schema.StringAttribute{
+							Description: "The changed fields with their old and new values, or the start of the comment text.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IssueActivityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IssueActivityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssueActivityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since time.Time
+	if !data.Since.IsNull() {
+		var err error
+		since, err = parseSince(data.Since.ValueString(), time.Now())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("since"), "Invalid Since", err.Error())
+			return
+		}
+	}
+
+	issueKey := data.IssueKey.ValueString()
+	tflog.Debug(ctx, "Reading Jira issue activity", map[string]any{
+		"issue_key": issueKey,
+		"since":     data.Since.ValueString(),
+	})
+
+	changes, err := d.client.GetChangelog(ctx, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read changelog", err.Error())
+		return
+	}
+
+	comments, err := d.client.GetComments(ctx, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read comments", err.Error())
+		return
+	}
+
+	activities := make([]activity, 0, len(changes)+len(comments))
+	for _, change := range changes {
+		at, err := client.ParseTime(change.Created)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read changelog", err.Error())
+			return
+		}
+		activities = append(activities, newActivity(activityTypeChange, change.ID, change.Author, at, changeSummary(change.Items)))
+	}
+	for _, comment := range comments {
+		at, err := client.ParseTime(comment.Created)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read comments", err.Error())
+			return
+		}
+		activities = append(activities, newActivity(activityTypeComment, comment.ID, comment.Author, at, commentSummary(comment.Body)))
+	}
+
+	sortActivities(activities)
+
+	data.Activities = make([]IssueActivityModel, 0, len(activities))
+	for _, a := range activities {
+		if a.at.Before(since) {
+			continue
+		}
+		data.Activities = append(data.Activities, a.model)
+	}
+
+	tflog.Debug(ctx, "Read Jira issue activity", map[string]any{
+		"issue_key":  issueKey,
+		"activities": len(data.Activities),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseSince parses the since attribute: an RFC 3339 timestamp, or a
+// duration counted back from now.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("since must be an RFC 3339 timestamp (e.g., 2024-01-15T00:00:00Z) or a positive duration (e.g., 168h), got %q", value)
+}
+
+// newActivity builds an activity entry with its timestamp normalized to UTC.
+func newActivity(activityType, id string, author *client.User, at time.Time, summary string) activity {
+	displayName := types.StringNull()
+	if author != nil && author.DisplayName != "" {
+		displayName = types.StringValue(author.DisplayName)
+	}
+
+	return activity{
+		at: at,
+		model: IssueActivityModel{
+			Type:              types.StringValue(activityType),
+			ID:                types.StringValue(id),
+			AuthorAccountID:   userAccountID(author),
+			AuthorDisplayName: displayName,
+			Timestamp:         types.StringValue(at.UTC().Format(time.RFC3339)),
+			Summary:           types.StringValue(summary),
+		},
+	}
+}
+
+// sortActivities orders activity oldest first. Ties are broken by type and
+// then numeric ID so the order never depends on how the two listings
+// interleave.
+func sortActivities(activities []activity) {
+	sort.SliceStable(activities, func(i, j int) bool {
+		a, b := activities[i], activities[j]
+		if !a.at.Equal(b.at) {
+			return a.at.Before(b.at)
+		}
+		if a.model.Type != b.model.Type {
+			return a.model.Type.ValueString() < b.model.Type.ValueString()
+		}
+		return activityIDLess(a.model.ID.ValueString(), b.model.ID.ValueString())
+	})
+}
+
+// activityIDLess compares Jira IDs numerically, falling back to string order
+// for IDs that aren't numbers.
+func activityIDLess(a, b string) bool {
+	x, errX := strconv.ParseInt(a, 10, 64)
+	y, errY := strconv.ParseInt(b, 10, 64)
+	if errX != nil || errY != nil {
+		return a < b
+	}
+	return x < y
+}
+
+// changeSummary describes the fields touched by a changelog entry, e.g.
+// `status: "To Do" → "In Progress"`.
+func changeSummary(items []client.ChangelogItem) string {
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		parts = append(parts, fmt.Sprintf("%s: %q → %q", item.Field, item.FromString, item.ToString))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// commentSummary returns the start of a comment's text on a single line.
+func commentSummary(body interface{}) string {
+	text := strings.Join(strings.Fields(client.ADFToText(body)), " ")
+	if utf8.RuneCountInString(text) <= activitySummaryLength {
+		return text
+	}
+	return cutText(text, activitySummaryLength-1, func(rune) int { return 1 }) + "…"
+}
@@ -0,0 +1,153 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// attachmentHashesKey is the private state key caching the sha256 of each
+// tracked attachment.
+const attachmentHashesKey = "attachment_hashes"
+
+// attachmentHashMaxBytes is the size of the largest attachment downloaded
+// to compute its sha256. Larger attachments are listed without a hash.
+const attachmentHashMaxBytes = 10 << 20
+
+// issueAttachmentAttrTypes are the attribute types of an attachments entry.
+var issueAttachmentAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"filename": types.StringType,
+	"size":     types.Int64Type,
+	"sha256":   types.StringType,
+}
+
+// IssueAttachmentModel describes one entry of attachments.
+type IssueAttachmentModel struct {
+	ID       types.String `tfsdk:"id"`
+	Filename types.String `tfsdk:"filename"`
+	Size     types.Int64  `tfsdk:"size"`
+	SHA256   types.String `tfsdk:"sha256"`
+}
+
+// privateStateStore is implemented by the private state of resource
+// responses, which starts out as the private state of the request.
+type privateStateStore interface {
+	privateStateReader
+	privateStateWriter
+}
+
+// issueAttachmentsAttribute returns the schema of attachments.
+func issueAttachmentsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: fmt.Sprintf("The issue's attachments, ordered by ID. Null unless track_attachments is set. "+
+			"Attachments of up to %d MiB are downloaded once to compute sha256; larger ones have a null sha256.", attachmentHashMaxBytes>>20),
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "The attachment ID.",
+					Computed:    true,
+				},
+				"filename": schema.StringAttribute{
+					Description: "The attachment's file name.",
+					Computed:    true,
+				},
+				"size": schema.Int64Attribute{
+					Description: "The attachment's size in bytes.",
+					Computed:    true,
+				},
+				"sha256": schema.StringAttribute{
+					Description: "Hex-encoded SHA-256 of the content, comparable with filesha256(). Null for attachments over the size limit.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// readAttachments maps an issue's attachments to state when
+// track_attachments is set. Downloading content is expensive, so hashes are
+// cached in private state by attachment ID and size, and only attachments
+// that are new or changed in size are downloaded. Hashes of attachments no
+// longer on the issue are dropped from the cache.
+func (r *IssueResource) readAttachments(ctx context.Context, private privateStateStore, issue *client.Issue, data *IssueResourceModel) diag.Diagnostics {
+	attachmentType := types.ObjectType{AttrTypes: issueAttachmentAttrTypes}
+	if !data.TrackAttachments.ValueBool() {
+		data.Attachments = types.ListNull(attachmentType)
+		return private.SetKey(ctx, attachmentHashesKey, nil)
+	}
+
+	cached := map[string]string{}
+	value, diags := private.GetKey(ctx, attachmentHashesKey)
+	if len(value) > 0 && json.Unmarshal(value, &cached) != nil {
+		// A damaged cache only costs downloads.
+		cached = map[string]string{}
+	}
+
+	attachments := append([]client.Attachment(nil), issue.Fields.Attachments...)
+	sort.Slice(attachments, func(i, j int) bool {
+		a, b := attachments[i].ID, attachments[j].ID
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+
+	hashes := map[string]string{}
+	entries := make([]IssueAttachmentModel, 0, len(attachments))
+	for _, attachment := range attachments {
+		entry := IssueAttachmentModel{
+			ID:       types.StringValue(attachment.ID),
+			Filename: types.StringValue(attachment.Filename),
+			Size:     types.Int64Value(attachment.Size),
+			SHA256:   types.StringNull(),
+		}
+
+		if attachment.Size <= attachmentHashMaxBytes {
+			key := fmt.Sprintf("%s:%d", attachment.ID, attachment.Size)
+			sum, ok := cached[key]
+			if !ok {
+				content, err := r.client.DownloadAttachment(ctx, attachment.ID)
+				if err != nil {
+					diags.AddAttributeError(
+						path.Root("attachments"),
+						"Failed to Hash Attachment",
+						fmt.Sprintf("Could not download %s (attachment %s) from %s: %s", attachment.Filename, attachment.ID, issue.Key, err),
+					)
+					return diags
+				}
+				digest := sha256.Sum256(content)
+				sum = hex.EncodeToString(digest[:])
+			}
+			hashes[key] = sum
+			entry.SHA256 = types.StringValue(sum)
+		}
+		entries = append(entries, entry)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, attachmentType, entries)
+	diags.Append(listDiags...)
+	data.Attachments = list
+
+	encoded, err := json.Marshal(hashes)
+	if err != nil {
+		diags.AddError("Failed to cache attachment hashes", err.Error())
+		return diags
+	}
+	diags.Append(private.SetKey(ctx, attachmentHashesKey, encoded)...)
+	return diags
+}
@@ -0,0 +1,110 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// IssueAttributesModel is the standard attribute set of an issue read by
+// the issue data sources.
+type IssueAttributesModel struct {
+	Key         types.String `tfsdk:"key"`
+	ID          types.String `tfsdk:"id"`
+	Project     types.String `tfsdk:"project"`
+	Summary     types.String `tfsdk:"summary"`
+	Description types.String `tfsdk:"description"`
+	IssueType   types.String `tfsdk:"issue_type"`
+	Status      types.String `tfsdk:"status"`
+	Priority    types.String `tfsdk:"priority"`
+	ParentKey   types.String `tfsdk:"parent_key"`
+	Labels      types.Set    `tfsdk:"labels"`
+
+	ParentSummary types.String `tfsdk:"parent_summary"`
+	ParentStatus  types.String `tfsdk:"parent_status"`
+
+	DueDate          types.String `tfsdk:"due_date"`
+	Assignee         types.String `tfsdk:"assignee"`
+	Reporter         types.String `tfsdk:"reporter"`
+	CreatorAccountID types.String `tfsdk:"creator_account_id"`
+
+	PriorityIconURL types.String `tfsdk:"priority_icon_url"`
+	PriorityColor   types.String `tfsdk:"priority_color"`
+
+	IssueTypeIconURL types.String `tfsdk:"issue_type_icon_url"`
+}
+
+// mapIssue maps an issue fetched by GetIssue or a search to the standard
+// attribute set. Fields the issue doesn't have are null.
+func mapIssue(ctx context.Context, issue *client.Issue) (IssueAttributesModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fields := issue.Fields
+
+	attrs := nullIssueAttributes()
+	attrs.Key = types.StringValue(issue.Key)
+	attrs.ID = types.StringValue(issue.ID)
+	attrs.Summary = types.StringValue(fields.Summary)
+
+	if fields.Description != nil {
+		attrs.Description = types.StringValue(client.ADFToText(fields.Description))
+	}
+	if fields.Project != nil {
+		attrs.Project = types.StringValue(fields.Project.Key)
+	}
+	if fields.IssueType != nil {
+		attrs.IssueType = types.StringValue(fields.IssueType.Name)
+	}
+	if fields.Status != nil {
+		attrs.Status = types.StringValue(fields.Status.Name)
+	}
+	if fields.Priority != nil {
+		attrs.Priority = types.StringValue(fields.Priority.Name)
+	}
+	if fields.Parent != nil {
+		attrs.ParentKey = types.StringValue(fields.Parent.Key)
+	}
+	attrs.ParentSummary, attrs.ParentStatus = parentDetails(fields.Parent)
+
+	attrs.DueDate = stringOrNull(fields.DueDate)
+	attrs.Assignee = userAccountID(fields.Assignee)
+	attrs.Reporter = userAccountID(fields.Reporter)
+	attrs.CreatorAccountID = userAccountID(fields.Creator)
+	attrs.PriorityIconURL, attrs.PriorityColor = priorityStyle(fields.Priority)
+	attrs.IssueTypeIconURL = issueTypeIconURL(fields.IssueType)
+
+	if len(fields.Labels) > 0 {
+		attrs.Labels, diags = types.SetValueFrom(ctx, types.StringType, fields.Labels)
+	}
+
+	return attrs, diags
+}
+
+// nullIssueAttributes returns an attribute set with every attribute null.
+func nullIssueAttributes() IssueAttributesModel {
+	return IssueAttributesModel{
+		Key:              types.StringNull(),
+		ID:               types.StringNull(),
+		Project:          types.StringNull(),
+		Summary:          types.StringNull(),
+		Description:      types.StringNull(),
+		IssueType:        types.StringNull(),
+		Status:           types.StringNull(),
+		Priority:         types.StringNull(),
+		ParentKey:        types.StringNull(),
+		ParentSummary:    types.StringNull(),
+		ParentStatus:     types.StringNull(),
+		Labels:           types.SetNull(types.StringType),
+		DueDate:          types.StringNull(),
+		Assignee:         types.StringNull(),
+		Reporter:         types.StringNull(),
+		CreatorAccountID: types.StringNull(),
+		PriorityIconURL:  types.StringNull(),
+		PriorityColor:    types.StringNull(),
+		IssueTypeIconURL: types.StringNull(),
+	}
+}
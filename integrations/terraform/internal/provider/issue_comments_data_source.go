@@ -0,0 +1,200 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssueCommentsDataSource{}
+
+// NewIssueCommentsDataSource creates a new issue comments data source.
+func NewIssueCommentsDataSource() datasource.DataSource {
+	return &IssueCommentsDataSource{}
+}
+
+// IssueCommentsDataSource defines the data source implementation.
+type IssueCommentsDataSource struct {
+	client *client.JiraClient
+}
+
+// IssueCommentsDataSourceModel describes the data source data model.
+type IssueCommentsDataSourceModel struct {
+	IssueKey       types.String        `tfsdk:"issue_key"`
+	ResolveAuthors types.Bool          `tfsdk:"resolve_authors"`
+	Comments       []IssueCommentModel `tfsdk:"comments"`
+}
+
+// IssueCommentModel describes a single comment.
+type IssueCommentModel struct {
+	ID                types.String `tfsdk:"id"`
+	AuthorAccountID   types.String `tfsdk:"author_account_id"`
+	AuthorDisplayName types.String `tfsdk:"author_display_name"`
+	Body              types.String `tfsdk:"body"`
+	Created           types.String `tfsdk:"created"`
+	Updated           types.String `tfsdk:"updated"`
+}
+
+// Metadata returns the data source type name.
+func (d *IssueCommentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_comments"
+}
+
+// Schema defines the schema for the data source.
+func (d *IssueCommentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all comments on a Jira issue." + scopesNote("data.jira_issue_comments"),
+		MarkdownDescription: `
+Fetches all comments on a Jira issue, oldest first.
+
+Set ` + "`resolve_authors`" + ` to resolve author account IDs to display names. Authors are
+looked up in batches, so this costs one extra request per 90 distinct authors. Deleted
+users render as ` + "`Former user (<account id>)`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_issue_comments" "incident" {
+  issue_key       = "OPS-42"
+  resolve_authors = true
+}
+
+output "comment_authors" {
+  value = data.jira_issue_comments.incident.comments[*].author_display_name
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"issue_key": schema.StringAttribute{
+				Description: "The Jira issue key (e.g., PROJ-123).",
+				Required:    true,
+			},
+			"resolve_authors": schema.BoolAttribute{
+				Description: "Resolve author account IDs to display names (costs extra API calls).",
+				Optional:    true,
+			},
+			"comments": schema.ListNestedAttribute{
+				Description: "The issue comments, oldest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The comment ID.",
+							Computed:    true,
+						},
+						"author_account_id": schema.StringAttribute{
+							Description: "Account ID of the comment author.",
+							Computed:    true,
+						},
+						"author_display_name": schema.StringAttribute{
+							Description: "Display name of the comment author. Null unless resolve_authors is set.",
+							Computed:    true,
+						},
+						"body": schema.StringAttribute{
+							Description: "The comment body (plain text).",
+							Computed:    true,
+						},
+						"created": schema.StringAttribute{
+							Description: "When the comment was created.",
+							Computed:    true,
+						},
+						"updated": schema.StringAttribute{
+							Description: "When the comment was last updated.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IssueCommentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IssueCommentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssueCommentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue comments", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+	})
+
+	comments, err := d.client.GetComments(ctx, data.IssueKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read comments", err.Error())
+		return
+	}
+
+	var names map[string]string
+	if data.ResolveAuthors.ValueBool() {
+		authors := make([]string, 0, len(comments))
+		for _, comment := range comments {
+			if comment.Author != nil {
+				authors = append(authors, comment.Author.AccountID)
+			}
+		}
+
+		names, err = d.client.ResolveDisplayNames(ctx, authors)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resolve comment authors", err.Error())
+			return
+		}
+	}
+
+	data.Comments = make([]IssueCommentModel, 0, len(comments))
+	for _, comment := range comments {
+		data.Comments = append(data.Comments, IssueCommentModel{
+			ID:                types.StringValue(comment.ID),
+			AuthorAccountID:   userAccountID(comment.Author),
+			AuthorDisplayName: authorDisplayName(names, comment.Author),
+			Body:              types.StringValue(client.ADFToText(comment.Body)),
+			Created:           types.StringValue(comment.Created),
+			Updated:           types.StringValue(comment.Updated),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// authorDisplayName looks up a resolved author name, returning null when
+// authors were not resolved or the author is unknown.
+func authorDisplayName(names map[string]string, author *client.User) types.String {
+	if names == nil || author == nil {
+		return types.StringNull()
+	}
+
+	name, ok := names[author.AccountID]
+	if !ok {
+		return types.StringNull()
+	}
+	return types.StringValue(name)
+}
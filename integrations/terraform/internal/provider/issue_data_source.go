@@ -6,14 +6,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/spectra/terraform-provider-jira/internal/client"
 )
 
+// defaultIssueWaitTimeout is how long wait_for_existence polls when
+// wait_timeout is unset.
+const defaultIssueWaitTimeout = time.Minute
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &IssueDataSource{}
 
@@ -38,7 +46,25 @@ type IssueDataSourceModel struct {
 	Status      types.String `tfsdk:"status"`
 	Priority    types.String `tfsdk:"priority"`
 	ParentKey   types.String `tfsdk:"parent_key"`
-	Labels      types.List   `tfsdk:"labels"`
+	Labels      types.Set    `tfsdk:"labels"`
+
+	ParentSummary types.String `tfsdk:"parent_summary"`
+	ParentStatus  types.String `tfsdk:"parent_status"`
+
+	DueDate          types.String `tfsdk:"due_date"`
+	Assignee         types.String `tfsdk:"assignee"`
+	Reporter         types.String `tfsdk:"reporter"`
+	CreatorAccountID types.String `tfsdk:"creator_account_id"`
+
+	PriorityIconURL types.String `tfsdk:"priority_icon_url"`
+	PriorityColor   types.String `tfsdk:"priority_color"`
+
+	IssueTypeIconURL types.String `tfsdk:"issue_type_icon_url"`
+
+	AllowMissing     types.Bool   `tfsdk:"allow_missing"`
+	WaitForExistence types.Bool   `tfsdk:"wait_for_existence"`
+	WaitTimeout      types.String `tfsdk:"wait_timeout"`
+	Found            types.Bool   `tfsdk:"found"`
 }
 
 // Metadata returns the data source type name.
@@ -49,7 +75,7 @@ func (d *IssueDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 // Schema defines the schema for the data source.
 func (d *IssueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a Jira issue by key.",
+		Description: "Fetches a Jira issue by key." + scopesNote("data.jira_issue"),
 		MarkdownDescription: `
 Fetches a Jira issue by its key.
 
@@ -64,6 +90,19 @@ output "issue_summary" {
   value = data.jira_issue.existing.summary
 }
 
+# Optionally reference an issue that may not exist
+data "jira_issue" "tracking_epic" {
+  key           = "PROJ-1"
+  allow_missing = true
+}
+
+# Wait for an issue created outside this configuration, e.g. by automation
+data "jira_issue" "incident" {
+  key                = var.incident_key
+  wait_for_existence = true
+  wait_timeout       = "2m"
+}
+
 # Create a subtask under an existing issue
 resource "jira_subtask" "new_task" {
   project    = data.jira_issue.existing.project
@@ -71,14 +110,40 @@ resource "jira_subtask" "new_task" {
   summary    = "Additional task"
 }
 ` + "```" + `
+
+## Reading Issues Created in the Same Configuration
+
+Reference the resource's ` + "`key`" + ` attribute rather than a literal key, so Terraform reads
+the data source only after the issue is created:
+
+` + "```hcl" + `
+data "jira_issue" "created" {
+  key = jira_issue.example.key
+}
+` + "```" + `
+
+When the key is known before the issue exists (a variable, a predictable key, or an issue
+created by automation), set ` + "`wait_for_existence`" + ` so the read polls for the issue instead of
+failing on the first 404. Polls back off like request retries and stop after
+` + "`wait_timeout`" + `.
+
+## Reading Issues by ID
+
+Set ` + "`id`" + ` instead of ` + "`key`" + ` to look an issue up by its numeric ID. IDs survive a change of
+the project key, which renames every issue key in the project.
 `,
 		Attributes: map[string]schema.Attribute{
 			"key": schema.StringAttribute{
-				Description: "The Jira issue key (e.g., PROJ-123).",
-				Required:    true,
+				Description: "The Jira issue key (e.g., PROJ-123). Exactly one of key and id must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("id")),
+				},
 			},
 			"id": schema.StringAttribute{
-				Description: "The Jira issue ID.",
+				Description: "The Jira issue ID, which unlike the key survives project key changes. Exactly one of key and id must be set.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"project": schema.StringAttribute{
@@ -105,15 +170,67 @@ resource "jira_subtask" "new_task" {
 				Description: "The issue priority.",
 				Computed:    true,
 			},
+			"issue_type_icon_url": schema.StringAttribute{
+				Description: "URL of the issue type icon.",
+				Computed:    true,
+			},
+			"priority_icon_url": schema.StringAttribute{
+				Description: "URL of the priority icon.",
+				Computed:    true,
+			},
+			"priority_color": schema.StringAttribute{
+				Description: "Hex color Jira uses for the priority.",
+				Computed:    true,
+			},
 			"parent_key": schema.StringAttribute{
 				Description: "Parent issue key (if this is a subtask or story in an epic).",
 				Computed:    true,
 			},
-			"labels": schema.ListAttribute{
+			"parent_summary": schema.StringAttribute{
+				Description: "Summary of the parent issue, or null when the issue has no parent.",
+				Computed:    true,
+			},
+			"parent_status": schema.StringAttribute{
+				Description: "Status of the parent issue, or null when the issue has no parent.",
+				Computed:    true,
+			},
+			"labels": schema.SetAttribute{
 				Description: "Issue labels.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"due_date": schema.StringAttribute{
+				Description: "Due date in YYYY-MM-DD format, or null when unset.",
+				Computed:    true,
+			},
+			"assignee": schema.StringAttribute{
+				Description: "Account ID of the assignee, or null when unassigned.",
+				Computed:    true,
+			},
+			"reporter": schema.StringAttribute{
+				Description: "Account ID of the issue reporter.",
+				Computed:    true,
+			},
+			"creator_account_id": schema.StringAttribute{
+				Description: "Account ID of the user that created the issue.",
+				Computed:    true,
+			},
+			"allow_missing": schema.BoolAttribute{
+				Description: "Don't fail when the issue doesn't exist; found is false and all other attributes are null instead.",
+				Optional:    true,
+			},
+			"wait_for_existence": schema.BoolAttribute{
+				Description: "Poll for the issue while Jira reports it missing, instead of failing immediately. Combine with allow_missing to get found = false once wait_timeout elapses.",
+				Optional:    true,
+			},
+			"wait_timeout": schema.StringAttribute{
+				Description: "How long wait_for_existence polls before giving up, as a duration (e.g., 30s, 2m). Defaults to 1m.",
+				Optional:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether the issue exists.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -124,16 +241,16 @@ func (d *IssueDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.JiraClient)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.JiraClient, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -144,56 +261,81 @@ func (d *IssueDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	// The key or ID, whichever is configured.
+	lookup := data.Key.ValueString()
+	if !data.ID.IsNull() {
+		lookup = data.ID.ValueString()
+	}
+
 	tflog.Debug(ctx, "Reading Jira issue", map[string]any{
-		"key": data.Key.ValueString(),
+		"issue": lookup,
 	})
 
-	issue, err := d.client.GetIssue(data.Key.ValueString())
+	var issue *client.Issue
+	var err error
+	if data.WaitForExistence.ValueBool() {
+		timeout := defaultIssueWaitTimeout
+		if !data.WaitTimeout.IsNull() {
+			timeout, err = time.ParseDuration(data.WaitTimeout.ValueString())
+			if err != nil || timeout <= 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("wait_timeout"),
+					"Invalid Wait Timeout",
+					fmt.Sprintf("wait_timeout must be a positive duration such as 30s or 2m, got %q.", data.WaitTimeout.ValueString()),
+				)
+				return
+			}
+		}
+		issue, err = d.client.WaitForIssue(ctx, lookup, timeout)
+	} else {
+		issue, err = d.client.GetIssue(ctx, lookup)
+	}
 	if err != nil {
+		if client.IsNotFound(err) && data.AllowMissing.ValueBool() {
+			tflog.Debug(ctx, "Jira issue not found", map[string]any{
+				"issue": lookup,
+			})
+			data.setAttributes(nullIssueAttributes())
+			data.Found = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
 		resp.Diagnostics.AddError("Failed to read issue", err.Error())
 		return
 	}
 
 	// Populate data from API response
-	data.ID = types.StringValue(issue.ID)
-	data.Summary = types.StringValue(issue.Fields.Summary)
-
-	if issue.Fields.Description != nil {
-		data.Description = types.StringValue(client.ADFToText(issue.Fields.Description))
-	} else {
-		data.Description = types.StringNull()
-	}
-
-	if issue.Fields.Project != nil {
-		data.Project = types.StringValue(issue.Fields.Project.Key)
-	}
-
-	if issue.Fields.IssueType != nil {
-		data.IssueType = types.StringValue(issue.Fields.IssueType.Name)
-	}
-
-	if issue.Fields.Status != nil {
-		data.Status = types.StringValue(issue.Fields.Status.Name)
-	}
-
-	if issue.Fields.Priority != nil {
-		data.Priority = types.StringValue(issue.Fields.Priority.Name)
-	}
+	attrs, diags := mapIssue(ctx, issue)
+	resp.Diagnostics.Append(diags...)
+	data.setAttributes(attrs)
+	data.Found = types.BoolValue(true)
 
-	if issue.Fields.Parent != nil {
-		data.ParentKey = types.StringValue(issue.Fields.Parent.Key)
-	} else {
-		data.ParentKey = types.StringNull()
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	if len(issue.Fields.Labels) > 0 {
-		labels, diags := types.ListValueFrom(ctx, types.StringType, issue.Fields.Labels)
-		resp.Diagnostics.Append(diags...)
-		data.Labels = labels
+// setAttributes copies the standard issue attributes, except the configured
+// key or ID, into the model.
+func (m *IssueDataSourceModel) setAttributes(attrs IssueAttributesModel) {
+	if m.Key.IsNull() {
+		m.Key = attrs.Key
 	} else {
-		data.Labels = types.ListNull(types.StringType)
+		m.ID = attrs.ID
 	}
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	m.Project = attrs.Project
+	m.Summary = attrs.Summary
+	m.Description = attrs.Description
+	m.IssueType = attrs.IssueType
+	m.Status = attrs.Status
+	m.Priority = attrs.Priority
+	m.ParentKey = attrs.ParentKey
+	m.Labels = attrs.Labels
+	m.ParentSummary = attrs.ParentSummary
+	m.ParentStatus = attrs.ParentStatus
+	m.DueDate = attrs.DueDate
+	m.Assignee = attrs.Assignee
+	m.Reporter = attrs.Reporter
+	m.CreatorAccountID = attrs.CreatorAccountID
+	m.PriorityIconURL = attrs.PriorityIconURL
+	m.PriorityColor = attrs.PriorityColor
+	m.IssueTypeIconURL = attrs.IssueTypeIconURL
 }
-
@@ -0,0 +1,121 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Values of on_destroy.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyClose   = "close"
+	onDestroyAbandon = "abandon"
+)
+
+// Defaults of close_status and close_label.
+const (
+	defaultCloseStatus = "Done"
+	defaultCloseLabel  = "terraform-abandoned"
+)
+
+// onDestroyAttribute returns the schema of on_destroy.
+func onDestroyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "What destroying the resource does in Jira: delete (the default) deletes the issue, close labels it with close_label and transitions it to close_status, and abandon only removes it from state. close and abandon don't need the Delete Issues permission.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyDelete, onDestroyClose, onDestroyAbandon),
+		},
+	}
+}
+
+// closeStatusAttribute returns the schema of close_status.
+func closeStatusAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The status on_destroy = \"close\" transitions the issue to. Defaults to \"" + defaultCloseStatus + "\". A transition to it must be available from the issue's current status.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// closeLabelAttribute returns the schema of close_label.
+func closeLabelAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The label on_destroy = \"close\" adds before closing the issue. Defaults to \"" + defaultCloseLabel + "\"; set to \"\" to add none.",
+		Optional:    true,
+	}
+}
+
+// destroyIssue removes an issue from Jira as on_destroy asks, deleting its
+// subtasks with it when deleteSubtasks is set. An issue that is already gone
+// counts as destroyed.
+func destroyIssue(ctx context.Context, c *client.JiraClient, key string, onDestroy, closeStatus, closeLabel types.String, deleteSubtasks bool) error {
+	switch onDestroy.ValueString() {
+	case onDestroyAbandon:
+		tflog.Info(ctx, "Leaving Jira issue in place as on_destroy is abandon", map[string]any{
+			"key": key,
+		})
+		return nil
+	case onDestroyClose:
+		status := defaultCloseStatus
+		if !closeStatus.IsNull() {
+			status = closeStatus.ValueString()
+		}
+		label := defaultCloseLabel
+		if !closeLabel.IsNull() {
+			label = closeLabel.ValueString()
+		}
+		return closeIssue(ctx, c, key, status, label)
+	}
+
+	err := c.DeleteIssue(ctx, key, deleteSubtasks)
+	if client.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// closeIssue labels an issue and transitions it to status. The label goes
+// on first, since workflows often make closed issues read-only.
+func closeIssue(ctx context.Context, c *client.JiraClient, key, status, label string) error {
+	issue, err := c.GetIssue(ctx, key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read issue %s: %w", key, err)
+	}
+
+	if label != "" && !slices.Contains(issue.Fields.Labels, label) {
+		if err := c.UpdateIssueVerbs(ctx, key, client.UpdateVerbs{}.AddLabel(label)); err != nil {
+			return fmt.Errorf("failed to add label %q to issue %s: %w", label, key, err)
+		}
+	}
+
+	var current string
+	if issue.Fields.Status != nil {
+		current = issue.Fields.Status.Name
+	}
+	if _, err := transitionToStatus(ctx, c, key, current, nil, status); err != nil {
+		return fmt.Errorf("%w\n\nSet close_status to a status the issue can reach, or set on_destroy to %q to leave the issue in Jira as it is", err, onDestroyAbandon)
+	}
+
+	tflog.Info(ctx, "Closed Jira issue instead of deleting it", map[string]any{
+		"key":    key,
+		"status": status,
+	})
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Values of on_uneditable_field.
+const (
+	uneditableFieldError = "error"
+	uneditableFieldSkip  = "skip"
+)
+
+// screenedSystemFields maps the system fields updates are screened for to
+// the attributes that set them. Along with custom fields, these are the
+// fields projects most often leave off edit screens.
+var screenedSystemFields = map[string]string{
+	"priority":    "priority",
+	"fixVersions": "fix_versions",
+	"versions":    "affects_versions",
+}
+
+// onUneditableFieldAttribute returns the schema of on_uneditable_field.
+func onUneditableFieldAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Check updates that set priority, fix_versions, affects_versions or custom fields against the issue's edit screen first. Jira rejects updates that set a field missing from the screen, even one that could be set at create: error fails the apply naming the field, skip leaves it out of the update with a warning, keeping its value in Jira. Unset, updates are sent unchecked.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(uneditableFieldError, uneditableFieldSkip),
+		},
+	}
+}
+
+// screenUpdate checks the fields an update sets or clears against the
+// issue's edit screen, as on_uneditable_field asks. Missing fields are
+// reported as errors, or dropped from the update with a warning. attributes
+// names the attribute behind custom fields that custom_fields didn't set,
+// such as the start date and epic name fields.
+func (r *IssueResource) screenUpdate(ctx context.Context, key string, mode types.String, update *client.UpdateIssueRequest, attributes map[string]string, diags *diag.Diagnostics) {
+	if mode.IsNull() {
+		return
+	}
+
+	screened := screenedFieldIDs(update)
+	if len(screened) == 0 {
+		return
+	}
+
+	editable, err := r.client.GetEditMeta(ctx, key)
+	if err != nil {
+		diags.AddError("Failed to read edit screen", fmt.Sprintf("Could not read the edit metadata of issue %s for on_uneditable_field: %s", key, err))
+		return
+	}
+
+	for _, id := range screened {
+		if _, ok := editable[id]; ok {
+			continue
+		}
+
+		attribute := screenedAttribute(id, attributes)
+
+		if mode.ValueString() == uneditableFieldSkip {
+			tflog.Debug(ctx, "Leaving field off issue update", map[string]any{
+				"key":   key,
+				"field": id,
+			})
+			update.Omit(id)
+			diags.AddAttributeWarning(path.Root(attribute), "Uneditable Field Skipped",
+				fmt.Sprintf("Field %s is not on the edit screen of issue %s, so it was left out of the update and keeps its current value in Jira. "+
+					"The next refresh reads that value back, so %s shows a change until the field is added to the project's edit screen.", id, key, attribute))
+			continue
+		}
+
+		diags.AddAttributeError(path.Root(attribute), "Field Not On Edit Screen",
+			fmt.Sprintf("Field %s is not on the edit screen of issue %s, so Jira would reject the update even though the field could be set at create. "+
+				"Add the field to the project's edit screen, or set on_uneditable_field = %q to leave it unchanged.", id, key, uneditableFieldSkip))
+	}
+}
+
+// screenedAttribute returns the attribute that set the screened field id.
+func screenedAttribute(id string, attributes map[string]string) string {
+	if attribute, ok := screenedSystemFields[id]; ok {
+		return attribute
+	}
+	if attribute, ok := attributes[id]; ok {
+		return attribute
+	}
+	return "custom_fields"
+}
+
+// screenedFieldIDs returns the IDs of the screened fields an update sets,
+// clears or changes with verbs, sorted.
+func screenedFieldIDs(update *client.UpdateIssueRequest) []string {
+	fields := &update.Fields
+	seen := make(map[string]bool)
+	for id := range update.Update {
+		if _, ok := screenedSystemFields[id]; ok || client.IsCustomFieldID(id) {
+			seen[id] = true
+		}
+	}
+	if fields.Priority != nil {
+		seen["priority"] = true
+	}
+	if len(fields.FixVersions) > 0 {
+		seen["fixVersions"] = true
+	}
+	if len(fields.Versions) > 0 {
+		seen["versions"] = true
+	}
+	for id := range fields.Custom {
+		seen[id] = true
+	}
+	for _, id := range fields.Clear {
+		if _, ok := screenedSystemFields[id]; ok || client.IsCustomFieldID(id) {
+			seen[id] = true
+		}
+	}
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,139 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// screenedUpdate returns an update setting a field for each attribute
+// screening knows about; only summary and priority are on the edit screen.
+func screenedUpdate() *client.UpdateIssueRequest {
+	update := &client.UpdateIssueRequest{
+		Fields: client.IssueFields{
+			Summary:  "Renamed",
+			Priority: client.PriorityRef("High"),
+			Custom: map[string]json.RawMessage{
+				"customfield_10011": json.RawMessage(`"Epic"`),
+				"customfield_10050": json.RawMessage(`"value"`),
+			},
+			Clear: []string{"customfield_10015"},
+		},
+		Update: client.UpdateVerbs{},
+	}
+	update.Update.AddFixVersion("1.0")
+	return update
+}
+
+var screenedAttributes = map[string]string{
+	"customfield_10011": "epic_name",
+	"customfield_10015": "start_date",
+}
+
+func newScreeningResource(t *testing.T) *IssueResource {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/PROJ-1/editmeta" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"fields":{"summary":{"key":"summary"},"priority":{"key":"priority"}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &IssueResource{client: c}
+}
+
+// diagnosticPaths returns the attribute paths of diags, by summary.
+func diagnosticPaths(diags diag.Diagnostics) map[string][]path.Path {
+	paths := make(map[string][]path.Path)
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths[d.Summary()] = append(paths[d.Summary()], withPath.Path())
+		}
+	}
+	return paths
+}
+
+func TestScreenUpdateError(t *testing.T) {
+	r := newScreeningResource(t)
+	update := screenedUpdate()
+
+	var diags diag.Diagnostics
+	r.screenUpdate(context.Background(), "PROJ-1", types.StringValue(uneditableFieldError), update, screenedAttributes, &diags)
+
+	want := []path.Path{
+		path.Root("epic_name"),
+		path.Root("start_date"),
+		path.Root("custom_fields"),
+		path.Root("fix_versions"),
+	}
+	if got := diagnosticPaths(diags)["Field Not On Edit Screen"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("error paths = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(update, screenedUpdate()) {
+		t.Errorf("error mode changed the update: %+v", update)
+	}
+}
+
+func TestScreenUpdateSkip(t *testing.T) {
+	r := newScreeningResource(t)
+	update := screenedUpdate()
+
+	var diags diag.Diagnostics
+	r.screenUpdate(context.Background(), "PROJ-1", types.StringValue(uneditableFieldSkip), update, screenedAttributes, &diags)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	want := []path.Path{
+		path.Root("epic_name"),
+		path.Root("start_date"),
+		path.Root("custom_fields"),
+		path.Root("fix_versions"),
+	}
+	if got := diagnosticPaths(diags)["Uneditable Field Skipped"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("warning paths = %v, want %v", got, want)
+	}
+
+	body, err := json.Marshal(update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"fields":{"summary":"Renamed","priority":{"name":"High"}}}`; string(body) != want {
+		t.Errorf("skipped update = %s, want %s", body, want)
+	}
+}
+
+func TestScreenUpdateUnset(t *testing.T) {
+	// With on_uneditable_field unset the edit screen isn't read at all; the
+	// resource's client would fail the test if it were.
+	r := &IssueResource{}
+	update := screenedUpdate()
+
+	var diags diag.Diagnostics
+	r.screenUpdate(context.Background(), "PROJ-1", types.StringNull(), update, screenedAttributes, &diags)
+
+	if len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(update, screenedUpdate()) {
+		t.Errorf("unset mode changed the update: %+v", update)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// epicColorPattern matches the board colors Jira offers for epics.
+var epicColorPattern = regexp.MustCompile(`^ghx-label-([1-9]|1[0-4])$`)
+
+// epicPaletteKey matches the form Jira stores epic colors in: color_1
+// through color_14 for ghx-label-1 through ghx-label-14.
+var epicPaletteKey = regexp.MustCompile(`^color_([1-9]|1[0-4])$`)
+
+// epicColorKey converts an epic_color value to the palette key Jira stores.
+func epicColorKey(color string) string {
+	return "color_" + strings.TrimPrefix(color, "ghx-label-")
+}
+
+// epicColorLabel maps a color read from Jira back to the palette name
+// epic_color uses. Values outside the palette are returned as they are, so
+// they show up as drift that the next plan rejects.
+func epicColorLabel(value string) string {
+	if match := epicPaletteKey.FindStringSubmatch(value); match != nil {
+		return "ghx-label-" + match[1]
+	}
+	return value
+}
+
+// validateEpicAttributes rejects epic_name and epic_color on issue types
+// named other than Epic. Issue types given by ID are checked at apply time
+// instead, once the type can be looked up.
+func validateEpicAttributes(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var issueType types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("issue_type"), &issueType)...)
+	if diags.HasError() || issueType.IsNull() || issueType.IsUnknown() ||
+		client.IsID(issueType.ValueString()) || strings.EqualFold(issueType.ValueString(), client.EpicIssueTypeName) {
+		return
+	}
+
+	for _, name := range []string{"epic_name", "epic_color"} {
+		var value types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root(name),
+			"Epic Attribute on Non-Epic Issue",
+			fmt.Sprintf("%s can only be set on epics, but issue_type is %q.", name, issueType.ValueString()),
+		)
+	}
+}
+
+// epicFields looks up the fields epic_name and epic_color are written to,
+// or returns nil when neither is set. It reports an error when the issue
+// type isn't an epic or the project lacks a configured field.
+func (r *IssueResource) epicFields(ctx context.Context, data IssueResourceModel, diags *diag.Diagnostics) *client.EpicFields {
+	if data.EpicName.IsNull() && data.EpicColor.IsNull() {
+		return nil
+	}
+
+	epic, err := r.client.EpicFieldIDs(ctx, data.Project.ValueString(), data.IssueType.ValueString())
+	if err != nil {
+		diags.AddError("Failed to look up epic fields", err.Error())
+		return nil
+	}
+	if epic == nil {
+		attribute := path.Root("epic_name")
+		if data.EpicName.IsNull() {
+			attribute = path.Root("epic_color")
+		}
+		diags.AddAttributeError(
+			attribute,
+			"Epic Attribute on Non-Epic Issue",
+			fmt.Sprintf("Issue type %s in project %s isn't an epic, so epic_name and epic_color can't be set.",
+				data.IssueType.ValueString(), data.Project.ValueString()),
+		)
+		return nil
+	}
+
+	if !data.EpicName.IsNull() && epic.NameFieldID == "" {
+		diags.AddAttributeError(
+			path.Root("epic_name"),
+			"Epic Name Field Not Found",
+			fmt.Sprintf("Project %s has no Epic Name field on its epic create screen. Team-managed projects name epics by their summary; remove epic_name.",
+				data.Project.ValueString()),
+		)
+	}
+	if !data.EpicColor.IsNull() && !epic.AgileColor && epic.ColorFieldID == "" {
+		diags.AddAttributeError(
+			path.Root("epic_color"),
+			"Epic Color Field Not Found",
+			fmt.Sprintf("Team-managed project %s has no Issue color field, so epic colors can only be set on its roadmap; remove epic_color.",
+				data.Project.ValueString()),
+		)
+	}
+	if diags.HasError() {
+		return nil
+	}
+	return epic
+}
+
+// setEpicField writes one epic attribute to its custom field.
+func setEpicField(fields *client.IssueFields, id string, value types.String, diags *diag.Diagnostics) {
+	if err := fields.SetCustom(id, value.ValueString()); err != nil {
+		diags.AddError("Failed to set epic field", err.Error())
+	}
+}
+
+// setEpicColor sets an epic's color. Company-managed projects keep it with
+// the epic's board data, written through the Agile API; team-managed
+// projects keep it in the Issue color field, written with update.
+func (r *IssueResource) setEpicColor(ctx context.Context, key string, epic *client.EpicFields, color types.String, update func(context.Context, string, *client.UpdateIssueRequest) error) error {
+	value := epicColorKey(color.ValueString())
+	if epic.AgileColor {
+		return r.client.SetEpicColor(ctx, key, value)
+	}
+
+	var fields client.IssueFields
+	if err := fields.SetCustom(epic.ColorFieldID, value); err != nil {
+		return err
+	}
+	return update(ctx, key, &client.UpdateIssueRequest{Fields: fields})
+}
+
+// readEpicFields maps the epic attributes to state. They are only read
+// once configured; removing one stops managing it, since Jira requires an
+// epic name and always assigns a color.
+func (r *IssueResource) readEpicFields(ctx context.Context, issue *client.Issue, data *IssueResourceModel, diags *diag.Diagnostics) {
+	if data.EpicName.IsNull() && data.EpicColor.IsNull() {
+		return
+	}
+
+	epic, err := r.client.EpicFieldIDs(ctx, data.Project.ValueString(), data.IssueType.ValueString())
+	if err != nil {
+		diags.AddError("Failed to look up epic fields", err.Error())
+		return
+	}
+	if epic == nil {
+		return
+	}
+
+	if !data.EpicName.IsNull() && epic.NameFieldID != "" {
+		name, _ := issue.Fields.CustomString(epic.NameFieldID)
+		data.EpicName = stringOrNull(name)
+	}
+	if data.EpicColor.IsNull() {
+		return
+	}
+	switch {
+	case epic.AgileColor:
+		agileEpic, err := r.client.GetEpic(ctx, issue.Key)
+		if err != nil {
+			diags.AddError("Failed to read epic color", err.Error())
+			return
+		}
+		data.EpicColor = types.StringNull()
+		if agileEpic.Color != nil {
+			data.EpicColor = stringOrNull(epicColorLabel(agileEpic.Color.Key))
+		}
+	case epic.ColorFieldID != "":
+		color, _ := issue.Fields.CustomString(epic.ColorFieldID)
+		data.EpicColor = stringOrNull(epicColorLabel(color))
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// estimatePattern matches a Jira duration with units. Unlike time_spent on
+// worklogs, bare numbers aren't accepted, since Jira reads them in the
+// site's default unit rather than as seconds.
+var estimatePattern = regexp.MustCompile(`^\s*(\d+[wdhm]\s*)+$`)
+
+// issueTimeTracking returns the timetracking field for the configured
+// estimates, or nil when neither is set.
+func issueTimeTracking(data IssueResourceModel) *client.TimeTracking {
+	if data.OriginalEstimate.IsNull() && data.RemainingEstimate.IsNull() {
+		return nil
+	}
+	return &client.TimeTracking{
+		OriginalEstimate:  data.OriginalEstimate.ValueString(),
+		RemainingEstimate: data.RemainingEstimate.ValueString(),
+	}
+}
+
+// readEstimates maps an issue's estimates to state. Estimates are only
+// tracked once configured (or on import), and a configured duration is kept
+// while it amounts to what Jira holds, so "20h" doesn't drift to "2d 4h".
+func readEstimates(issue *client.Issue, data *IssueResourceModel, importing bool) {
+	tracking := issue.Fields.TimeTracking
+	if tracking == nil {
+		tracking = &client.TimeTracking{}
+	}
+
+	if !data.OriginalEstimate.IsNull() || importing {
+		data.OriginalEstimate = readEstimate(tracking.OriginalEstimate, tracking.OriginalEstimateSeconds, data.OriginalEstimate)
+	}
+	if !data.RemainingEstimate.IsNull() || importing {
+		data.RemainingEstimate = readEstimate(tracking.RemainingEstimate, tracking.RemainingEstimateSeconds, data.RemainingEstimate)
+	}
+}
+
+// readEstimate returns the state value of one estimate.
+func readEstimate(remote string, seconds int64, prior types.String) types.String {
+	if remote == "" {
+		return types.StringNull()
+	}
+	if want, ok := worklogSeconds(prior.ValueString()); ok && !prior.IsNull() && want == seconds {
+		return prior
+	}
+	return types.StringValue(remote)
+}
+
+// addTimeTrackingError reports a write Jira rejected because of the
+// timetracking field, and whether it did. Jira gives the same terse field
+// error whether time tracking is disabled on the site or the field is
+// missing from the project's screens, so both causes are named.
+func addTimeTrackingError(diags *diag.Diagnostics, data IssueResourceModel, err error) bool {
+	msg, ok := client.FieldError(err, "timetracking")
+	if !ok {
+		return false
+	}
+
+	attribute := path.Root("original_estimate")
+	if data.OriginalEstimate.IsNull() {
+		attribute = path.Root("remaining_estimate")
+	}
+	diags.AddAttributeError(
+		attribute,
+		"Time Tracking Unavailable",
+		fmt.Sprintf("Jira refused the estimates for a %s issue in %s: %s\n\n"+
+			"Time tracking must be enabled for the site (Settings > Issues > Time tracking), and the "+
+			"Time tracking field must be on the project's create and edit screens for this issue type. "+
+			"Remove original_estimate and remaining_estimate if the project doesn't track time.",
+			data.IssueType.ValueString(), data.Project.ValueString(), msg),
+	)
+	return true
+}
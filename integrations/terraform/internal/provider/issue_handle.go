@@ -0,0 +1,78 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// issueHandleAttrTypes are the attribute types of the handle object.
+var issueHandleAttrTypes = map[string]attr.Type{
+	"key":     types.StringType,
+	"id":      types.StringType,
+	"url":     types.StringType,
+	"project": types.StringType,
+}
+
+// IssueHandleModel describes the handle of a managed issue: the values
+// other modules need to refer to it, as one object.
+type IssueHandleModel struct {
+	Key     types.String `tfsdk:"key"`
+	ID      types.String `tfsdk:"id"`
+	URL     types.String `tfsdk:"url"`
+	Project types.String `tfsdk:"project"`
+}
+
+// issueHandleAttribute returns the schema of the computed handle attribute
+// shared by jira_issue and jira_subtask.
+func issueHandleAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "The key, id, browse url, and project key of the issue as one object, for modules to pass on as a single value.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "The issue key.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The issue ID.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL of the issue in the Jira web UI.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The project key.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// issueHandle builds the handle object from an issue's key, ID, and
+// project, so it always matches the scalar attributes.
+func issueHandle(ctx context.Context, c *client.JiraClient, key, id, project types.String) (types.Object, diag.Diagnostics) {
+	url := types.StringNull()
+	if !key.IsNull() && !key.IsUnknown() {
+		url = types.StringValue(c.BrowseURL(key.ValueString()))
+	}
+	return types.ObjectValueFrom(ctx, issueHandleAttrTypes, IssueHandleModel{
+		Key:     key,
+		ID:      id,
+		URL:     url,
+		Project: project,
+	})
+}
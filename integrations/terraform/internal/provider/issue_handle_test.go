@@ -0,0 +1,48 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+func TestIssueHandle(t *testing.T) {
+	ctx := context.Background()
+	c, err := client.NewJiraClient("https://example.atlassian.net/", "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handle, diags := issueHandle(ctx, c, types.StringValue("PROJ-7"), types.StringValue("10070"), types.StringValue("PROJ"))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	var got IssueHandleModel
+	if diags := handle.As(ctx, &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := IssueHandleModel{
+		Key:     types.StringValue("PROJ-7"),
+		ID:      types.StringValue("10070"),
+		URL:     types.StringValue("https://example.atlassian.net/browse/PROJ-7"),
+		Project: types.StringValue("PROJ"),
+	}
+	if got != want {
+		t.Errorf("handle = %+v, want %+v", got, want)
+	}
+
+	// Without a key there is no browse URL.
+	handle, diags = issueHandle(ctx, c, types.StringNull(), types.StringNull(), types.StringValue("PROJ"))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if url := handle.Attributes()["url"]; !url.IsNull() {
+		t.Errorf("url = %s without a key, want null", url)
+	}
+}
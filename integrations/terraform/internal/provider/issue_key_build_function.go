@@ -0,0 +1,79 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IssueKeyBuildFunction{}
+
+// NewIssueKeyBuildFunction creates a new issue_key_build function.
+func NewIssueKeyBuildFunction() function.Function {
+	return &IssueKeyBuildFunction{}
+}
+
+// IssueKeyBuildFunction defines the function implementation.
+type IssueKeyBuildFunction struct{}
+
+// Metadata returns the function name.
+func (f *IssueKeyBuildFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "issue_key_build"
+}
+
+// Definition defines the function's parameters and return type.
+func (f *IssueKeyBuildFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an issue key from a project key and issue number.",
+		MarkdownDescription: `
+Returns the issue key for ` + "`number`" + ` in ` + "`project`" + `: ` + "`PROJ-123`" + ` for ` + "`PROJ`" + ` and
+` + "`123`" + `. The result always parses back with ` + "`issue_key_project`" + ` and
+` + "`issue_key_number`" + `; a project key or number that can't form a valid key is an error.
+
+` + "```hcl" + `
+locals {
+  epic_key = provider::jira::issue_key_build(var.project, var.epic_number)
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "project",
+				Description: "The project key, e.g. PROJ.",
+			},
+			function.Int64Parameter{
+				Name:        "number",
+				Description: "The issue number, a positive whole number.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the result.
+func (f *IssueKeyBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		project string
+		number  int64
+	)
+	resp.Error = req.Arguments.Get(ctx, &project, &number)
+	if resp.Error != nil {
+		return
+	}
+
+	if !issueKeyProjectPattern.MatchString(project) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a project key; keys are built as %s", project, issueKeyPatternText))
+		return
+	}
+	if number < 1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("%d is not an issue number; keys are built as %s", number, issueKeyPatternText))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, fmt.Sprintf("%s-%d", project, number))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction runs f with args and returns its result, or its error text.
+func runFunction(f function.Function, result attr.Value, args ...attr.Value) (attr.Value, string) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData(args)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+	if resp.Error != nil {
+		return nil, resp.Error.Error()
+	}
+	return resp.Result.Value(), ""
+}
+
+func TestParseIssueKey(t *testing.T) {
+	tests := []struct {
+		key         string
+		wantProject string
+		wantNumber  int64
+		wantError   string
+	}{
+		{key: "PROJ-123", wantProject: "PROJ", wantNumber: 123},
+		{key: "X1-9", wantProject: "X1", wantNumber: 9},
+		{key: "MY_PROJ-1", wantProject: "MY_PROJ", wantNumber: 1},
+		{key: "proj-1", wantError: "is not an issue key"},
+		{key: "PROJ-0", wantError: "is not an issue key"},
+		{key: "PROJ-012", wantError: "is not an issue key"},
+		{key: "1PROJ-1", wantError: "is not an issue key"},
+		{key: "PROJ", wantError: "is not an issue key"},
+		{key: " PROJ-1", wantError: "is not an issue key"},
+		{key: "PROJ-99999999999999999999", wantError: "too large"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			project, number, err := parseIssueKey(tt.key)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Fatalf("parseIssueKey() error = %v, want one containing %q", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if project != tt.wantProject || number != tt.wantNumber {
+				t.Errorf("parseIssueKey() = %s, %d, want %s, %d", project, number, tt.wantProject, tt.wantNumber)
+			}
+		})
+	}
+}
+
+func TestIssueKeyFunctions(t *testing.T) {
+	project, errText := runFunction(&IssueKeyProjectFunction{}, types.StringUnknown(), types.StringValue("X1-9"))
+	if errText != "" || !project.Equal(types.StringValue("X1")) {
+		t.Errorf("issue_key_project(X1-9) = %v, %s, want X1", project, errText)
+	}
+
+	number, errText := runFunction(&IssueKeyNumberFunction{}, types.Int64Unknown(), types.StringValue("X1-9"))
+	if errText != "" || !number.Equal(types.Int64Value(9)) {
+		t.Errorf("issue_key_number(X1-9) = %v, %s, want 9", number, errText)
+	}
+
+	for _, f := range []function.Function{&IssueKeyProjectFunction{}, &IssueKeyNumberFunction{}} {
+		if _, errText := runFunction(f, types.StringUnknown(), types.StringValue("proj-1")); !strings.Contains(errText, "PROJECT-NUMBER") {
+			t.Errorf("%T error = %q, want one naming the PROJECT-NUMBER format", f, errText)
+		}
+	}
+}
+
+func TestIssueKeyBuildFunction(t *testing.T) {
+	tests := []struct {
+		name      string
+		project   string
+		number    int64
+		want      string
+		wantError string
+	}{
+		{name: "valid", project: "PROJ", number: 123, want: "PROJ-123"},
+		{name: "digits in project", project: "X1", number: 9, want: "X1-9"},
+		{name: "lowercase project", project: "proj", number: 1, wantError: "is not a project key"},
+		{name: "project with number", project: "PROJ-1", number: 1, wantError: "is not a project key"},
+		{name: "zero", project: "PROJ", number: 0, wantError: "is not an issue number"},
+		{name: "negative", project: "PROJ", number: -4, wantError: "is not an issue number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errText := runFunction(&IssueKeyBuildFunction{}, types.StringUnknown(), types.StringValue(tt.project), types.Int64Value(tt.number))
+			if tt.wantError != "" {
+				if !strings.Contains(errText, tt.wantError) {
+					t.Fatalf("Run() error = %q, want one containing %q", errText, tt.wantError)
+				}
+				return
+			}
+			if errText != "" {
+				t.Fatal(errText)
+			}
+			if !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run() = %v, want %s", got, tt.want)
+			}
+
+			// Built keys parse back to their parts.
+			project, number, err := parseIssueKey(tt.want)
+			if err != nil || project != tt.project || number != tt.number {
+				t.Errorf("parseIssueKey(%s) = %s, %d, %v", tt.want, project, number, err)
+			}
+		})
+	}
+}
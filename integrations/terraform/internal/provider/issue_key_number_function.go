@@ -0,0 +1,67 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IssueKeyNumberFunction{}
+
+// NewIssueKeyNumberFunction creates a new issue_key_number function.
+func NewIssueKeyNumberFunction() function.Function {
+	return &IssueKeyNumberFunction{}
+}
+
+// IssueKeyNumberFunction defines the function implementation.
+type IssueKeyNumberFunction struct{}
+
+// Metadata returns the function name.
+func (f *IssueKeyNumberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "issue_key_number"
+}
+
+// Definition defines the function's parameters and return type.
+func (f *IssueKeyNumberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the issue number of an issue key.",
+		MarkdownDescription: `
+Returns the number part of an issue key: ` + "`123`" + ` for ` + "`PROJ-123`" + `, and ` + "`9`" + ` for
+` + "`X1-9`" + `. A malformed key is an error naming the expected format.
+
+` + "```hcl" + `
+output "issue_number" {
+  value = provider::jira::issue_key_number(jira_issue.example.key)
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "The issue key, e.g. PROJ-123.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run computes the result.
+func (f *IssueKeyNumberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+	resp.Error = req.Arguments.Get(ctx, &key)
+	if resp.Error != nil {
+		return
+	}
+
+	_, number, err := parseIssueKey(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, number)
+}
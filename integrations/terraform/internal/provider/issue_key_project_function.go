@@ -0,0 +1,99 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// issueKeyPatternText describes the issue keys the key functions accept, for
+// error messages.
+const issueKeyPatternText = "PROJECT-NUMBER, such as PROJ-123, where PROJECT is an uppercase letter followed by uppercase letters, digits or underscores and NUMBER is a positive whole number"
+
+// issueKeyPattern and issueKeyProjectPattern match issue and project keys.
+// They are looser than projectKeyPattern, which covers the keys new projects
+// may take: site admins can allow underscores and longer keys.
+var (
+	issueKeyPattern        = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)-([1-9][0-9]*)$`)
+	issueKeyProjectPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IssueKeyProjectFunction{}
+
+// NewIssueKeyProjectFunction creates a new issue_key_project function.
+func NewIssueKeyProjectFunction() function.Function {
+	return &IssueKeyProjectFunction{}
+}
+
+// IssueKeyProjectFunction defines the function implementation.
+type IssueKeyProjectFunction struct{}
+
+// Metadata returns the function name.
+func (f *IssueKeyProjectFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "issue_key_project"
+}
+
+// Definition defines the function's parameters and return type.
+func (f *IssueKeyProjectFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the project key of an issue key.",
+		MarkdownDescription: `
+Returns the project part of an issue key: ` + "`PROJ`" + ` for ` + "`PROJ-123`" + `. Project keys may
+contain digits, so ` + "`X1-9`" + ` gives ` + "`X1`" + `. A malformed key is an error naming the
+expected format.
+
+` + "```hcl" + `
+resource "jira_issue" "follow_up" {
+  project    = provider::jira::issue_key_project(var.incident_key)
+  summary    = "Follow up on ${var.incident_key}"
+  issue_type = "Task"
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "The issue key, e.g. PROJ-123.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the result.
+func (f *IssueKeyProjectFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+	resp.Error = req.Arguments.Get(ctx, &key)
+	if resp.Error != nil {
+		return
+	}
+
+	project, _, err := parseIssueKey(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, project)
+}
+
+// parseIssueKey splits an issue key into its project key and issue number.
+func parseIssueKey(key string) (string, int64, error) {
+	match := issueKeyPattern.FindStringSubmatch(key)
+	if match == nil {
+		return "", 0, fmt.Errorf("%q is not an issue key; expected %s", key, issueKeyPatternText)
+	}
+
+	number, err := strconv.ParseInt(match[2], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("%q has an issue number that is too large", key)
+	}
+	return match[1], number, nil
+}
@@ -0,0 +1,396 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueLinkResource{}
+var _ resource.ResourceWithImportState = &IssueLinkResource{}
+var _ resource.ResourceWithModifyPlan = &IssueLinkResource{}
+
+// NewIssueLinkResource creates a new issue link resource.
+func NewIssueLinkResource() resource.Resource {
+	return &IssueLinkResource{}
+}
+
+// IssueLinkResource defines the resource implementation.
+type IssueLinkResource struct {
+	client *client.JiraClient
+}
+
+// IssueLinkResourceModel describes the resource data model.
+type IssueLinkResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	InwardIssue  types.String `tfsdk:"inward_issue"`
+	OutwardIssue types.String `tfsdk:"outward_issue"`
+	LinkType     types.String `tfsdk:"link_type"`
+}
+
+// Metadata returns the resource type name.
+func (r *IssueLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link"
+}
+
+// Schema defines the schema for the resource.
+func (r *IssueLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a link between two Jira issues (e.g., \"blocks\" or \"relates to\")." + scopesNote("jira_issue_link"),
+		MarkdownDescription: `
+Manages a link between two Jira issues. Links can't be edited, so changing any argument
+replaces the link.
+
+` + "`link_type`" + ` takes the name of a link type or either of its phrases, ignoring case, and the
+link reads as "` + "`inward_issue`" + ` <phrase> ` + "`outward_issue`" + `". With a type name or its
+outward phrase (` + "`Blocks`" + `, ` + "`blocks`" + `) the issues map directly to the fields of
+the Jira issue link API; with the inward phrase (` + "`is blocked by`" + `) they are swapped, so
+the two links below are the same link. Rewriting a link from one side to the other updates it
+in place instead of replacing it. Values matching no link type are rejected at plan time.
+
+If the link or either issue is deleted outside Terraform, the link is removed from state and
+recreated on the next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_issue_link" "api_blocks_ui" {
+  inward_issue  = jira_issue.api.key
+  outward_issue = jira_issue.ui.key
+  link_type     = "Blocks"
+}
+
+resource "jira_issue_link" "ui_blocked_by_api" {
+  inward_issue  = jira_issue.ui.key
+  outward_issue = jira_issue.api.key
+  link_type     = "is blocked by"
+}
+` + "```" + `
+
+## Import
+
+Issue links can be imported using the link ID:
+
+` + "```bash" + `
+terraform import jira_issue_link.api_blocks_ui 10231
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue link ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"inward_issue": schema.StringAttribute{
+				Description: "Key of the inward issue of the link (e.g., PROJ-123). Changing this forces a new link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"outward_issue": schema.StringAttribute{
+				Description: "Key of the outward issue of the link (e.g., PROJ-456). Changing this forces a new link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"link_type": schema.StringAttribute{
+				Description: "Name of the issue link type (e.g., Blocks, Relates) or one of its phrases (e.g., blocks, is blocked by). Changing this forces a new link unless it names the same link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IssueLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IssueLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira issue link", map[string]any{
+		"inward_issue":  data.InwardIssue.ValueString(),
+		"outward_issue": data.OutwardIssue.ValueString(),
+		"link_type":     data.LinkType.ValueString(),
+	})
+
+	resolved, err := resolveIssueLink(ctx, r.client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issue link types", err.Error())
+		return
+	}
+	if resolved == nil {
+		addUnknownLinkTypeError(ctx, r.client, data.LinkType.ValueString(), &resp.Diagnostics)
+		return
+	}
+
+	link, err := r.client.CreateIssueLink(ctx, resolved.typeName, resolved.inward, resolved.outward)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create issue link", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(link.ID)
+
+	tflog.Info(ctx, "Created Jira issue link", map[string]any{
+		"id": link.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data. Links are looked
+// up from the inward issue, so a deleted link or a deleted issue on either
+// side reads as gone.
+func (r *IssueLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue link", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	// Imported links only know their ID.
+	if data.InwardIssue.IsNull() {
+		link, err := r.client.GetIssueLink(ctx, data.ID.ValueString())
+		if err != nil {
+			if client.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			resp.Diagnostics.AddError("Failed to read issue link", err.Error())
+			return
+		}
+		if link.Type == nil || link.InwardIssue == nil || link.OutwardIssue == nil {
+			resp.Diagnostics.AddError("Failed to read issue link", fmt.Sprintf("Jira returned an incomplete issue link %s", data.ID.ValueString()))
+			return
+		}
+
+		data.InwardIssue = types.StringValue(link.InwardIssue.Key)
+		data.OutwardIssue = types.StringValue(link.OutwardIssue.Key)
+		data.LinkType = types.StringValue(link.Type.Name)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// Compare in Jira's own terms, so a link configured with its inward
+	// phrase is still found when Jira reports it from the other side.
+	resolved, err := resolveIssueLink(ctx, r.client, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issue link types", err.Error())
+		return
+	}
+	if resolved == nil {
+		tflog.Info(ctx, "Jira issue link type no longer exists", map[string]any{
+			"id":        data.ID.ValueString(),
+			"link_type": data.LinkType.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	link, err := r.client.FindIssueLink(ctx, resolved.typeName, resolved.inward, resolved.outward)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read issue link", err.Error())
+		return
+	}
+
+	if link == nil {
+		tflog.Info(ctx, "Jira issue link no longer exists", map[string]any{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(link.ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ModifyPlan rejects link types that match nothing on the instance, and
+// keeps the link when the configuration only switches to the phrase of the
+// other side with the issues swapped.
+func (r *IssueLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan IssueLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.LinkType.IsUnknown() {
+		return
+	}
+
+	planned, err := resolveIssueLink(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issue link types", err.Error())
+		return
+	}
+	if planned == nil {
+		addUnknownLinkTypeError(ctx, r.client, plan.LinkType.ValueString(), &resp.Diagnostics)
+		return
+	}
+
+	if req.State.Raw.IsNull() || plan.InwardIssue.IsUnknown() || plan.OutwardIssue.IsUnknown() {
+		return
+	}
+
+	var state IssueLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.LinkType.IsNull() {
+		return
+	}
+
+	current, err := resolveIssueLink(ctx, r.client, state)
+	if err != nil || current == nil {
+		return
+	}
+	if current.typeID == planned.typeID &&
+		strings.EqualFold(current.inward, planned.inward) &&
+		strings.EqualFold(current.outward, planned.outward) {
+		resp.RequiresReplace = nil
+	}
+}
+
+// Update only runs when the configuration names the existing link from the
+// other side (see ModifyPlan); the link itself is unchanged, so it only
+// carries the new values forward.
+func (r *IssueLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IssueLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IssueLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira issue link", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteIssueLink(ctx, data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete issue link", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira issue link", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *IssueLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// resolvedIssueLink is a configured link in Jira's terms: the link type's
+// name, with the issues in the order the issue link API expects.
+type resolvedIssueLink struct {
+	typeID   string
+	typeName string
+	inward   string
+	outward  string
+}
+
+// resolveIssueLink looks up the link type that data's link_type names and
+// swaps the issues when it is the type's inward phrase. It returns nil
+// without an error when no link type matches.
+func resolveIssueLink(ctx context.Context, c *client.JiraClient, data IssueLinkResourceModel) (*resolvedIssueLink, error) {
+	linkType, reversed, err := c.FindIssueLinkTypeByPhrase(ctx, data.LinkType.ValueString())
+	if err != nil || linkType == nil {
+		return nil, err
+	}
+
+	resolved := &resolvedIssueLink{
+		typeID:   linkType.ID,
+		typeName: linkType.Name,
+		inward:   data.InwardIssue.ValueString(),
+		outward:  data.OutwardIssue.ValueString(),
+	}
+	if reversed {
+		resolved.inward, resolved.outward = resolved.outward, resolved.inward
+	}
+	return resolved, nil
+}
+
+// addUnknownLinkTypeError reports a link_type that matches no link type,
+// listing the names and phrases that would.
+func addUnknownLinkTypeError(ctx context.Context, c *client.JiraClient, linkType string, diags *diag.Diagnostics) {
+	linkTypes, err := c.GetIssueLinkTypes(ctx)
+	if err != nil {
+		diags.AddError("Failed to read issue link types", err.Error())
+		return
+	}
+
+	valid := make([]string, 0, len(linkTypes))
+	for _, lt := range linkTypes {
+		valid = append(valid, fmt.Sprintf("  - %s: %q (outward), %q (inward)", lt.Name, lt.Outward, lt.Inward))
+	}
+	sort.Strings(valid)
+
+	diags.AddAttributeError(
+		path.Root("link_type"),
+		"Unknown Issue Link Type",
+		fmt.Sprintf("%q is not the name or a phrase of any issue link type. Valid link types are:\n\n%s",
+			linkType, strings.Join(valid, "\n")),
+	)
+}
@@ -0,0 +1,262 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueLinkTypeResource{}
+var _ resource.ResourceWithImportState = &IssueLinkTypeResource{}
+
+// NewIssueLinkTypeResource creates a new issue link type resource.
+func NewIssueLinkTypeResource() resource.Resource {
+	return &IssueLinkTypeResource{}
+}
+
+// IssueLinkTypeResource defines the resource implementation.
+type IssueLinkTypeResource struct {
+	client *client.JiraClient
+}
+
+// IssueLinkTypeResourceModel describes the resource data model.
+type IssueLinkTypeResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Inward  types.String `tfsdk:"inward"`
+	Outward types.String `tfsdk:"outward"`
+}
+
+// Metadata returns the resource type name.
+func (r *IssueLinkTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_link_type"
+}
+
+// Schema defines the schema for the resource.
+func (r *IssueLinkTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira issue link type (e.g., \"Causes\" / \"is caused by\")." + scopesNote("jira_issue_link_type"),
+		MarkdownDescription: `
+Manages a Jira issue link type. Link types define the relationship names shown
+on both sides of an issue link.
+
+~> **Note:** Deleting a link type that is still in use causes Jira to convert
+all existing links of that type to the default "Relates" type.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_issue_link_type" "causes" {
+  name    = "Causes"
+  inward  = "is caused by"
+  outward = "causes"
+}
+` + "```" + `
+
+## Import
+
+Issue link types can be imported using the link type ID:
+
+` + "```bash" + `
+terraform import jira_issue_link_type.causes 10005
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue link type ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The link type name (e.g., Causes).",
+				Required:    true,
+			},
+			"inward": schema.StringAttribute{
+				Description: "The phrase shown on the inward issue (e.g., is caused by).",
+				Required:    true,
+			},
+			"outward": schema.StringAttribute{
+				Description: "The phrase shown on the outward issue (e.g., causes).",
+				Required:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IssueLinkTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *IssueLinkTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira issue link type", map[string]any{
+		"name": data.Name.ValueString(),
+	})
+
+	linkType, err := r.client.CreateIssueLinkType(ctx, &client.IssueLinkType{
+		Name:    data.Name.ValueString(),
+		Inward:  data.Inward.ValueString(),
+		Outward: data.Outward.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create issue link type", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(linkType.ID)
+
+	tflog.Info(ctx, "Created Jira issue link type", map[string]any{
+		"id":   linkType.ID,
+		"name": linkType.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *IssueLinkTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue link type", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	linkType, err := r.client.GetIssueLinkType(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read issue link type", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(linkType.ID)
+	data.Name = types.StringValue(linkType.Name)
+	data.Inward = types.StringValue(linkType.Inward)
+	data.Outward = types.StringValue(linkType.Outward)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the link type or changes its phrases in place.
+func (r *IssueLinkTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira issue link type", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	_, err := r.client.UpdateIssueLinkType(ctx, data.ID.ValueString(), &client.IssueLinkType{
+		Name:    data.Name.ValueString(),
+		Inward:  data.Inward.ValueString(),
+		Outward: data.Outward.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update issue link type", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira issue link type", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *IssueLinkTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira issue link type", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	// Count links still using this type before deleting, since Jira silently
+	// converts them to "Relates" rather than refusing the delete.
+	jql := fmt.Sprintf("issueLinkType in (%s, %s)",
+		client.QuoteJQL(data.Inward.ValueString()),
+		client.QuoteJQL(data.Outward.ValueString()),
+	)
+	usage, searchErr := r.client.SearchIssues(ctx, jql, 0)
+
+	err := r.client.DeleteIssueLinkType(ctx, data.ID.ValueString())
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete issue link type", err.Error())
+			return
+		}
+	}
+
+	switch {
+	case searchErr != nil:
+		resp.Diagnostics.AddWarning(
+			"Issue links may have been converted to \"Relates\"",
+			fmt.Sprintf("Could not determine whether link type %q was in use (%s). "+
+				"Jira converts any existing links of a deleted type to the default \"Relates\" type.",
+				data.Name.ValueString(), searchErr.Error()),
+		)
+	case usage.Total > 0:
+		resp.Diagnostics.AddWarning(
+			"Issue links converted to \"Relates\"",
+			fmt.Sprintf("Link type %q was still used by %d issue(s). "+
+				"Jira converted those links to the default \"Relates\" type when the link type was deleted.",
+				data.Name.ValueString(), usage.Total),
+		)
+	}
+
+	tflog.Info(ctx, "Deleted Jira issue link type", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *IssueLinkTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// checkIssueMetadata checks project, issue_type and priority against Jira at
+// plan time, so a typo fails the plan instead of an apply that has already
+// created other resources. Only values being written are checked, so
+// existing issues don't start failing plans. Unknown values are skipped,
+// as are lookups that fail for reasons other than a missing project; the
+// apply reports those. Project and priority lookups are cached by the
+// client, so a plan reads each project and the priority list once.
+func (r *IssueResource) checkIssueMetadata(ctx context.Context, plan, state IssueResourceModel, creating bool, diags *diag.Diagnostics) {
+	if r.client == nil {
+		return
+	}
+
+	written := func(planned, prior types.String) bool {
+		return !planned.IsNull() && !planned.IsUnknown() && (creating || !planned.Equal(prior))
+	}
+
+	if written(plan.Priority, state.Priority) {
+		r.checkPriority(ctx, plan.Priority.ValueString(), diags)
+	}
+
+	projectWritten := written(plan.Project, state.Project)
+	if !projectWritten && !written(plan.IssueType, state.IssueType) {
+		return
+	}
+	if plan.Project.IsUnknown() || plan.IssueType.IsUnknown() {
+		return
+	}
+
+	key := plan.Project.ValueString()
+	project, err := r.client.GetProjectMetadata(ctx, key)
+	if err != nil {
+		if client.IsNotFound(err) && projectWritten {
+			// The project may be created by this same apply, so this can't
+			// fail the plan.
+			diags.AddAttributeWarning(
+				path.Root("project"),
+				"Project Not Found",
+				fmt.Sprintf("Project %s doesn't exist or isn't visible to this account, so issue_type couldn't be checked. "+
+					"This is expected when the project is created in the same apply; otherwise the apply will fail.", key),
+			)
+			return
+		}
+		tflog.Debug(ctx, "Skipping plan-time issue type check", map[string]any{
+			"project": key,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Some projects don't list their issue types; leave those to the apply.
+	if len(project.IssueTypes) == 0 {
+		return
+	}
+	issueType := plan.IssueType.ValueString()
+	names := make([]string, 0, len(project.IssueTypes))
+	for _, t := range project.IssueTypes {
+		if t.ID == issueType || strings.EqualFold(t.Name, issueType) {
+			return
+		}
+		names = append(names, t.Name)
+	}
+	sort.Strings(names)
+	diags.AddAttributeError(
+		path.Root("issue_type"),
+		"Unknown Issue Type",
+		fmt.Sprintf("Project %s has no issue type %q. Valid issue types: %s.", key, issueType, strings.Join(names, ", ")),
+	)
+}
+
+// checkPriority reports a priority that isn't a name or ID of any priority
+// on the site.
+func (r *IssueResource) checkPriority(ctx context.Context, priority string, diags *diag.Diagnostics) {
+	priorities, err := r.client.GetPriorities(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Skipping plan-time priority check", map[string]any{
+			"error": err.Error(),
+		})
+		return
+	}
+	if len(priorities) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(priorities))
+	for _, p := range priorities {
+		if p.ID == priority || strings.EqualFold(p.Name, priority) {
+			return
+		}
+		names = append(names, p.Name)
+	}
+	diags.AddAttributeError(
+		path.Root("priority"),
+		"Unknown Priority",
+		fmt.Sprintf("There is no priority %q. Valid priorities: %s.", priority, strings.Join(names, ", ")),
+	)
+}
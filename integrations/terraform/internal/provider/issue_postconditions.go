@@ -0,0 +1,193 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// postconditionKeyPlaceholder is replaced by the quoted issue key in
+// postcondition JQL.
+const postconditionKeyPlaceholder = "%KEY%"
+
+// IssuePostconditionsModel describes the postconditions attribute.
+type IssuePostconditionsModel struct {
+	JQL          types.List   `tfsdk:"jql"`
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+// postconditionsAttribute returns the schema of the postconditions
+// attribute.
+func postconditionsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "JQL checks that must each match at least one issue after every create and update, e.g. to assert that automation assigned or labeled the issue. They are polled until all pass or the timeout runs out, and the apply fails naming the checks that never passed.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"jql": schema.ListAttribute{
+				Description: "JQL queries containing the " + postconditionKeyPlaceholder + " placeholder, which is replaced by the quoted issue key (e.g., key = %KEY% AND assignee IS NOT EMPTY).",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(regexp.QuoteMeta(postconditionKeyPlaceholder)),
+						"must contain the "+postconditionKeyPlaceholder+" placeholder",
+					)),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "How long to wait for every check to pass, as a duration (e.g., 2m). Defaults to 5m.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("5m"),
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to run the checks that haven't passed yet, as a duration. Defaults to 5s.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("5s"),
+			},
+		},
+	}
+}
+
+// validatePostconditions checks that the postconditions durations parse.
+func validatePostconditions(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var postconditions types.Object
+	diags.Append(config.GetAttribute(ctx, path.Root("postconditions"), &postconditions)...)
+	if diags.HasError() || postconditions.IsNull() || postconditions.IsUnknown() {
+		return
+	}
+
+	var model IssuePostconditionsModel
+	diags.Append(postconditions.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{"timeout": model.Timeout, "poll_interval": model.PollInterval} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if d, err := time.ParseDuration(value.ValueString()); err != nil || d <= 0 {
+			diags.AddAttributeError(
+				path.Root("postconditions").AtName(name),
+				"Invalid Duration",
+				fmt.Sprintf("%q is not a positive duration such as 30s or 5m.", value.ValueString()),
+			)
+		}
+	}
+}
+
+// checkPostconditions runs the postcondition queries for an issue until each
+// has matched at least once or the timeout runs out. Passed checks aren't
+// run again. Cancellation of ctx stops polling early. Invalid JQL fails
+// immediately rather than being retried.
+func (r *IssueResource) checkPostconditions(ctx context.Context, key string, postconditions types.Object, diags *diag.Diagnostics) {
+	if postconditions.IsNull() || postconditions.IsUnknown() {
+		return
+	}
+
+	var model IssuePostconditionsModel
+	diags.Append(postconditions.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	var queries []string
+	diags.Append(model.JQL.ElementsAs(ctx, &queries, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	timeout, err := time.ParseDuration(model.Timeout.ValueString())
+	if err != nil {
+		diags.AddError("Failed to check postconditions", fmt.Sprintf("invalid postconditions timeout: %s", err))
+		return
+	}
+	interval, err := time.ParseDuration(model.PollInterval.ValueString())
+	if err != nil {
+		diags.AddError("Failed to check postconditions", fmt.Sprintf("invalid postconditions poll_interval: %s", err))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pending := make(map[int]string, len(queries))
+	for i, query := range queries {
+		pending[i] = strings.ReplaceAll(query, postconditionKeyPlaceholder, client.QuoteJQL(key))
+	}
+
+	for {
+		for i := range queries {
+			jql, ok := pending[i]
+			if !ok {
+				continue
+			}
+
+			result, err := r.client.SearchIssues(ctx, jql, 0)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				diags.AddAttributeError(
+					path.Root("postconditions").AtName("jql").AtListIndex(i),
+					"Failed to Check Postcondition",
+					fmt.Sprintf("Running %q for issue %s failed: %s", jql, key, err),
+				)
+				return
+			}
+
+			tflog.Debug(ctx, "Checked Jira issue postcondition", map[string]any{
+				"key":     key,
+				"jql":     jql,
+				"matches": result.Total,
+			})
+			if result.Total > 0 {
+				delete(pending, i)
+			}
+		}
+
+		if len(pending) == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(interval):
+			continue
+		}
+
+		reason := fmt.Sprintf("after %s", timeout)
+		if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			reason = fmt.Sprintf("when waiting stopped (%s)", ctx.Err())
+		}
+		for i := range queries {
+			if jql, ok := pending[i]; ok {
+				diags.AddAttributeError(
+					path.Root("postconditions").AtName("jql").AtListIndex(i),
+					"Issue Postcondition Failed",
+					fmt.Sprintf("%q still matched no issues %s, so issue %s isn't in the expected state.", jql, reason, key),
+				)
+			}
+		}
+		return
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IssueRankingResource{}
+var _ resource.ResourceWithImportState = &IssueRankingResource{}
+
+// NewIssueRankingResource creates a new issue ranking resource.
+func NewIssueRankingResource() resource.Resource {
+	return &IssueRankingResource{}
+}
+
+// IssueRankingResource defines the resource implementation.
+type IssueRankingResource struct {
+	client *client.JiraClient
+}
+
+// IssueRankingResourceModel describes the resource data model.
+type IssueRankingResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssueKeys types.List   `tfsdk:"issue_keys"`
+}
+
+// Metadata returns the resource type name.
+func (r *IssueRankingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_ranking"
+}
+
+// Schema defines the schema for the resource.
+func (r *IssueRankingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Keeps a list of issues in a fixed relative order on boards and backlogs." + scopesNote("jira_issue_ranking"),
+		MarkdownDescription: `
+Keeps a list of issues in a fixed relative order by their Agile rank, so they appear in that
+order on boards and backlogs. Other issues may sit between them. Only adjacent pairs that are
+out of order are re-ranked, so converging after a single drag in the UI takes one request.
+
+Refresh reads the current order back; an issue dragged out of place shows up as a diff on
+` + "`issue_keys`" + `. Destroying the resource only removes it from state; ranks are left as they are.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_issue_ranking" "now_next_later" {
+  issue_keys = [
+    jira_issue.now.key,
+    jira_issue.next.key,
+    jira_issue.later.key,
+  ]
+}
+` + "```" + `
+
+## Import
+
+Rankings can be imported using the issue keys in order, separated by commas:
+
+` + "```bash" + `
+terraform import jira_issue_ranking.now_next_later PROJ-1,PROJ-7,PROJ-3
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the ranking, the comma-separated issue keys it was created with.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_keys": schema.ListAttribute{
+				Description: "Issue keys in the order they should appear, highest rank first.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *IssueRankingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create ranks the issues into the configured order.
+func (r *IssueRankingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IssueRankingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Ranking Jira issues", map[string]any{
+		"issue_keys": keys,
+	})
+
+	moves, err := rankIssues(ctx, r.client, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to rank issues", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(strings.Join(keys, ","))
+
+	tflog.Info(ctx, "Ranked Jira issues", map[string]any{
+		"issue_keys": keys,
+		"moves":      moves,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes issue_keys with the current order of the issues.
+func (r *IssueRankingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IssueRankingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue ranking", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	current, err := r.client.GetRankedIssueKeys(ctx, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issue ranking", err.Error())
+		return
+	}
+
+	issueKeys, diags := types.ListValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+	data.IssueKeys = issueKeys
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update re-ranks the issues into the configured order.
+func (r *IssueRankingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IssueRankingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Re-ranking Jira issues", map[string]any{
+		"id":         data.ID.ValueString(),
+		"issue_keys": keys,
+	})
+
+	moves, err := rankIssues(ctx, r.client, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to rank issues", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Re-ranked Jira issues", map[string]any{
+		"id":    data.ID.ValueString(),
+		"moves": moves,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the ranking from state. Ranks are left as they are.
+func (r *IssueRankingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IssueRankingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removed Jira issue ranking from state", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports a ranking from comma-separated issue keys.
+func (r *IssueRankingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	keys := strings.Split(req.ID, ",")
+	for i, key := range keys {
+		keys[i] = strings.TrimSpace(key)
+		if keys[i] == "" {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected comma-separated issue keys such as PROJ-1,PROJ-2, got: %q", req.ID),
+			)
+			return
+		}
+	}
+
+	issueKeys, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strings.Join(keys, ","))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_keys"), issueKeys)...)
+}
+
+// rankIssues converges the Agile rank of keys to their order in the list and
+// returns how many issues were moved. Pairs are fixed from the end of the
+// list, ranking an issue directly before its successor only when it sits
+// after it, so every pair already fixed stays in order.
+func rankIssues(ctx context.Context, c *client.JiraClient, keys []string) (int, error) {
+	current, err := c.GetRankedIssueKeys(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	position := make(map[string]int, len(current))
+	for i, key := range current {
+		position[key] = i
+	}
+
+	var missing []string
+	for _, key := range keys {
+		if _, ok := position[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return 0, fmt.Errorf("issues not found: %s", strings.Join(missing, ", "))
+	}
+
+	moves := 0
+	for i := len(keys) - 2; i >= 0; i-- {
+		key, next := keys[i], keys[i+1]
+		if position[key] < position[next] {
+			continue
+		}
+
+		if err := c.RankIssueBefore(ctx, key, next); err != nil {
+			return moves, err
+		}
+		moves++
+
+		// Mirror the move locally: key now sits directly before next.
+		current = moveBefore(current, key, next)
+		for j, k := range current {
+			position[k] = j
+		}
+	}
+
+	return moves, nil
+}
+
+// moveBefore returns order with key moved directly before next.
+func moveBefore(order []string, key, next string) []string {
+	moved := make([]string, 0, len(order))
+	for _, k := range order {
+		switch k {
+		case key:
+			continue
+		case next:
+			moved = append(moved, key)
+		}
+		moved = append(moved, k)
+	}
+	return moved
+}
@@ -0,0 +1,129 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// rankBoard is a fake Jira holding the rank order of a board's issues.
+type rankBoard struct {
+	order []string
+	ranks int
+}
+
+var quotedKeyPattern = regexp.MustCompile(`"([^"]+)"`)
+
+func newRankBoard(t *testing.T, order ...string) (*client.JiraClient, *rankBoard) {
+	t.Helper()
+	board := &rankBoard{order: order}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/search":
+			var req struct {
+				JQL string `json:"jql"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+				return
+			}
+			var requested []string
+			for _, m := range quotedKeyPattern.FindAllStringSubmatch(req.JQL, -1) {
+				requested = append(requested, m[1])
+			}
+
+			var issues []map[string]string
+			for _, key := range board.order {
+				if slices.Contains(requested, key) {
+					issues = append(issues, map[string]string{"key": key})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"total": len(issues), "issues": issues})
+		case "/rest/agile/1.0/issue/rank":
+			var req struct {
+				Issues          []string `json:"issues"`
+				RankBeforeIssue string   `json:"rankBeforeIssue"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+				return
+			}
+			board.ranks++
+			board.order = moveBefore(board.order, req.Issues[0], req.RankBeforeIssue)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+	return c, board
+}
+
+func TestRankIssues(t *testing.T) {
+	keys := []string{"PROJ-1", "PROJ-2", "PROJ-3", "PROJ-4"}
+
+	tests := []struct {
+		name      string
+		board     []string
+		wantMoves int
+	}{
+		{"in order", []string{"PROJ-1", "PROJ-5", "PROJ-2", "PROJ-3", "PROJ-4"}, 0},
+		{"dragged out of order", []string{"PROJ-2", "PROJ-3", "PROJ-1", "PROJ-4"}, 1},
+		{"reversed", []string{"PROJ-4", "PROJ-3", "PROJ-2", "PROJ-1"}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, board := newRankBoard(t, tt.board...)
+
+			moves, err := rankIssues(context.Background(), c, keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if moves != tt.wantMoves || board.ranks != tt.wantMoves {
+				t.Errorf("moves = %d with %d rank requests, want %d", moves, board.ranks, tt.wantMoves)
+			}
+
+			var ranked []string
+			for _, key := range board.order {
+				if slices.Contains(keys, key) {
+					ranked = append(ranked, key)
+				}
+			}
+			if !reflect.DeepEqual(ranked, keys) {
+				t.Errorf("board order = %v, want %v", ranked, keys)
+			}
+		})
+	}
+}
+
+func TestRankIssuesMissing(t *testing.T) {
+	// PROJ-3 was deleted; the search still succeeds and the ranking reports
+	// it instead of failing on a rejected query.
+	c, board := newRankBoard(t, "PROJ-2", "PROJ-1")
+
+	_, err := rankIssues(context.Background(), c, []string{"PROJ-1", "PROJ-2", "PROJ-3"})
+	if err == nil || err.Error() != "issues not found: PROJ-3" {
+		t.Errorf("rankIssues() error = %v, want PROJ-3 reported missing", err)
+	}
+	if board.ranks != 0 {
+		t.Errorf("rank requests = %d, want none", board.ranks)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// getManagedIssue reads a managed issue by its ID, which survives a change
+// of the project key while the issue key doesn't. Imported issues have no
+// ID in state yet and are read by key.
+func getManagedIssue(ctx context.Context, c *client.JiraClient, id, key types.String) (*client.Issue, error) {
+	if !id.IsNull() && !id.IsUnknown() && id.ValueString() != "" {
+		return c.GetIssue(ctx, id.ValueString())
+	}
+	return c.GetIssue(ctx, key.ValueString())
+}
+
+// issueMovedKey is the private state key recording the project an issue
+// was moved out of in Jira. It is kept until the next apply, so plans can
+// explain the replacement the move forces.
+const issueMovedKey = "moved_from"
+
+// issueMove describes a move of an issue to another project made in Jira.
+type issueMove struct {
+	Project string `json:"project"`
+	Key     string `json:"key"`
+}
+
+// warnRenamedIssue warns when an issue's key changed since the last
+// refresh. A change of project means the issue was moved in Jira; the move
+// is recorded in private state for warnMovedIssueReplacement, and the
+// warning says how to keep the issue, mentioning allow_project_move where
+// the resource has it. Otherwise the project key itself was changed.
+func warnRenamedIssue(ctx context.Context, private privateStateWriter, priorKey, priorProject, project types.String, issue *client.Issue, canMove bool, diags *diag.Diagnostics) {
+	if priorKey.IsNull() || priorKey.IsUnknown() || priorKey.ValueString() == issue.Key {
+		return
+	}
+
+	if priorProject.IsNull() || priorProject.IsUnknown() || strings.EqualFold(priorProject.ValueString(), project.ValueString()) {
+		diags.AddWarning(
+			"Issue Key Changed",
+			fmt.Sprintf("Issue %s is now %s, most likely because its project key changed. "+
+				"The new key has been written to state; update any references to the old key.",
+				priorKey.ValueString(), issue.Key),
+		)
+		return
+	}
+
+	value, err := json.Marshal(issueMove{Project: priorProject.ValueString(), Key: priorKey.ValueString()})
+	if err == nil {
+		diags.Append(private.SetKey(ctx, issueMovedKey, value)...)
+	}
+
+	keep := fmt.Sprintf("Set project to %q to keep the issue where it is.", project.ValueString())
+	if canMove {
+		keep = fmt.Sprintf("Set project to %q to keep the issue where it is, or set allow_project_move to move it back.", project.ValueString())
+	}
+	diags.AddAttributeWarning(
+		path.Root("project"),
+		"Issue Moved to Another Project",
+		fmt.Sprintf("Issue %s was moved from project %s to %s in Jira and is now %s. The new key and project have been written to state. "+
+			"While the configuration names project %s, plans replace the issue. %s",
+			priorKey.ValueString(), priorProject.ValueString(), project.ValueString(), issue.Key, priorProject.ValueString(), keep),
+	)
+}
+
+// warnMovedIssueReplacement warns when a plan replaces an issue because the
+// configuration still names the project the issue was moved out of in Jira.
+// Replacing it destroys the moved issue, with its history, comments and
+// links, which is rarely what was meant.
+func warnMovedIssueReplacement(ctx context.Context, private privateStateReader, key, planned types.String, diags *diag.Diagnostics) {
+	value, readDiags := private.GetKey(ctx, issueMovedKey)
+	if readDiags.HasError() || len(value) == 0 || planned.IsUnknown() {
+		return
+	}
+
+	var move issueMove
+	if err := json.Unmarshal(value, &move); err != nil || !strings.EqualFold(move.Project, planned.ValueString()) {
+		return
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("project"),
+		"Replacing Moved Issue",
+		fmt.Sprintf("Issue %s was moved out of project %s in Jira and is now %s. Because the configuration still names %s, "+
+			"this plan destroys %s and creates a new issue in %s. Update project in the configuration to keep the issue.",
+			move.Key, move.Project, key.ValueString(), move.Project, key.ValueString(), move.Project),
+	)
+}
+
+// readProjectKey maps an issue's project key to state. When the project key
+// was changed, Jira still resolves the old one, so an old key that the
+// configuration uses is kept while it names the same project; replacing the
+// issue over a rename would be wrong. A warning asks for the configuration
+// to be updated.
+func readProjectKey(ctx context.Context, c *client.JiraClient, prior types.String, current string, diags *diag.Diagnostics) types.String {
+	if prior.IsNull() || prior.IsUnknown() || strings.EqualFold(prior.ValueString(), current) {
+		return types.StringValue(current)
+	}
+
+	project, err := c.GetProject(ctx, prior.ValueString())
+	if err != nil {
+		tflog.Debug(ctx, "Could not resolve the previous project key", map[string]any{
+			"project": prior.ValueString(),
+			"error":   err.Error(),
+		})
+		return types.StringValue(current)
+	}
+	if project.Key != current {
+		return types.StringValue(current)
+	}
+
+	diags.AddWarning(
+		"Project Key Changed",
+		fmt.Sprintf("Project %s has been renamed to %s. Jira still accepts the old key, so the issue is kept, "+
+			"but update project to %q in the configuration.", prior.ValueString(), current, current),
+	)
+	return prior
+}
@@ -0,0 +1,170 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// newRenameClient returns a client for a Jira where project OLD was renamed
+// to NEW, and issue 10001 is NEW-1.
+func newRenameClient(t *testing.T) (*client.JiraClient, *[]string) {
+	t.Helper()
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/issue/10001", "/rest/api/3/issue/NEW-1", "/rest/api/3/issue/OLD-1":
+			_, _ = w.Write([]byte(`{"id":"10001","key":"NEW-1","fields":{"project":{"key":"NEW"}}}`))
+		case "/rest/api/3/project/OLD", "/rest/api/3/project/NEW":
+			_, _ = w.Write([]byte(`{"id":"10000","key":"NEW"}`))
+		case "/rest/api/3/project/OTHER":
+			_, _ = w.Write([]byte(`{"id":"10100","key":"OTHER"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Not found"]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, &paths
+}
+
+func TestGetManagedIssue(t *testing.T) {
+	ctx := context.Background()
+	c, paths := newRenameClient(t)
+
+	if _, err := getManagedIssue(ctx, c, types.StringValue("10001"), types.StringValue("OLD-1")); err != nil {
+		t.Fatal(err)
+	}
+	// Imports have only the key.
+	if _, err := getManagedIssue(ctx, c, types.StringNull(), types.StringValue("OLD-1")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"/rest/api/3/issue/10001", "/rest/api/3/issue/OLD-1"}
+	if strings.Join(*paths, " ") != strings.Join(want, " ") {
+		t.Errorf("requested %v, want %v", *paths, want)
+	}
+}
+
+func TestWarnRenamedIssue(t *testing.T) {
+	ctx := context.Background()
+	issue := &client.Issue{Key: "NEW-1"}
+
+	tests := []struct {
+		name         string
+		priorKey     types.String
+		priorProject types.String
+		project      types.String
+		canMove      bool
+		wantWarning  string
+		wantMove     bool
+	}{
+		{name: "unchanged", priorKey: types.StringValue("NEW-1"), priorProject: types.StringValue("NEW"), project: types.StringValue("NEW")},
+		{name: "import", priorKey: types.StringNull(), project: types.StringValue("NEW")},
+		{name: "project key renamed", priorKey: types.StringValue("OLD-1"), priorProject: types.StringValue("NEW"), project: types.StringValue("NEW"), wantWarning: "Issue Key Changed"},
+		{name: "moved", priorKey: types.StringValue("OLD-1"), priorProject: types.StringValue("OLD"), project: types.StringValue("NEW"), wantWarning: "Issue Moved to Another Project", wantMove: true},
+		{name: "moved, can move back", priorKey: types.StringValue("OLD-1"), priorProject: types.StringValue("OLD"), project: types.StringValue("NEW"), canMove: true, wantWarning: "Issue Moved to Another Project", wantMove: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			private := mapPrivateState{}
+			var diags diag.Diagnostics
+			warnRenamedIssue(ctx, private, tt.priorKey, tt.priorProject, tt.project, issue, tt.canMove, &diags)
+
+			if tt.wantWarning == "" {
+				if len(diags) != 0 {
+					t.Errorf("diagnostics = %v, want none", diags)
+				}
+			} else if len(diags) != 1 || diags[0].Summary() != tt.wantWarning {
+				t.Fatalf("diagnostics = %v, want warning %q", diags, tt.wantWarning)
+			}
+			if len(diags) == 1 {
+				if mentioned := strings.Contains(diags[0].Detail(), "allow_project_move"); mentioned != tt.canMove {
+					t.Errorf("warning mentions allow_project_move = %v, want %v", mentioned, tt.canMove)
+				}
+			}
+
+			if _, recorded := private[issueMovedKey]; recorded != tt.wantMove {
+				t.Errorf("move recorded = %v, want %v", recorded, tt.wantMove)
+			}
+		})
+	}
+}
+
+func TestWarnMovedIssueReplacement(t *testing.T) {
+	ctx := context.Background()
+	private := mapPrivateState{}
+	var diags diag.Diagnostics
+	warnRenamedIssue(ctx, private, types.StringValue("OLD-1"), types.StringValue("OLD"), types.StringValue("NEW"), &client.Issue{Key: "NEW-1"}, false, &diags)
+
+	tests := []struct {
+		name    string
+		private mapPrivateState
+		planned types.String
+		want    bool
+	}{
+		{"configuration names old project", private, types.StringValue("old"), true},
+		{"configuration updated", private, types.StringValue("NEW"), false},
+		{"project unknown", private, types.StringUnknown(), false},
+		{"not moved", mapPrivateState{}, types.StringValue("OLD"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			warnMovedIssueReplacement(ctx, tt.private, types.StringValue("NEW-1"), tt.planned, &diags)
+			if warned := len(diags) == 1 && diags[0].Summary() == "Replacing Moved Issue"; warned != tt.want {
+				t.Errorf("diagnostics = %v, want replacement warning %v", diags, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadProjectKey(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newRenameClient(t)
+
+	tests := []struct {
+		name        string
+		prior       types.String
+		want        string
+		wantWarning bool
+	}{
+		{"unchanged", types.StringValue("NEW"), "NEW", false},
+		{"different case", types.StringValue("new"), "NEW", false},
+		{"import", types.StringNull(), "NEW", false},
+		{"old key of renamed project kept", types.StringValue("OLD"), "OLD", true},
+		{"moved to another project", types.StringValue("OTHER"), "NEW", false},
+		{"old key no longer resolves", types.StringValue("GONE"), "NEW", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := readProjectKey(ctx, c, tt.prior, "NEW", &diags)
+			if got.ValueString() != tt.want {
+				t.Errorf("readProjectKey() = %s, want %s", got, tt.want)
+			}
+			if warned := diags.WarningsCount() == 1; warned != tt.wantWarning || diags.HasError() {
+				t.Errorf("diagnostics = %v, want warning %v", diags, tt.wantWarning)
+			}
+		})
+	}
+}
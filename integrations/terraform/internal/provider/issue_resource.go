@@ -5,22 +5,40 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/spectra/terraform-provider-jira/internal/client"
 )
 
+// dueDateLayout is the date format Jira uses for due dates.
+const dueDateLayout = "2006-01-02"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IssueResource{}
 var _ resource.ResourceWithImportState = &IssueResource{}
+var _ resource.ResourceWithValidateConfig = &IssueResource{}
+var _ resource.ResourceWithModifyPlan = &IssueResource{}
 
 // NewIssueResource creates a new issue resource.
 func NewIssueResource() resource.Resource {
@@ -29,7 +47,13 @@ func NewIssueResource() resource.Resource {
 
 // IssueResource defines the resource implementation.
 type IssueResource struct {
-	client *client.JiraClient
+	client          *client.JiraClient
+	description     descriptionText
+	validationRules *validationRules
+	templates       issueTemplates
+	manifest        *keyManifest
+
+	removeWithoutProjectAccess bool
 }
 
 // IssueResourceModel describes the resource data model.
@@ -39,11 +63,82 @@ type IssueResourceModel struct {
 	Project     types.String `tfsdk:"project"`
 	Summary     types.String `tfsdk:"summary"`
 	Description types.String `tfsdk:"description"`
-	IssueType   types.String `tfsdk:"issue_type"`
-	Priority    types.String `tfsdk:"priority"`
-	Status      types.String `tfsdk:"status"`
-	Labels      types.List   `tfsdk:"labels"`
-	ParentKey   types.String `tfsdk:"parent_key"`
+
+	DescriptionFormat types.String `tfsdk:"description_format"`
+
+	AutoTrimSummary types.Bool `tfsdk:"auto_trim_summary"`
+
+	DescriptionSourceFile   types.String `tfsdk:"description_source_file"`
+	DescriptionSourceHash   types.String `tfsdk:"description_source_hash"`
+	DescriptionSourceLength types.Int64  `tfsdk:"description_source_length"`
+
+	IssueType types.String `tfsdk:"issue_type"`
+	Priority  types.String `tfsdk:"priority"`
+	Status    types.String `tfsdk:"status"`
+
+	DesiredStatus  types.String `tfsdk:"desired_status"`
+	TransitionPath types.List   `tfsdk:"transition_path"`
+
+	TemplateApplied types.Bool `tfsdk:"template_applied"`
+
+	Labels    types.Set    `tfsdk:"labels"`
+	ParentKey types.String `tfsdk:"parent_key"`
+
+	FixVersions     types.Set `tfsdk:"fix_versions"`
+	AffectsVersions types.Set `tfsdk:"affects_versions"`
+
+	DueDate   types.String `tfsdk:"due_date"`
+	SprintID  types.Int64  `tfsdk:"sprint_id"`
+	InBacklog types.Bool   `tfsdk:"in_backlog"`
+
+	StartDate types.String `tfsdk:"start_date"`
+
+	OriginalEstimate  types.String `tfsdk:"original_estimate"`
+	RemainingEstimate types.String `tfsdk:"remaining_estimate"`
+
+	EpicName  types.String `tfsdk:"epic_name"`
+	EpicColor types.String `tfsdk:"epic_color"`
+
+	Assignee         types.String `tfsdk:"assignee"`
+	Reporter         types.String `tfsdk:"reporter"`
+	CreatorAccountID types.String `tfsdk:"creator_account_id"`
+	AdoptExisting    types.Bool   `tfsdk:"adopt_existing"`
+	UniqueSummary    types.Bool   `tfsdk:"unique_summary"`
+	SilentCreate     types.Bool   `tfsdk:"silent_create"`
+	AllowProjectMove types.Bool   `tfsdk:"allow_project_move"`
+	Handle           types.Object `tfsdk:"handle"`
+
+	PriorityIconURL types.String `tfsdk:"priority_icon_url"`
+	PriorityColor   types.String `tfsdk:"priority_color"`
+
+	IssueTypeIconURL types.String `tfsdk:"issue_type_icon_url"`
+
+	ParentSummary types.String `tfsdk:"parent_summary"`
+	ParentStatus  types.String `tfsdk:"parent_status"`
+
+	CustomFields types.Map `tfsdk:"custom_fields"`
+
+	WaitFor        types.Object `tfsdk:"wait_for"`
+	Postconditions types.Object `tfsdk:"postconditions"`
+
+	TrackAttachments types.Bool `tfsdk:"track_attachments"`
+	Attachments      types.List `tfsdk:"attachments"`
+
+	OnDestroy   types.String `tfsdk:"on_destroy"`
+	CloseStatus types.String `tfsdk:"close_status"`
+	CloseLabel  types.String `tfsdk:"close_label"`
+
+	OnUneditableField types.String `tfsdk:"on_uneditable_field"`
+
+	DeleteSubtasks types.Bool `tfsdk:"delete_subtasks"`
+}
+
+// IssueWaitForModel describes the wait_for attribute.
+type IssueWaitForModel struct {
+	Status         types.String `tfsdk:"status"`
+	StatusCategory types.String `tfsdk:"status_category"`
+	Timeout        types.String `tfsdk:"timeout"`
+	PollInterval   types.String `tfsdk:"poll_interval"`
 }
 
 // Metadata returns the resource type name.
@@ -54,7 +149,8 @@ func (r *IssueResource) Metadata(ctx context.Context, req resource.MetadataReque
 // Schema defines the schema for the resource.
 func (r *IssueResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Jira issue (Story, Bug, Task, Epic, etc.).",
+		Version:     issueSchemaVersion,
+		Description: "Manages a Jira issue (Story, Bug, Task, Epic, etc.)." + scopesNote("jira_issue"),
 		MarkdownDescription: `
 Manages a Jira issue. This resource can create, read, update, and delete Jira issues.
 
@@ -92,6 +188,80 @@ resource "jira_issue" "story_in_epic" {
 }
 ` + "```" + `
 
+### Mirror a Runbook into the Description
+
+` + "```hcl" + `
+resource "jira_issue" "runbook" {
+  project                 = "OPS"
+  summary                 = "Runbook: database failover"
+  issue_type              = "Task"
+  description_source_file = "${path.module}/runbooks/db-failover.md"
+}
+` + "```" + `
+
+### Set Custom Fields
+
+Keys are custom field IDs or names; values are JSON, so numbers, options and arrays can
+all be set. Only the declared fields are tracked in state.
+
+` + "```hcl" + `
+resource "jira_issue" "estimated" {
+  project    = "PROJ"
+  summary    = "Estimated story"
+  issue_type = "Story"
+
+  custom_fields = {
+    "Story Points"      = jsonencode(5)
+    "customfield_10050" = jsonencode({ value = "Platform" })
+  }
+}
+` + "```" + `
+
+### Drive the Workflow Status
+
+` + "```hcl" + `
+resource "jira_issue" "release" {
+  project         = "PROJ"
+  summary         = "Release 1.4"
+  issue_type      = "Task"
+  desired_status  = "Done"
+  transition_path = ["In Progress", "In Review"]
+}
+` + "```" + `
+
+### Wait for Automation to Settle
+
+` + "```hcl" + `
+resource "jira_issue" "incident" {
+  project    = "OPS"
+  summary    = "Database failover"
+  issue_type = "Bug"
+
+  wait_for = {
+    status  = "Triage"
+    timeout = "2m"
+  }
+}
+` + "```" + `
+
+### Check Automation Results
+
+` + "```hcl" + `
+resource "jira_issue" "incident" {
+  project    = "OPS"
+  summary    = "Database failover"
+  issue_type = "Bug"
+
+  postconditions = {
+    jql = [
+      "key = %KEY% AND assignee IS NOT EMPTY",
+      "key = %KEY% AND filter = \"Triage board\"",
+    ]
+    timeout = "1m"
+  }
+}
+` + "```" + `
+
 ## Import
 
 Issues can be imported using the issue key:
@@ -116,37 +286,110 @@ terraform import jira_issue.example PROJ-123
 				},
 			},
 			"project": schema.StringAttribute{
-				Description: "The project key (e.g., PROJ).",
+				Description: "The project key (e.g., PROJ). Changing this replaces the issue unless allow_project_move is set.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"summary": schema.StringAttribute{
-				Description: "The issue summary/title.",
+				Description: "The issue summary/title. It must be a single line of at most 255 characters, where most emoji count as two, unless auto_trim_summary is set.",
 				Required:    true,
 			},
+			"auto_trim_summary": schema.BoolAttribute{
+				Description: "Collapse whitespace and line breaks in summary into single spaces and truncate it to 255 characters with an ellipsis, with a plan-time warning, instead of rejecting it. For summaries generated from external text; state keeps the configured summary.",
+				Optional:    true,
+			},
 			"description": schema.StringAttribute{
-				Description: "The issue description (plain text, will be converted to ADF).",
+				Description: "The issue description (plain text, will be converted to ADF). When unset, new issues start from the provider's issue_templates entry for their type.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("description_source_file")),
+				},
+			},
+			"description_format": schema.StringAttribute{
+				Description: "How description is interpreted: plain (the default) sends it as plain text, markdown converts headings, lists, code blocks, quotes, rules, emphasis and links to rich text and reads them back as Markdown.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(descriptionFormatPlain),
+				Validators: []validator.String{
+					stringvalidator.OneOf(descriptionFormatPlain, descriptionFormatMarkdown),
+				},
+			},
+			"description_source_file": schema.StringAttribute{
+				Description: "Path to a Markdown file mirrored into the description (converted to ADF). The file is read at plan time; only its hash and length are stored in state.",
 				Optional:    true,
 			},
+			"description_source_hash": schema.StringAttribute{
+				Description: "SHA-256 of description_source_file, used to detect changes.",
+				Computed:    true,
+			},
+			"description_source_length": schema.Int64Attribute{
+				Description: "Length in bytes of description_source_file.",
+				Computed:    true,
+			},
 			"issue_type": schema.StringAttribute{
-				Description: "The issue type (Story, Bug, Task, Epic, etc.).",
+				Description: "The issue type name (Story, Bug, Task, Epic, etc.) or numeric ID. Switching between the name and ID of the same type doesn't replace the issue.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"priority": schema.StringAttribute{
-				Description: "The issue priority (Highest, High, Medium, Low, Lowest).",
+				Description: "The issue priority name (Highest, High, Medium, Low, Lowest) or numeric ID. Use the ID when priority names are duplicated across priority schemes.",
 				Optional:    true,
 			},
 			"status": schema.StringAttribute{
-				Description: "The issue status (read-only, set via transitions).",
+				Description: "The current issue status. Changes made in Jira show up as drift.",
+				Computed:    true,
+			},
+			"desired_status": schema.StringAttribute{
+				Description: "Status to transition the issue to after create and update (matched case-insensitively). If the issue is moved elsewhere in Jira, the next apply transitions it back.",
+				Optional:    true,
+			},
+			"transition_path": schema.ListAttribute{
+				Description: "Intermediate statuses to pass through on the way to desired_status, for workflows without a direct transition.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.AlsoRequires(path.MatchRoot("desired_status")),
+				},
+			},
+			"issue_type_icon_url": schema.StringAttribute{
+				Description: "URL of the issue type icon.",
+				Computed:    true,
+			},
+			"priority_icon_url": schema.StringAttribute{
+				Description: "URL of the priority icon, for styling reports consistently with Jira.",
+				Computed:    true,
+			},
+			"priority_color": schema.StringAttribute{
+				Description: "Hex color Jira uses for the priority (e.g., #d04437).",
+				Computed:    true,
+			},
+			"labels": schema.SetAttribute{
+				Description: "Issue labels. Jira doesn't preserve label order, so labels are a set. When unset on an issue created from a template, the template's labels are used.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"fix_versions": schema.SetAttribute{
+				Description: "Names of the project versions the issue is fixed in. Names are resolved against the project's versions at apply time.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"affects_versions": schema.SetAttribute{
+				Description: "Names of the project versions the issue affects. Names are resolved against the project's versions at apply time.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"template_applied": schema.BoolAttribute{
+				Description: "Whether the issue was created from the provider's issue_templates entry for its type because no description was set.",
 				Computed:    true,
 			},
-			"labels": schema.ListAttribute{
-				Description: "Issue labels.",
+			"custom_fields": schema.MapAttribute{
+				Description: "Custom field values keyed by field ID (customfield_10016) or name, as JSON-encoded strings (use jsonencode). Cascading select fields also accept \"cascade:Parent/Child\" or \"cascade:Parent\". Only declared fields are read back; removing a key clears the field.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
@@ -154,26 +397,340 @@ terraform import jira_issue.example PROJ-123
 				Description: "Parent issue key (for stories in epics or subtasks).",
 				Optional:    true,
 			},
+			"parent_summary": schema.StringAttribute{
+				Description: "Summary of the parent issue, or null when the issue has no parent.",
+				Computed:    true,
+			},
+			"parent_status": schema.StringAttribute{
+				Description: "Status of the parent issue, or null when the issue has no parent.",
+				Computed:    true,
+			},
+			"due_date": schema.StringAttribute{
+				Description: "Due date in YYYY-MM-DD format. Removing it clears the due date.",
+				Optional:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Start date in YYYY-MM-DD format, as shown on Advanced Roadmaps timelines. Written to the site's \"Start date\" field, or the provider's start_date_field_id. Removing it clears the start date.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(startDatePattern, "must be a date in YYYY-MM-DD format"),
+				},
+			},
+			"original_estimate": schema.StringAttribute{
+				Description: "Original time estimate as a Jira duration (e.g., 2d 4h). Requires time tracking to be enabled and on the project's screens. Re-estimating in Jira shows as drift; removing it stops managing the estimate without clearing it.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(estimatePattern, "must be a Jira duration such as 2d 4h"),
+				},
+			},
+			"epic_name": schema.StringAttribute{
+				Description: "The Epic Name, which company-managed projects require to create an epic. Only valid when issue_type is Epic; team-managed projects name epics by their summary. Removing it stops managing the name.",
+				Optional:    true,
+			},
+			"epic_color": schema.StringAttribute{
+				Description: "The epic's color, ghx-label-1 through ghx-label-14. Only valid when issue_type is Epic. Company-managed projects set it through the Agile API, team-managed projects through their Issue color field. Removing it stops managing the color.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(epicColorPattern, "must be one of ghx-label-1 through ghx-label-14"),
+				},
+			},
+			"remaining_estimate": schema.StringAttribute{
+				Description: "Remaining time estimate as a Jira duration (e.g., 1d). Jira lowers it as work is logged, which shows as drift. Removing it stops managing the estimate without clearing it.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(estimatePattern, "must be a Jira duration such as 2d 4h"),
+				},
+			},
+			"sprint_id": schema.Int64Attribute{
+				Description: "ID of the sprint the issue is assigned to. Removing it moves the issue back to the backlog on scrum boards.",
+				Optional:    true,
+			},
+			"in_backlog": schema.BoolAttribute{
+				Description: "Whether the issue is in the backlog (not in an active or future sprint). Null for projects without a scrum board.",
+				Computed:    true,
+			},
+			"assignee": schema.StringAttribute{
+				Description: "Account ID of the assignee. When unset the issue is kept unassigned, overriding any project default assignee.",
+				Optional:    true,
+			},
+			"reporter": schema.StringAttribute{
+				Description: "Account ID of the issue reporter. Defaults to the account that created the issue.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator_account_id": schema.StringAttribute{
+				Description: "Account ID of the user that physically created the issue (normally the provider's service account). Unlike reporter, this never changes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Description: "Adopt an existing issue with the same project, issue type, and exact summary instead of creating a duplicate. Only issues created by the provider's own account are adopted.",
+				Optional:    true,
+			},
+			"allow_project_move": schema.BoolAttribute{
+				Description: "Experimental: move the issue when project changes instead of replacing it, using Jira Cloud's bulk move API. The issue keeps its ID but gets a new key; Jira fills in fields and statuses the target project requires from its defaults, and per-issue conflicts fail the apply. issue_type must exist in the target project.",
+				Optional:    true,
+			},
+			"handle":              issueHandleAttribute(),
+			"on_destroy":          onDestroyAttribute(),
+			"close_status":        closeStatusAttribute(),
+			"close_label":         closeLabelAttribute(),
+			"on_uneditable_field": onUneditableFieldAttribute(),
+			"delete_subtasks": schema.BoolAttribute{
+				Description: "Delete the issue's subtasks along with it when it is deleted, including subtasks created outside Terraform. Jira refuses to delete an issue with subtasks otherwise. Defaults to false.",
+				Optional:    true,
+			},
+			"silent_create": schema.BoolAttribute{
+				Description: "Create the issue without notifying the assignee. Jira's create API always notifies, so the issue is created unassigned and the assignee is set by a follow-up edit with notifications off; the issue is briefly unassigned in between, and the follow-up requires project or Jira administer permission. Watchers added by Jira automation are not affected. Only applies to creation.",
+				Optional:    true,
+			},
+			"unique_summary": schema.BoolAttribute{
+				Description: "Fail the create when an open issue with exactly the same summary already exists in the project. Combined with adopt_existing, matching issues created by the provider's account are adopted instead.",
+				Optional:    true,
+			},
+			"wait_for": schema.SingleNestedAttribute{
+				Description: "After create, wait until the issue reaches a status (e.g., once automation has transitioned it) before the resource is considered created.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"status": schema.StringAttribute{
+						Description: "Status name to wait for. Exactly one of status or status_category must be set.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("status_category")),
+						},
+					},
+					"status_category": schema.StringAttribute{
+						Description: "Status category to wait for (TODO, IN_PROGRESS, DONE).",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(client.StatusCategoryToDo, client.StatusCategoryInProgress, client.StatusCategoryDone),
+						},
+					},
+					"timeout": schema.StringAttribute{
+						Description: "How long to wait, as a duration (e.g., 2m). Defaults to 5m.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("5m"),
+					},
+					"poll_interval": schema.StringAttribute{
+						Description: "How often to check the status, as a duration. Defaults to 5s.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("5s"),
+					},
+				},
+			},
+			"postconditions": postconditionsAttribute(),
+			"track_attachments": schema.BoolAttribute{
+				Description: "List the issue's attachments in attachments, with a SHA-256 of their content, so swapped files show up as drift. Each attachment is downloaded once; later refreshes reuse the hash while its ID and size are unchanged.",
+				Optional:    true,
+			},
+			"attachments": issueAttachmentsAttribute(),
 		},
 	}
 }
 
+// ValidateConfig checks that the summary is one Jira accepts, that due_date
+// and start_date are real dates and that wait_for and postconditions
+// durations parse.
+func (r *IssueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSummary(ctx, req.Config, &resp.Diagnostics)
+
+	var dueDate types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("due_date"), &dueDate)...)
+	if !dueDate.IsNull() && !dueDate.IsUnknown() {
+		if _, err := time.Parse(dueDateLayout, dueDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("due_date"),
+				"Invalid Due Date",
+				fmt.Sprintf("%q is not a valid date in YYYY-MM-DD format.", dueDate.ValueString()),
+			)
+		}
+	}
+
+	var startDate types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start_date"), &startDate)...)
+	if !startDate.IsNull() && !startDate.IsUnknown() {
+		if _, err := time.Parse(dueDateLayout, startDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("start_date"),
+				"Invalid Start Date",
+				fmt.Sprintf("%q is not a valid date in YYYY-MM-DD format.", startDate.ValueString()),
+			)
+		}
+	}
+
+	var customFields types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_fields"), &customFields)...)
+	for key, value := range customFields.Elements() {
+		str, ok := value.(types.String)
+		if !ok || str.IsNull() || str.IsUnknown() {
+			continue
+		}
+		if !json.Valid([]byte(str.ValueString())) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_fields").AtMapKey(key),
+				"Invalid Custom Field Value",
+				fmt.Sprintf("%q is not valid JSON. Encode values with jsonencode, e.g. jsonencode(5) or jsonencode({ value = \"Platform\" }).", str.ValueString()),
+			)
+		}
+	}
+
+	validatePostconditions(ctx, req.Config, &resp.Diagnostics)
+	validateEpicAttributes(ctx, req.Config, &resp.Diagnostics)
+
+	var waitFor types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for"), &waitFor)...)
+	if resp.Diagnostics.HasError() || waitFor.IsNull() || waitFor.IsUnknown() {
+		return
+	}
+
+	var wait IssueWaitForModel
+	resp.Diagnostics.Append(waitFor.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{"timeout": wait.Timeout, "poll_interval": wait.PollInterval} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if d, err := time.ParseDuration(value.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for").AtName(name),
+				"Invalid Duration",
+				fmt.Sprintf("%q is not a positive duration such as 30s or 5m.", value.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan reads description_source_file at plan time so content changes
+// show up as a hash diff, and missing files fail the plan. Long description
+// changes get a compact diff preview, since Terraform shows both full values.
+// New or changed content is checked against the provider's validation rules.
+// New issues without a description start from their type's issue template.
+func (r *IssueResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state IssueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A status that drifted from desired_status is planned as unknown so the
+	// apply transitions it back.
+	if !req.State.Raw.IsNull() && !plan.DesiredStatus.IsNull() && !plan.DesiredStatus.IsUnknown() &&
+		!strings.EqualFold(state.Status.ValueString(), plan.DesiredStatus.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	}
+
+	if !req.State.Raw.IsNull() && !plan.IssueType.IsUnknown() && !plan.IssueType.Equal(state.IssueType) {
+		r.keepIssueTypeOnFormSwitch(ctx, state.Key.ValueString(), plan.IssueType.ValueString(), resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	moving := !req.State.Raw.IsNull() && plan.AllowProjectMove.ValueBool() &&
+		!plan.Project.IsUnknown() && !plan.Project.Equal(state.Project)
+	if moving {
+		keepProjectOnMove(resp)
+	} else if !req.State.Raw.IsNull() && !plan.Project.Equal(state.Project) {
+		warnMovedIssueReplacement(ctx, req.Private, state.Key, plan.Project, &resp.Diagnostics)
+	}
+
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		keepUnchangedComputed(ctx, plan, state, resp)
+	}
+
+	// A move changes the key, and may change the status and type icon.
+	if moving && len(resp.RequiresReplace) == 0 {
+		for _, name := range []string{"key", "status", "issue_type_icon_url"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("handle"), types.ObjectUnknown(issueHandleAttrTypes))...)
+	}
+
+	r.planIssueTemplate(ctx, req, resp, &plan, &state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.checkIssueMetadata(ctx, plan, state, req.State.Raw.IsNull(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		r.description.previewDiff(state.Description, plan.Description, resp)
+	}
+
+	var sourceFile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("description_source_file"), &sourceFile)...)
+	if resp.Diagnostics.HasError() || sourceFile.IsUnknown() {
+		return
+	}
+
+	description := plan.Description.ValueString()
+	hash, length := types.StringNull(), types.Int64Null()
+	if !sourceFile.IsNull() {
+		content, sum, err := readDescriptionSource(sourceFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("description_source_file"), "Unable to Read Description Source File", err.Error())
+			return
+		}
+		description = content
+		hash = types.StringValue(sum)
+		length = types.Int64Value(int64(len(content)))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("description_source_hash"), hash)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("description_source_length"), length)...)
+
+	// Only lint content being written, so existing issues don't start failing
+	// plans the moment a rule is introduced.
+	contentChanged := req.State.Raw.IsNull() ||
+		!plan.Summary.Equal(state.Summary) ||
+		!plan.Description.Equal(state.Description) ||
+		!hash.Equal(state.DescriptionSourceHash)
+	if contentChanged && !plan.Summary.IsUnknown() && !plan.Description.IsUnknown() {
+		r.validationRules.lint(lintTarget{summary: plan.Summary.ValueString(), description: description}, &resp.Diagnostics)
+	}
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *IssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.JiraClient)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.JiraClient, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.description = newDescriptionText(providerData)
+	r.validationRules = providerData.ValidationRules
+	r.templates = providerData.IssueTemplates
+	r.manifest = providerData.KeyManifest
+	r.removeWithoutProjectAccess = providerData.RemoveIssuesWithoutProjectAccess
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -193,23 +750,68 @@ func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Build the issue fields
 	fields := client.IssueFields{
 		Project:   &client.Project{Key: data.Project.ValueString()},
-		Summary:   data.Summary.ValueString(),
-		IssueType: &client.IssueType{Name: data.IssueType.ValueString()},
+		Summary:   sentSummary(data.Summary, data.AutoTrimSummary),
+		IssueType: client.IssueTypeRef(data.IssueType.ValueString()),
 	}
 
 	// Add optional fields
 	if !data.Description.IsNull() {
-		fields.Description = client.TextToADF(data.Description.ValueString())
+		fields.Description = r.description.forCreate(data.Description, data.DescriptionFormat)
+	}
+
+	if !data.DescriptionSourceFile.IsNull() {
+		description, err := sourcedDescription(data, r.description)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("description_source_file"), "Unable to Read Description Source File", err.Error())
+			return
+		}
+		fields.Description = description
 	}
 
 	if !data.Priority.IsNull() {
-		fields.Priority = &client.Priority{Name: data.Priority.ValueString()}
+		fields.Priority = client.PriorityRef(data.Priority.ValueString())
 	}
 
 	if !data.ParentKey.IsNull() {
 		fields.Parent = &client.Parent{Key: data.ParentKey.ValueString()}
 	}
 
+	if !data.Reporter.IsNull() && !data.Reporter.IsUnknown() {
+		fields.Reporter = r.client.UserRef(data.Reporter.ValueString())
+	}
+
+	// A silent create assigns the issue afterwards; see below.
+	if !data.Assignee.IsNull() && !data.SilentCreate.ValueBool() {
+		fields.Assignee = r.client.UserRef(data.Assignee.ValueString())
+	}
+
+	if !data.DueDate.IsNull() {
+		fields.DueDate = data.DueDate.ValueString()
+	}
+
+	fields.TimeTracking = issueTimeTracking(data)
+
+	// Epic Color is set after the create, since Jira leaves it off most
+	// create screens.
+	epic := r.epicFields(ctx, data, &resp.Diagnostics)
+	if epic != nil && !data.EpicName.IsNull() {
+		setEpicField(&fields, epic.NameFieldID, data.EpicName, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StartDate.IsNull() {
+		startDate := r.startDateField(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := fields.SetCustom(startDate, data.StartDate.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to set start date", err.Error())
+			return
+		}
+	}
+
 	// Add labels
 	if !data.Labels.IsNull() {
 		var labels []string
@@ -220,35 +822,194 @@ func (r *IssueResource) Create(ctx context.Context, req resource.CreateRequest,
 		fields.Labels = labels
 	}
 
-	// Create the issue
-	issue, err := r.client.CreateIssue(&client.CreateIssueRequest{Fields: fields})
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to create issue", err.Error())
+	versions := &versionResolver{client: r.client, project: data.Project.ValueString()}
+	fixVersions, diags := versions.resolve(ctx, "fix_versions", data.FixVersions)
+	resp.Diagnostics.Append(diags...)
+	affectsVersions, diags := versions.resolve(ctx, "affects_versions", data.AffectsVersions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	fields.FixVersions = fixVersions
+	fields.Versions = affectsVersions
 
-	// Fetch the created issue to get all fields
-	createdIssue, err := r.client.GetIssue(issue.Key)
+	customFields, err := resolveCustomFields(ctx, r.client, data.CustomFields)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read created issue", err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("custom_fields"), "Failed to resolve custom fields", err.Error())
 		return
 	}
+	setCustomFields(&fields, customFields)
 
-	// Update state
-	data.ID = types.StringValue(createdIssue.ID)
-	data.Key = types.StringValue(createdIssue.Key)
-	if createdIssue.Fields.Status != nil {
-		data.Status = types.StringValue(createdIssue.Fields.Status.Name)
-	}
+	var issueKey string
+	if data.AdoptExisting.ValueBool() {
+		existing, err := r.findAdoptableIssue(ctx, data.Project.ValueString(), data.IssueType.ValueString(), fields.Summary)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to search for an existing issue to adopt", err.Error())
+			return
+		}
 
-	tflog.Info(ctx, "Created Jira issue", map[string]any{
-		"key": createdIssue.Key,
-	})
+		if existing != nil {
+			update := fields
+			update.Project = nil
+			update.IssueType = nil
+			if err := r.client.UpdateIssue(ctx, existing.Key, &client.UpdateIssueRequest{Fields: update}); err != nil {
+				resp.Diagnostics.AddError("Failed to update adopted issue", err.Error())
+				return
+			}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+			tflog.Info(ctx, "Adopted existing Jira issue", map[string]any{
+				"key": existing.Key,
+			})
+			issueKey = existing.Key
+		}
+	}
 
-// Read refreshes the Terraform state with the latest data.
+	if issueKey == "" && data.UniqueSummary.ValueBool() {
+		duplicate, err := r.findOpenIssueWithSummary(ctx, data.Project.ValueString(), fields.Summary)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to check for duplicate summaries", err.Error())
+			return
+		}
+
+		if duplicate != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("summary"),
+				"Duplicate Issue Summary",
+				fmt.Sprintf("Open issue %s in project %s already has the summary %q. "+
+					"Change the summary, resolve the existing issue, or set adopt_existing if it was created by this provider.",
+					duplicate, data.Project.ValueString(), fields.Summary),
+			)
+			return
+		}
+	}
+
+	// Create the issue
+	if issueKey == "" {
+		issue, err := r.client.CreateIssue(ctx, &client.CreateIssueRequest{Fields: fields})
+		if err != nil && fields.Parent != nil && client.IsParentHierarchyError(err) {
+			// Older company-managed projects place stories in epics through
+			// the legacy Epic Link field rather than fields.parent.
+			tflog.Debug(ctx, "Parent rejected, retrying with the Epic Link field", map[string]any{
+				"parent_key": fields.Parent.Key,
+			})
+			issue, err = r.createWithEpicLink(ctx, fields)
+		}
+		if err != nil {
+			if addTimeTrackingError(&resp.Diagnostics, data, err) {
+				return
+			}
+			resp.Diagnostics.AddError("Failed to create issue", err.Error()+cascadeOptionsHint(ctx, r.client, data.CustomFields))
+			return
+		}
+		issueKey = issue.Key
+	}
+
+	// Fetch the created issue to get all fields
+	createdIssue, err := r.client.GetIssue(ctx, issueKey)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created issue", err.Error())
+		return
+	}
+
+	// Update state
+	data.ID = types.StringValue(createdIssue.ID)
+	data.Key = types.StringValue(createdIssue.Key)
+	data.Handle, diags = issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	if createdIssue.Fields.Status != nil {
+		data.Status = types.StringValue(createdIssue.Fields.Status.Name)
+	}
+	data.Reporter = userAccountID(createdIssue.Fields.Reporter)
+	data.CreatorAccountID = userAccountID(createdIssue.Fields.Creator)
+	data.PriorityIconURL, data.PriorityColor = priorityStyle(createdIssue.Fields.Priority)
+	data.IssueTypeIconURL = issueTypeIconURL(createdIssue.Fields.IssueType)
+	data.ParentSummary, data.ParentStatus = parentDetails(createdIssue.Fields.Parent)
+	resp.Diagnostics.Append(r.readAttachments(ctx, resp.Private, createdIssue, &data)...)
+	if resp.Diagnostics.HasError() {
+		// The issue exists, so keep it in state rather than orphaning it.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	update := r.client.UpdateIssue
+	if data.SilentCreate.ValueBool() {
+		update = r.client.UpdateIssueSilently
+	}
+
+	if assign := createdAssignee(r.client, data, createdIssue, fields.Assignee != nil); assign != nil {
+		if err := update(ctx, createdIssue.Key, &client.UpdateIssueRequest{Fields: *assign}); err != nil {
+			summary := "Failed to assign created issue"
+			if assign.Assignee == nil {
+				summary = "Failed to unassign created issue"
+			}
+			// The issue exists, so keep it in state rather than orphaning it.
+			resp.Diagnostics.AddError(summary, err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if epic != nil && !data.EpicColor.IsNull() {
+		if err := r.setEpicColor(ctx, createdIssue.Key, epic, data.EpicColor, update); err != nil {
+			resp.Diagnostics.AddError("Failed to set epic color", err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if !data.WaitFor.IsNull() {
+		var wait IssueWaitForModel
+		resp.Diagnostics.Append(data.WaitFor.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		status, err := r.waitForStatus(ctx, createdIssue.Key, wait)
+		if status != nil {
+			data.Status = types.StringValue(status.Name)
+		}
+		if err != nil {
+			// The issue exists, so keep it in state and let the next apply retry.
+			resp.Diagnostics.AddError("Issue did not reach the expected status", err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if err := r.applyDesiredStatus(ctx, &data); err != nil {
+		// As with wait_for, the issue exists and the next apply retries.
+		resp.Diagnostics.AddAttributeError(path.Root("desired_status"), "Failed to Transition Issue", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if !data.SprintID.IsNull() {
+		if err := r.client.MoveIssuesToSprint(ctx, data.SprintID.ValueInt64(), []string{createdIssue.Key}); err != nil {
+			resp.Diagnostics.AddError("Failed to move issue to sprint", err.Error())
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if err := r.readSprint(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to read issue sprint", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	r.recordKey(data)
+
+	// As with wait_for, a failed check keeps the created issue in state.
+	r.checkPostconditions(ctx, createdIssue.Key, data.Postconditions, &resp.Diagnostics)
+
+	tflog.Info(ctx, "Created Jira issue", map[string]any{
+		"key": createdIssue.Key,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
 func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data IssueResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -258,124 +1019,398 @@ func (r *IssueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	tflog.Debug(ctx, "Reading Jira issue", map[string]any{
 		"key": data.Key.ValueString(),
+		"id":  data.ID.ValueString(),
 	})
 
-	issue, err := r.client.GetIssue(data.Key.ValueString())
+	issue, err := getManagedIssue(ctx, r.client, data.ID, data.Key)
 	if err != nil {
 		// Check if issue was deleted
-		if strings.Contains(err.Error(), "404") {
-			resp.State.RemoveResource(ctx)
+		if client.IsNotFound(err) {
+			removeMissingIssue(ctx, r.client, data.Key.ValueString(), r.removeWithoutProjectAccess, resp)
 			return
 		}
 		resp.Diagnostics.AddError("Failed to read issue", err.Error())
 		return
 	}
 
-	// Update state from API response
-	data.ID = types.StringValue(issue.ID)
-	data.Key = types.StringValue(issue.Key)
-	data.Summary = types.StringValue(issue.Fields.Summary)
+	// Imported issues have no ID in state yet.
+	importing := data.ID.IsNull()
+	if data.TemplateApplied.IsNull() {
+		data.TemplateApplied = types.BoolValue(false)
+	}
+	// Update state from API response. Description, issue type, priority,
+	// parent key and labels keep the forms the configuration uses, so only
+	// the plain attributes come from the standard mapping.
+	attrs, diags := mapIssue(ctx, issue)
+	resp.Diagnostics.Append(diags...)
+	priorKey, priorProject := data.Key, data.Project
+	data.ID = attrs.ID
+	data.Key = attrs.Key
+	data.Summary = readSummary(issue.Fields.Summary, data.Summary, data.AutoTrimSummary)
 
-	if issue.Fields.Description != nil {
-		data.Description = types.StringValue(client.ADFToText(issue.Fields.Description))
-	} else {
+	resp.Diagnostics.Append(r.description.read(ctx, resp.Private, issue.Fields.Description, &data.Description, &data.DescriptionFormat)...)
+	// A sourced description lives in the file, not in state.
+	if !data.DescriptionSourceFile.IsNull() {
 		data.Description = types.StringNull()
 	}
 
 	if issue.Fields.Project != nil {
-		data.Project = types.StringValue(issue.Fields.Project.Key)
+		data.Project = readProjectKey(ctx, r.client, data.Project, issue.Fields.Project.Key, &resp.Diagnostics)
 	}
+	warnRenamedIssue(ctx, resp.Private, priorKey, priorProject, data.Project, issue, true, &resp.Diagnostics)
 
+	// Keep whichever form, name or ID, the configuration uses.
 	if issue.Fields.IssueType != nil {
-		data.IssueType = types.StringValue(issue.Fields.IssueType.Name)
+		data.IssueType = nameOrID(data.IssueType, issue.Fields.IssueType.ID, issue.Fields.IssueType.Name)
 	}
 
 	if issue.Fields.Status != nil {
 		data.Status = types.StringValue(issue.Fields.Status.Name)
 	}
 
-	if issue.Fields.Priority != nil {
-		data.Priority = types.StringValue(issue.Fields.Priority.Name)
+	// An unconfigured priority falls back to the priority scheme's default,
+	// which isn't tracked; imports pick up whatever priority is set.
+	if issue.Fields.Priority != nil && (!data.Priority.IsNull() || importing) {
+		data.Priority = nameOrID(data.Priority, issue.Fields.Priority.ID, issue.Fields.Priority.Name)
+	} else if issue.Fields.Priority == nil {
+		data.Priority = types.StringNull()
 	}
 
-	if issue.Fields.Parent != nil {
-		data.ParentKey = types.StringValue(issue.Fields.Parent.Key)
-	} else {
-		data.ParentKey = types.StringNull()
+	data.ParentKey = r.parentKey(ctx, issue)
+	data.ParentSummary, data.ParentStatus = attrs.ParentSummary, attrs.ParentStatus
+
+	data.Assignee = attrs.Assignee
+	data.DueDate = attrs.DueDate
+	data.Reporter = attrs.Reporter
+	data.CreatorAccountID = attrs.CreatorAccountID
+	data.PriorityIconURL, data.PriorityColor = attrs.PriorityIconURL, attrs.PriorityColor
+	data.IssueTypeIconURL = attrs.IssueTypeIconURL
+
+	readEstimates(issue, &data, importing)
+	r.readEpicFields(ctx, issue, &data, &resp.Diagnostics)
+	resp.Diagnostics.Append(r.readAttachments(ctx, resp.Private, issue, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The start date field is only looked up once start_date is managed or
+	// the issue is being imported.
+	if !data.StartDate.IsNull() || importing {
+		data.StartDate = r.readStartDate(ctx, issue, data.StartDate, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Handle labels
+	// Keep a configured empty set rather than flipping it to null, which
+	// would show as a diff on every plan.
 	if len(issue.Fields.Labels) > 0 {
-		labels, diags := types.ListValueFrom(ctx, types.StringType, issue.Fields.Labels)
+		labels, diags := types.SetValueFrom(ctx, types.StringType, issue.Fields.Labels)
 		resp.Diagnostics.Append(diags...)
 		data.Labels = labels
+	} else if !data.Labels.IsNull() {
+		data.Labels = types.SetValueMust(types.StringType, nil)
 	} else {
-		data.Labels = types.ListNull(types.StringType)
+		data.Labels = types.SetNull(types.StringType)
+	}
+
+	fixVersions, diags := versionSet(ctx, issue.Fields.FixVersions, data.FixVersions)
+	resp.Diagnostics.Append(diags...)
+	data.FixVersions = fixVersions
+	affectsVersions, diags := versionSet(ctx, issue.Fields.Versions, data.AffectsVersions)
+	resp.Diagnostics.Append(diags...)
+	data.AffectsVersions = affectsVersions
+
+	customFields, err := readCustomFields(ctx, r.client, data.CustomFields, issue.Fields)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("custom_fields"), "Failed to read custom fields", err.Error())
+		return
+	}
+	data.CustomFields = customFields
+
+	if err := r.readSprint(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to read issue sprint", err.Error())
+		return
 	}
 
+	handle, diags := issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	data.Handle = handle
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *IssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data IssueResourceModel
+	var data, state IssueResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if data.Key.IsUnknown() {
+		data.Key = state.Key
+	}
+
 	tflog.Debug(ctx, "Updating Jira issue", map[string]any{
 		"key": data.Key.ValueString(),
 	})
 
+	if !data.Project.Equal(state.Project) {
+		if err := r.moveProject(ctx, &data); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("project"), "Failed to Move Issue", err.Error())
+			return
+		}
+	}
+
 	// Build update fields
 	fields := client.IssueFields{
-		Summary: data.Summary.ValueString(),
+		Summary: sentSummary(data.Summary, data.AutoTrimSummary),
+		Clear:   clearedIssueFields(data, state),
 	}
 
-	if !data.Description.IsNull() {
-		fields.Description = client.TextToADF(data.Description.ValueString())
+	resp.Diagnostics.Append(r.description.setForUpdate(ctx, req.Private, &fields, data.Description, data.DescriptionFormat, issueHadDescription(data, state))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.DescriptionSourceFile.IsNull() && !data.DescriptionSourceHash.Equal(state.DescriptionSourceHash) {
+		description, err := sourcedDescription(data, r.description)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("description_source_file"), "Unable to Read Description Source File", err.Error())
+			return
+		}
+		fields.Description = description
 	}
 
 	if !data.Priority.IsNull() {
-		fields.Priority = &client.Priority{Name: data.Priority.ValueString()}
+		fields.Priority = client.PriorityRef(data.Priority.ValueString())
 	}
 
-	// Handle labels
-	if !data.Labels.IsNull() {
-		var labels []string
-		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if !data.Reporter.IsNull() && !data.Reporter.IsUnknown() && !data.Reporter.Equal(state.Reporter) {
+		fields.Reporter = r.client.UserRef(data.Reporter.ValueString())
+	}
+
+	if !data.DueDate.IsNull() {
+		fields.DueDate = data.DueDate.ValueString()
+	}
+
+	// Removed estimates stay in Jira untracked, so only set ones are sent.
+	if !data.OriginalEstimate.Equal(state.OriginalEstimate) || !data.RemainingEstimate.Equal(state.RemainingEstimate) {
+		fields.TimeTracking = issueTimeTracking(data)
+	}
+
+	// Custom fields set by attributes other than custom_fields, so screening
+	// names the attribute responsible.
+	fieldAttributes := make(map[string]string)
+
+	// As with estimates, removed epic attributes are left as they are.
+	nameChanged := !data.EpicName.IsNull() && !data.EpicName.Equal(state.EpicName)
+	colorChanged := !data.EpicColor.IsNull() && !data.EpicColor.Equal(state.EpicColor)
+	var epic *client.EpicFields
+	if nameChanged || colorChanged {
+		epic = r.epicFields(ctx, data, &resp.Diagnostics)
+		if epic != nil && nameChanged {
+			setEpicField(&fields, epic.NameFieldID, data.EpicName, &resp.Diagnostics)
+			fieldAttributes[epic.NameFieldID] = "epic_name"
+		}
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		fields.Labels = labels
 	}
 
-	// Update the issue
-	err := r.client.UpdateIssue(data.Key.ValueString(), &client.UpdateIssueRequest{Fields: fields})
+	if !data.StartDate.Equal(state.StartDate) {
+		startDate := r.startDateField(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		fieldAttributes[startDate] = "start_date"
+		if data.StartDate.IsNull() {
+			fields.Clear = append(fields.Clear, startDate)
+		} else if err := fields.SetCustom(startDate, data.StartDate.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to set start date", err.Error())
+			return
+		}
+	}
+
+	if !data.Assignee.IsNull() && !data.Assignee.Equal(state.Assignee) {
+		fields.Assignee = r.client.UserRef(data.Assignee.ValueString())
+	}
+
+	// Labels and versions change through add and remove verbs, so values
+	// added in Jira since the last refresh aren't overwritten. A removed or
+	// empty set removes every value state knows about.
+	verbs := client.UpdateVerbs{}
+	added, removed := setChanges(ctx, state.Labels, data.Labels, &resp.Diagnostics)
+	for _, label := range added {
+		verbs.AddLabel(label)
+	}
+	for _, label := range removed {
+		verbs.RemoveLabel(label)
+	}
+
+	// Resolving checks the planned names against the project's versions.
+	versions := &versionResolver{client: r.client, project: data.Project.ValueString()}
+	_, diags := versions.resolve(ctx, "fix_versions", data.FixVersions)
+	resp.Diagnostics.Append(diags...)
+	_, diags = versions.resolve(ctx, "affects_versions", data.AffectsVersions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	added, removed = setChanges(ctx, state.FixVersions, data.FixVersions, &resp.Diagnostics)
+	for _, name := range added {
+		verbs.AddFixVersion(name)
+	}
+	for _, name := range removed {
+		verbs.RemoveFixVersion(name)
+	}
+	added, removed = setChanges(ctx, state.AffectsVersions, data.AffectsVersions, &resp.Diagnostics)
+	for _, name := range added {
+		verbs.AddAffectsVersion(name)
+	}
+	for _, name := range removed {
+		verbs.RemoveAffectsVersion(name)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customFields, err := resolveCustomFields(ctx, r.client, data.CustomFields)
+	if err == nil {
+		setCustomFields(&fields, customFields)
+		err = clearRemovedCustomFields(ctx, r.client, &fields, data.CustomFields, state.CustomFields)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update issue", err.Error())
+		resp.Diagnostics.AddAttributeError(path.Root("custom_fields"), "Failed to resolve custom fields", err.Error())
+		return
+	}
+
+	update := &client.UpdateIssueRequest{Fields: fields, Update: verbs}
+	r.screenUpdate(ctx, data.Key.ValueString(), data.OnUneditableField, update, fieldAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Fetch updated issue
-	issue, err := r.client.GetIssue(data.Key.ValueString())
+	// Update the issue
+	err = r.client.UpdateIssue(ctx, data.Key.ValueString(), update)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read updated issue", err.Error())
+		if addTimeTrackingError(&resp.Diagnostics, data, err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to update issue", err.Error()+cascadeOptionsHint(ctx, r.client, data.CustomFields))
 		return
 	}
 
-	if issue.Fields.Status != nil {
-		data.Status = types.StringValue(issue.Fields.Status.Name)
+	if epic != nil && colorChanged {
+		if err := r.setEpicColor(ctx, data.Key.ValueString(), epic, data.EpicColor, r.client.UpdateIssue); err != nil {
+			resp.Diagnostics.AddError("Failed to set epic color", err.Error())
+			return
+		}
+	}
+
+	// The plan already carries every computed value the update can't have
+	// changed, so the issue is only read back when something is unknown.
+	if issueReadNeeded(data) {
+		issue, err := r.client.GetIssue(ctx, data.Key.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read updated issue", err.Error())
+			return
+		}
+
+		if issue.Fields.Status != nil {
+			data.Status = types.StringValue(issue.Fields.Status.Name)
+		}
+		data.Reporter = userAccountID(issue.Fields.Reporter)
+		data.PriorityIconURL, data.PriorityColor = priorityStyle(issue.Fields.Priority)
+		data.IssueTypeIconURL = issueTypeIconURL(issue.Fields.IssueType)
+		data.ParentSummary, data.ParentStatus = parentDetails(issue.Fields.Parent)
+		if data.Attachments.IsUnknown() {
+			resp.Diagnostics.Append(r.readAttachments(ctx, resp.Private, issue, &data)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if err := r.applyDesiredStatus(ctx, &data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("desired_status"), "Failed to Transition Issue", err.Error())
+		return
+	}
+
+	if !data.SprintID.Equal(state.SprintID) {
+		if err := r.updateSprint(ctx, data.Project.ValueString(), data.Key.ValueString(), data.SprintID); err != nil {
+			resp.Diagnostics.AddError("Failed to update issue sprint", err.Error())
+			return
+		}
 	}
 
+	if err := r.readSprint(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to read issue sprint", err.Error())
+		return
+	}
+
+	handle, diags := issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	data.Handle = handle
+
+	r.recordKey(data)
+
+	r.checkPostconditions(ctx, data.Key.ValueString(), data.Postconditions, &resp.Diagnostics)
+
 	tflog.Info(ctx, "Updated Jira issue", map[string]any{
 		"key": data.Key.ValueString(),
 	})
 
+	// An applied configuration settles a move made in Jira.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, issueMovedKey, nil)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// createdAssignee returns the edit that makes a created issue's assignee
+// match the plan, or nil when it already does. A project default assignee
+// (or an adopted issue's assignee) is cleared, since it would otherwise show
+// up as drift against an unset assignee. An assignee the create request left
+// out, so assigning doesn't notify, is set.
+func createdAssignee(c *client.JiraClient, data IssueResourceModel, created *client.Issue, sent bool) *client.IssueFields {
+	if data.Assignee.IsNull() {
+		if created.Fields.Assignee == nil {
+			return nil
+		}
+		return &client.IssueFields{Clear: []string{"assignee"}}
+	}
+	if sent || userAccountID(created.Fields.Assignee).Equal(data.Assignee) {
+		return nil
+	}
+	return &client.IssueFields{Assignee: c.UserRef(data.Assignee.ValueString())}
+}
+
+// clearedIssueFields lists the system fields removed from the configuration
+// since the last apply. Updates clear them explicitly; leaving them out of
+// the update would keep the old value in Jira.
+func clearedIssueFields(data, state IssueResourceModel) []string {
+	var cleared []string
+	if data.Priority.IsNull() && !state.Priority.IsNull() {
+		cleared = append(cleared, "priority")
+	}
+	if data.DueDate.IsNull() && !state.DueDate.IsNull() {
+		cleared = append(cleared, "duedate")
+	}
+	if data.Assignee.IsNull() && !state.Assignee.IsNull() {
+		cleared = append(cleared, "assignee")
+	}
+	return cleared
+}
+
+// issueHadDescription reports whether state holds a description a null
+// planned description removes. A description read from
+// description_source_file is replaced rather than cleared.
+func issueHadDescription(data, state IssueResourceModel) bool {
+	return data.DescriptionSourceFile.IsNull() && (!state.Description.IsNull() || !state.DescriptionSourceFile.IsNull())
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data IssueResourceModel
@@ -388,22 +1423,491 @@ func (r *IssueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		"key": data.Key.ValueString(),
 	})
 
-	err := r.client.DeleteIssue(data.Key.ValueString())
+	err := destroyIssue(ctx, r.client, data.Key.ValueString(), data.OnDestroy, data.CloseStatus, data.CloseLabel, data.DeleteSubtasks.ValueBool())
 	if err != nil {
-		// Ignore 404 errors (already deleted)
-		if !strings.Contains(err.Error(), "404") {
-			resp.Diagnostics.AddError("Failed to delete issue", err.Error())
+		if client.HasSubtasks(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("delete_subtasks"), "Issue Has Subtasks",
+				fmt.Sprintf("Jira won't delete issue %s while it has subtasks: %s\n\n"+
+					"Set delete_subtasks = true and apply before destroying to delete the subtasks with the issue, or remove them first.", data.Key.ValueString(), err))
 			return
 		}
+		resp.Diagnostics.AddError("Failed to delete issue", err.Error())
+		return
 	}
 
+	r.manifest.remove(data.Key.ValueString())
+
 	tflog.Info(ctx, "Deleted Jira issue", map[string]any{
 		"key": data.Key.ValueString(),
 	})
 }
 
+// recordKey adds the issue to the provider's key manifest, if configured.
+func (r *IssueResource) recordKey(data IssueResourceModel) {
+	r.manifest.record(keyManifestEntry{
+		Key:          data.Key.ValueString(),
+		URL:          r.client.BrowseURL(data.Key.ValueString()),
+		ResourceType: "jira_issue",
+		Project:      data.Project.ValueString(),
+		Summary:      data.Summary.ValueString(),
+	})
+}
+
 // ImportState imports the resource into Terraform state.
 func (r *IssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
 }
 
+// updateSprint moves an issue into the planned sprint, or back to the backlog
+// when the sprint assignment was removed. Projects without a scrum board have
+// no backlog, so removing the assignment there makes no API call.
+func (r *IssueResource) updateSprint(ctx context.Context, project, key string, sprintID types.Int64) error {
+	if !sprintID.IsNull() {
+		return r.client.MoveIssuesToSprint(ctx, sprintID.ValueInt64(), []string{key})
+	}
+
+	hasScrum, err := r.client.ProjectHasScrumBoard(ctx, project)
+	if err != nil || !hasScrum {
+		return err
+	}
+
+	return r.client.MoveIssuesToBacklog(ctx, []string{key})
+}
+
+// readSprint populates in_backlog, and sprint_id when it is managed, from the
+// Agile API. Projects without a scrum board (or instances without Jira
+// Software) leave in_backlog null without looking up the issue's sprint.
+func (r *IssueResource) readSprint(ctx context.Context, data *IssueResourceModel) error {
+	hasScrum, err := r.client.ProjectHasScrumBoard(ctx, data.Project.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			data.InBacklog = types.BoolNull()
+			return nil
+		}
+		return err
+	}
+
+	if !hasScrum {
+		data.InBacklog = types.BoolNull()
+		return nil
+	}
+
+	sprint, err := r.client.GetIssueSprint(ctx, data.Key.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.InBacklog = types.BoolValue(sprint == nil)
+
+	// Only track the sprint when the configuration manages it, so issues
+	// planned in the Jira UI don't show up as drift.
+	if !data.SprintID.IsNull() {
+		if sprint != nil {
+			data.SprintID = types.Int64Value(sprint.ID)
+		} else {
+			data.SprintID = types.Int64Null()
+		}
+	}
+
+	return nil
+}
+
+// findAdoptableIssue looks for an existing issue with exactly the planned
+// project, issue type, and summary that was created by the provider's own
+// account. Matching on the creator rather than the reporter keeps the
+// provider from adopting a human's issue that happens to share a summary.
+func (r *IssueResource) findAdoptableIssue(ctx context.Context, project, issueType, summary string) (*client.Issue, error) {
+	me, err := r.client.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// JQL reads a quoted value as a name, so IDs are passed bare.
+	issueTypeClause := client.QuoteJQL(issueType)
+	if client.IsID(issueType) {
+		issueTypeClause = issueType
+	}
+
+	jql := fmt.Sprintf("project = %s AND issuetype = %s AND summary ~ %s AND creator = currentUser() ORDER BY created ASC",
+		client.QuoteJQL(project),
+		issueTypeClause,
+		client.QuoteJQLText(summary),
+	)
+
+	result, err := r.client.SearchIssues(ctx, jql, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result.Issues {
+		issue := &result.Issues[i]
+		if issue.Fields.Summary != summary {
+			continue
+		}
+		if !userAccountID(issue.Fields.Creator).Equal(userAccountID(me)) {
+			continue
+		}
+		return issue, nil
+	}
+
+	return nil, nil
+}
+
+// findOpenIssueWithSummary returns the key of an unresolved issue in the
+// project whose summary exactly matches, or "" when there is none. The cheap
+// approximate count rules out the common no-match case before any issues
+// are fetched.
+func (r *IssueResource) findOpenIssueWithSummary(ctx context.Context, project, summary string) (string, error) {
+	jql := fmt.Sprintf("project = %s AND summary ~ %s AND statusCategory != Done",
+		client.QuoteJQL(project),
+		client.QuoteJQLText(summary),
+	)
+
+	count, err := r.client.ApproximateCount(ctx, jql)
+	if err != nil || count == 0 {
+		return "", err
+	}
+
+	// Text search is fuzzy, so compare the candidates exactly.
+	result, err := r.client.SearchIssues(ctx, jql+" ORDER BY created ASC", 50)
+	if err != nil {
+		return "", err
+	}
+
+	for _, issue := range result.Issues {
+		if issue.Fields.Summary == summary {
+			return issue.Key, nil
+		}
+	}
+
+	return "", nil
+}
+
+// createWithEpicLink creates an issue with its parent set through the legacy
+// Epic Link field instead of fields.parent.
+func (r *IssueResource) createWithEpicLink(ctx context.Context, fields client.IssueFields) (*client.Issue, error) {
+	epicLink, err := r.client.FieldIDByCustomType(ctx, client.EpicLinkFieldType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the Epic Link field: %w", err)
+	}
+	if epicLink == "" {
+		return nil, fmt.Errorf("the project rejected parent %s and the site has no Epic Link field", fields.Parent.Key)
+	}
+
+	if err := fields.SetCustom(epicLink, fields.Parent.Key); err != nil {
+		return nil, err
+	}
+	fields.Parent = nil
+
+	return r.client.CreateIssue(ctx, &client.CreateIssueRequest{Fields: fields})
+}
+
+// parentKey returns the issue's parent from fields.parent or, for projects
+// still using it, the legacy Epic Link field, so configurations read the same
+// across project types.
+func (r *IssueResource) parentKey(ctx context.Context, issue *client.Issue) types.String {
+	if issue.Fields.Parent != nil {
+		return types.StringValue(issue.Fields.Parent.Key)
+	}
+	if len(issue.Fields.Custom) == 0 {
+		return types.StringNull()
+	}
+
+	epicLink, err := r.client.FieldIDByCustomType(ctx, client.EpicLinkFieldType)
+	if err != nil {
+		// Not fatal: without field metadata the issue simply reads as unparented.
+		tflog.Warn(ctx, "Could not look up the Epic Link field", map[string]any{
+			"error": err.Error(),
+		})
+		return types.StringNull()
+	}
+
+	if key, ok := issue.Fields.CustomString(epicLink); ok && epicLink != "" && key != "" {
+		return types.StringValue(key)
+	}
+	return types.StringNull()
+}
+
+// keepIssueTypeOnFormSwitch drops the replacement that an issue_type change
+// forces when the new value only switches between the name and ID of the
+// issue's current type.
+func (r *IssueResource) keepIssueTypeOnFormSwitch(ctx context.Context, key, issueType string, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	issue, err := r.client.GetIssue(ctx, key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read issue type", err.Error())
+		return
+	}
+
+	current := issue.Fields.IssueType
+	if current == nil || (current.ID != issueType && !strings.EqualFold(current.Name, issueType)) {
+		return
+	}
+
+	tflog.Debug(ctx, "issue_type switched form, not replacing the issue", map[string]any{
+		"key":        key,
+		"issue_type": issueType,
+	})
+
+	var requiresReplace path.Paths
+	for _, p := range resp.RequiresReplace {
+		if !p.Equal(path.Root("issue_type")) {
+			requiresReplace = append(requiresReplace, p)
+		}
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+// keepProjectOnMove drops the replacement that a project change forces when
+// allow_project_move is set, so Update moves the issue instead.
+func keepProjectOnMove(resp *resource.ModifyPlanResponse) {
+	var requiresReplace path.Paths
+	for _, p := range resp.RequiresReplace {
+		if !p.Equal(path.Root("project")) {
+			requiresReplace = append(requiresReplace, p)
+		}
+	}
+	resp.RequiresReplace = requiresReplace
+}
+
+// moveProject moves the issue to the planned project with the bulk move
+// API and records its new key.
+func (r *IssueResource) moveProject(ctx context.Context, data *IssueResourceModel) error {
+	project := data.Project.ValueString()
+	issueTypes, err := r.client.GetIssueTypes(ctx, project)
+	if err != nil {
+		return fmt.Errorf("failed to read issue types of project %s: %w", project, err)
+	}
+
+	issueTypeID := ""
+	for _, issueType := range issueTypes {
+		if issueType.ID == data.IssueType.ValueString() || strings.EqualFold(issueType.Name, data.IssueType.ValueString()) {
+			issueTypeID = issueType.ID
+			break
+		}
+	}
+	if issueTypeID == "" {
+		return fmt.Errorf("project %s has no issue type %q", project, data.IssueType.ValueString())
+	}
+
+	tflog.Info(ctx, "Moving Jira issue to another project", map[string]any{
+		"key":     data.Key.ValueString(),
+		"project": project,
+	})
+	if err := r.client.MoveIssues(ctx, []string{data.Key.ValueString()}, project, issueTypeID); err != nil {
+		return err
+	}
+
+	// Issue IDs survive the move and resolve to the new key.
+	issue, err := r.client.GetIssue(ctx, data.ID.ValueString())
+	if err != nil {
+		return fmt.Errorf("failed to read moved issue: %w", err)
+	}
+	data.Key = types.StringValue(issue.Key)
+	return nil
+}
+
+// keepUnchangedComputed plans computed attributes from state when nothing
+// that feeds them changes. Without it they would all show as known after
+// apply on any update, and Update would have to read the issue back to fill
+// them in.
+func keepUnchangedComputed(ctx context.Context, plan, state IssueResourceModel, resp *resource.ModifyPlanResponse) {
+	keep := func(name string, unchanged bool, value attr.Value) {
+		if unchanged {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+		}
+	}
+
+	// Status only moves when a transition is driven.
+	statusKept := plan.DesiredStatus.IsNull() ||
+		(!plan.DesiredStatus.IsUnknown() && strings.EqualFold(state.Status.ValueString(), plan.DesiredStatus.ValueString()))
+	keep("status", statusKept, state.Status)
+
+	priorityKept := plan.Priority.Equal(state.Priority)
+	keep("priority_icon_url", priorityKept, state.PriorityIconURL)
+	keep("priority_color", priorityKept, state.PriorityColor)
+
+	keep("issue_type_icon_url", plan.IssueType.Equal(state.IssueType), state.IssueTypeIconURL)
+
+	parentKept := plan.ParentKey.Equal(state.ParentKey)
+	keep("parent_summary", parentKept, state.ParentSummary)
+	keep("parent_status", parentKept, state.ParentStatus)
+
+	// Attachments aren't managed here, so refreshes pick up their changes.
+	keep("attachments", plan.TrackAttachments.Equal(state.TrackAttachments), state.Attachments)
+}
+
+// issueReadNeeded reports whether any attribute refreshed from the issue
+// after an update is still unknown in the plan.
+func issueReadNeeded(data IssueResourceModel) bool {
+	for _, value := range []attr.Value{
+		data.Status,
+		data.Reporter,
+		data.PriorityIconURL,
+		data.PriorityColor,
+		data.IssueTypeIconURL,
+		data.ParentSummary,
+		data.ParentStatus,
+		data.Attachments,
+	} {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// readDescriptionSource reads a description source file and returns its
+// content and hash. Errors name the resolved path, since relative paths are
+// resolved against Terraform's working directory.
+func readDescriptionSource(name string) (string, string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		resolved, absErr := filepath.Abs(name)
+		if absErr != nil {
+			resolved = name
+		}
+		return "", "", fmt.Errorf("could not read %s (resolved to %s): %w", name, resolved, err)
+	}
+
+	sum := sha256.Sum256(content)
+	return string(content), "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// sourcedDescription converts the description source file to rich text,
+// refusing content that changed since the plan was made.
+func sourcedDescription(data IssueResourceModel, description descriptionText) (interface{}, error) {
+	content, sum, err := readDescriptionSource(data.DescriptionSourceFile.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	if !data.DescriptionSourceHash.IsUnknown() && sum != data.DescriptionSourceHash.ValueString() {
+		return nil, fmt.Errorf("%s changed after the plan was created; run plan again", data.DescriptionSourceFile.ValueString())
+	}
+
+	return description.client.FormatMarkdown(description.links.expand(content)), nil
+}
+
+// waitForStatus polls an issue's status until it matches wait or the wait
+// times out. Cancellation of ctx (including Terraform's own deadline) stops
+// polling early. The last seen status is returned alongside any error.
+func (r *IssueResource) waitForStatus(ctx context.Context, key string, wait IssueWaitForModel) (*client.Status, error) {
+	timeout, err := time.ParseDuration(wait.Timeout.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait_for timeout: %w", err)
+	}
+	interval, err := time.ParseDuration(wait.PollInterval.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait_for poll_interval: %w", err)
+	}
+
+	want := wait.Status.ValueString()
+	matches := func(s *client.Status) bool { return s.Name == want }
+	if !wait.StatusCategory.IsNull() {
+		want = wait.StatusCategory.ValueString()
+		matches = func(s *client.Status) bool { return s.Category() == want }
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last *client.Status
+	for {
+		status, err := r.client.GetIssueStatus(ctx, key)
+		if err != nil {
+			return last, fmt.Errorf("failed to read status of issue %s: %w", key, err)
+		}
+		last = status
+
+		tflog.Debug(ctx, "Polled Jira issue status", map[string]any{
+			"key":    key,
+			"status": status.Name,
+			"want":   want,
+		})
+
+		if matches(status) {
+			return last, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return last, fmt.Errorf("timed out after %s waiting for issue %s to reach %q; last seen status was %q", timeout, key, want, last.Name)
+			}
+			return last, fmt.Errorf("stopped waiting for issue %s to reach %q: %w; last seen status was %q", key, want, ctx.Err(), last.Name)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// issueTypeIconURL returns the icon URL of an issue type, or null when Jira
+// didn't report one.
+func issueTypeIconURL(issueType *client.IssueType) types.String {
+	if issueType == nil {
+		return types.StringNull()
+	}
+	return stringOrNull(issueType.IconURL)
+}
+
+// priorityStyle returns the icon URL and color of a priority, or nulls when
+// the issue has no priority.
+func priorityStyle(priority *client.Priority) (types.String, types.String) {
+	if priority == nil {
+		return types.StringNull(), types.StringNull()
+	}
+	return stringOrNull(priority.IconURL), stringOrNull(priority.StatusColor)
+}
+
+// parentDetails returns the summary and status Jira embeds for an issue's
+// parent, or nulls when the issue has none. Parents linked only through the
+// legacy Epic Link field carry no details.
+func parentDetails(parent *client.Parent) (types.String, types.String) {
+	if parent == nil || parent.Fields == nil {
+		return types.StringNull(), types.StringNull()
+	}
+
+	status := types.StringNull()
+	if parent.Fields.Status != nil {
+		status = stringOrNull(parent.Fields.Status.Name)
+	}
+	return stringOrNull(parent.Fields.Summary), status
+}
+
+// nameOrID returns the ID when the configured value is an ID and the name
+// otherwise, so switching either form in configuration doesn't read as drift.
+func nameOrID(configured types.String, id, name string) types.String {
+	if client.IsID(configured.ValueString()) {
+		return types.StringValue(id)
+	}
+	return types.StringValue(name)
+}
+
+// stringOrNull returns a string value, or null for the empty string.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// userAccountID returns the account ID of a user, or null when unset. Jira
+// Server and Data Center identify users by username instead.
+func userAccountID(user *client.User) types.String {
+	if user == nil {
+		return types.StringNull()
+	}
+	if user.AccountID == "" {
+		if user.Name == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(user.Name)
+	}
+	return types.StringValue(user.AccountID)
+}
@@ -0,0 +1,412 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// emptyPrivateState is private state with no keys set.
+type emptyPrivateState struct{}
+
+func (emptyPrivateState) GetKey(context.Context, string) ([]byte, diag.Diagnostics) {
+	return nil, nil
+}
+
+func TestIssueUpdateClearsRemovedFields(t *testing.T) {
+	c, err := client.NewJiraClient("https://example.atlassian.net", "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	description := descriptionText{client: c}
+
+	withDescription := func(m IssueResourceModel) IssueResourceModel {
+		m.Description = types.StringValue("Steps to reproduce")
+		return m
+	}
+	applied := IssueResourceModel{
+		Summary:  types.StringValue("Broken login"),
+		Priority: types.StringValue("High"),
+		DueDate:  types.StringValue("2026-11-01"),
+		Assignee: types.StringValue("5b10ac8d82e05b22cc7d4ef5"),
+	}
+	unset := IssueResourceModel{Summary: types.StringValue("Broken login")}
+
+	tests := []struct {
+		name  string
+		plan  IssueResourceModel
+		state IssueResourceModel
+		want  string
+	}{
+		{
+			name:  "removed",
+			plan:  unset,
+			state: withDescription(applied),
+			want:  `{"summary":"Broken login","description":null,"priority":null,"duedate":null,"assignee":null}`,
+		},
+		{
+			name:  "never set",
+			plan:  unset,
+			state: unset,
+			want:  `{"summary":"Broken login"}`,
+		},
+		{
+			name: "description moved to a source file",
+			plan: func() IssueResourceModel {
+				m := unset
+				m.DescriptionSourceFile = types.StringValue("README.md")
+				return m
+			}(),
+			state: withDescription(unset),
+			want:  `{"summary":"Broken login"}`,
+		},
+		{
+			name: "source file removed",
+			plan: unset,
+			state: func() IssueResourceModel {
+				m := withDescription(unset)
+				m.DescriptionSourceFile = types.StringValue("README.md")
+				return m
+			}(),
+			want: `{"summary":"Broken login","description":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := client.IssueFields{
+				Summary: tt.plan.Summary.ValueString(),
+				Clear:   clearedIssueFields(tt.plan, tt.state),
+			}
+			diags := description.setForUpdate(context.Background(), emptyPrivateState{}, &fields, tt.plan.Description, tt.plan.DescriptionFormat, issueHadDescription(tt.plan, tt.state))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			got, err := json.Marshal(fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotFields, wantFields map[string]interface{}
+			if err := json.Unmarshal(got, &gotFields); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantFields); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotFields, wantFields) {
+				t.Errorf("update fields = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueReadNeeded(t *testing.T) {
+	known := IssueResourceModel{
+		Status:      types.StringValue("In Progress"),
+		Attachments: types.ListNull(issueAttachmentType),
+	}
+	if issueReadNeeded(known) {
+		t.Error("issueReadNeeded() = true with every computed value known")
+	}
+
+	unknownStatus := known
+	unknownStatus.Status = types.StringUnknown()
+	if !issueReadNeeded(unknownStatus) {
+		t.Error("issueReadNeeded() = false with status unknown")
+	}
+
+	unknownAttachments := known
+	unknownAttachments.Attachments = types.ListUnknown(issueAttachmentType)
+	if !issueReadNeeded(unknownAttachments) {
+		t.Error("issueReadNeeded() = false with attachments unknown")
+	}
+}
+
+// issueUpdateServer is a fake Jira for updating PROJ-1. It counts the reads
+// of the issue itself and keeps the bodies of its updates.
+type issueUpdateServer struct {
+	reads       int
+	transitions int
+	updates     []string
+}
+
+func newIssueUpdateServer(t *testing.T) (*client.JiraClient, *issueUpdateServer) {
+	t.Helper()
+	counts := &issueUpdateServer{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch route := r.Method + " " + r.URL.Path; route {
+		case "GET /rest/api/3/issue/PROJ-1":
+			counts.reads++
+			_, _ = w.Write([]byte(`{"id":"10001","key":"PROJ-1","fields":{"summary":"Renamed","status":{"name":"In Progress"}}}`))
+		case "PUT /rest/api/3/issue/PROJ-1":
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Error(err)
+			}
+			counts.updates = append(counts.updates, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /rest/api/3/issue/PROJ-1/transitions":
+			counts.transitions++
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"customfield_10015","name":"Start date","custom":true,"schema":{"type":"date"}}]`))
+		case "GET /rest/api/3/issue/PROJ-1/transitions":
+			_, _ = w.Write([]byte(`{"transitions":[{"id":"31","name":"Finish","to":{"name":"Done"}}]}`))
+		case "GET /rest/agile/1.0/board":
+			_, _ = w.Write([]byte(`{"values":[],"isLast":true}`))
+		default:
+			t.Errorf("unexpected request %s", route)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Retry.MaxAttempts = 1
+	return c, counts
+}
+
+// appliedIssue holds the attributes of PROJ-1 as last applied.
+var appliedIssue = map[string]attr.Value{
+	"id":         types.StringValue("10001"),
+	"key":        types.StringValue("PROJ-1"),
+	"project":    types.StringValue("PROJ"),
+	"summary":    types.StringValue("Summary"),
+	"issue_type": types.StringValue("Task"),
+	"status":     types.StringValue("In Progress"),
+}
+
+// issueValues returns an object of the issue schema with the given
+// attributes set and all others null.
+func issueValues(t *testing.T, attributes map[string]attr.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&IssueResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nulls := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nulls)}
+	for name, value := range attributes {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+	return plan
+}
+
+// updateIssue runs r's Update from the applied attributes to the applied
+// attributes with change made, and returns the response.
+func updateIssue(t *testing.T, r *IssueResource, applied, change map[string]attr.Value) resource.UpdateResponse {
+	t.Helper()
+	planned := make(map[string]attr.Value, len(applied)+len(change))
+	for name, value := range applied {
+		planned[name] = value
+	}
+	for name, value := range change {
+		planned[name] = value
+	}
+	plan := issueValues(t, planned)
+
+	req := resource.UpdateRequest{Plan: plan, State: tfsdk.State(issueValues(t, applied)), Config: tfsdk.Config(plan)}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw.Copy()}}
+	// The framework's private state type is internal; its zero value is
+	// empty private state.
+	private := reflect.ValueOf(&resp).Elem().FieldByName("Private")
+	private.Set(reflect.New(private.Type().Elem()))
+
+	r.Update(context.Background(), req, &resp)
+	return resp
+}
+
+func TestIssueUpdateReads(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		change          map[string]attr.Value
+		wantReads       int
+		wantTransitions int
+		wantStatus      string
+	}{
+		{
+			name:       "every computed value known",
+			change:     map[string]attr.Value{"summary": types.StringValue("Renamed")},
+			wantStatus: "In Progress",
+		},
+		{
+			name:       "status unknown",
+			change:     map[string]attr.Value{"status": types.StringUnknown()},
+			wantReads:  1,
+			wantStatus: "In Progress",
+		},
+		{
+			name: "transition",
+			change: map[string]attr.Value{
+				"desired_status": types.StringValue("Done"),
+				"status":         types.StringUnknown(),
+			},
+			wantReads:       1,
+			wantTransitions: 1,
+			wantStatus:      "Done",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, counts := newIssueUpdateServer(t)
+			r := &IssueResource{client: c, description: descriptionText{client: c}}
+
+			resp := updateIssue(t, r, appliedIssue, tt.change)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			if counts.reads != tt.wantReads {
+				t.Errorf("issue reads = %d, want %d", counts.reads, tt.wantReads)
+			}
+			if counts.transitions != tt.wantTransitions {
+				t.Errorf("transitions = %d, want %d", counts.transitions, tt.wantTransitions)
+			}
+			var status types.String
+			if diags := resp.State.GetAttribute(context.Background(), path.Root("status"), &status); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if status.ValueString() != tt.wantStatus {
+				t.Errorf("status = %s, want %q", status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestKeepUnchangedComputed(t *testing.T) {
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&IssueResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := IssueResourceModel{
+		Priority:         types.StringValue("High"),
+		IssueType:        types.StringValue("Bug"),
+		ParentKey:        types.StringValue("PROJ-1"),
+		Status:           types.StringValue("In Progress"),
+		PriorityIconURL:  types.StringValue("https://example.atlassian.net/high.svg"),
+		PriorityColor:    types.StringValue("#ff5630"),
+		IssueTypeIconURL: types.StringValue("https://example.atlassian.net/bug.svg"),
+		ParentSummary:    types.StringValue("Epic"),
+		ParentStatus:     types.StringValue("To Do"),
+		Attachments:      types.ListNull(issueAttachmentType),
+	}
+	computed := []string{"status", "priority_icon_url", "priority_color", "issue_type_icon_url", "parent_summary", "parent_status"}
+
+	tests := []struct {
+		name    string
+		change  func(*IssueResourceModel)
+		changed []string
+	}{
+		{"nothing changed", func(*IssueResourceModel) {}, nil},
+		{"desired status already reached", func(m *IssueResourceModel) { m.DesiredStatus = types.StringValue("in progress") }, nil},
+		{"transition", func(m *IssueResourceModel) { m.DesiredStatus = types.StringValue("Done") }, []string{"status"}},
+		{"transition to unknown status", func(m *IssueResourceModel) { m.DesiredStatus = types.StringUnknown() }, []string{"status"}},
+		{"priority", func(m *IssueResourceModel) { m.Priority = types.StringValue("Low") }, []string{"priority_icon_url", "priority_color"}},
+		{"issue type", func(m *IssueResourceModel) { m.IssueType = types.StringValue("Task") }, []string{"issue_type_icon_url"}},
+		{"parent", func(m *IssueResourceModel) { m.ParentKey = types.StringNull() }, []string{"parent_summary", "parent_status"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			tt.change(&plan)
+
+			resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			keepUnchangedComputed(ctx, plan, state, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			for _, name := range computed {
+				var got types.String
+				if diags := resp.Plan.GetAttribute(ctx, path.Root(name), &got); diags.HasError() {
+					t.Fatal(diags)
+				}
+				wantPlanned := !slices.Contains(tt.changed, name)
+				if planned := !got.IsNull(); planned != wantPlanned {
+					t.Errorf("%s planned from state = %v, want %v", name, planned, wantPlanned)
+				}
+			}
+		})
+	}
+}
+
+var issueAttachmentType = types.ObjectType{AttrTypes: issueAttachmentAttrTypes}
+
+func TestCreatedAssignee(t *testing.T) {
+	c, err := client.NewJiraClient("https://example.atlassian.net", "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const ana, ben = "5b10ac8d82e05b22cc7d4ef5", "5b10a2844c20165700ede21g"
+	created := func(assignee string) *client.Issue {
+		issue := &client.Issue{Key: "PROJ-1"}
+		if assignee != "" {
+			issue.Fields.Assignee = &client.User{AccountID: assignee}
+		}
+		return issue
+	}
+	planned := func(assignee string) IssueResourceModel {
+		if assignee == "" {
+			return IssueResourceModel{Assignee: types.StringNull()}
+		}
+		return IssueResourceModel{Assignee: types.StringValue(assignee)}
+	}
+
+	tests := []struct {
+		name    string
+		plan    IssueResourceModel
+		created *client.Issue
+		sent    bool
+		want    *client.IssueFields
+	}{
+		{"unassigned", planned(""), created(""), false, nil},
+		{"project default assignee cleared", planned(""), created(ben), false, &client.IssueFields{Clear: []string{"assignee"}}},
+		{"assigned on create", planned(ana), created(ana), true, nil},
+		{"silent create assigns afterwards", planned(ana), created(""), false, &client.IssueFields{Assignee: &client.User{AccountID: ana}}},
+		{"silent create replaces project default", planned(ana), created(ben), false, &client.IssueFields{Assignee: &client.User{AccountID: ana}}},
+		{"adopted issue already assigned", planned(ana), created(ana), false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createdAssignee(c, tt.plan, tt.created, tt.sent); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("createdAssignee() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
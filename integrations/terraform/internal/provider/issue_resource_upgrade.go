@@ -0,0 +1,77 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// issueSchemaVersion is the current jira_issue schema version.
+//
+// Version 1 changed labels from a list to a set.
+const issueSchemaVersion = 1
+
+var _ resource.ResourceWithUpgradeState = &IssueResource{}
+
+// UpgradeState migrates state written by earlier schema versions.
+func (r *IssueResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeIssueStateV0},
+	}
+}
+
+// upgradeIssueStateV0 converts labels from a list to a set. Lists and sets
+// share a JSON encoding, so the raw state carries over unchanged apart from
+// dropping duplicate labels, which a set can't hold.
+func upgradeIssueStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || req.RawState.JSON == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "jira_issue state is missing or not JSON encoded.")
+		return
+	}
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Failed to parse jira_issue state: "+err.Error())
+		return
+	}
+
+	if raw, ok := state["labels"]; ok && string(raw) != "null" {
+		var labels []string
+		if err := json.Unmarshal(raw, &labels); err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Failed to parse jira_issue labels: "+err.Error())
+			return
+		}
+
+		seen := make(map[string]bool, len(labels))
+		unique := make([]string, 0, len(labels))
+		for _, label := range labels {
+			if !seen[label] {
+				seen[label] = true
+				unique = append(unique, label)
+			}
+		}
+
+		encoded, err := json.Marshal(unique)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Failed to encode jira_issue labels: "+err.Error())
+			return
+		}
+		state["labels"] = encoded
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", "Failed to encode jira_issue state: "+err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Upgraded jira_issue state from schema version 0")
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// startDatePattern matches start_date values: calendar dates in YYYY-MM-DD
+// format, the format Jira's date fields take.
+var startDatePattern = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01])$`)
+
+// startDateField returns the start date field for writing start_date. Jira
+// rejects unknown fields with a bare 400, so a missing field is reported
+// against start_date instead.
+func (r *IssueResource) startDateField(ctx context.Context, diags *diag.Diagnostics) string {
+	field, err := r.client.StartDateFieldID(ctx)
+	if err != nil {
+		diags.AddError("Failed to look up start date field", err.Error())
+		return ""
+	}
+	if field == "" {
+		diags.AddAttributeError(
+			path.Root("start_date"),
+			"Start Date Not Available",
+			fmt.Sprintf("This Jira site has no %q date field. Enable Advanced Roadmaps or add the field, "+
+				"set start_date_field_id on the provider, or remove start_date from the configuration.", client.StartDateFieldName),
+		)
+	}
+	return field
+}
+
+// readStartDate returns an issue's start date. An empty field reads as null;
+// a field missing from the site also reads as null but warns when start_date
+// was managed, since the value can no longer be tracked.
+func (r *IssueResource) readStartDate(ctx context.Context, issue *client.Issue, prior types.String, diags *diag.Diagnostics) types.String {
+	field, err := r.client.StartDateFieldID(ctx)
+	if err != nil {
+		diags.AddError("Failed to look up start date field", err.Error())
+		return prior
+	}
+
+	if field == "" {
+		if !prior.IsNull() {
+			diags.AddAttributeWarning(
+				path.Root("start_date"),
+				"Start Date Field Missing",
+				fmt.Sprintf("%s's start date can't be read because this Jira site no longer has a %q date field. "+
+					"Set start_date_field_id on the provider if the field was renamed.", issue.Key, client.StartDateFieldName),
+			)
+		}
+		return types.StringNull()
+	}
+
+	value, _ := issue.Fields.CustomString(field)
+	return stringOrNull(value)
+}
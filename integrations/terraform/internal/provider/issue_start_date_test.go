@@ -0,0 +1,210 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// newStartDateResource returns an issue resource whose site has the given
+// fields, and a pointer to the number of times they were listed.
+func newStartDateResource(t *testing.T, fields string) (*IssueResource, *int) {
+	t.Helper()
+	lookups := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/field" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		lookups++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fields))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &IssueResource{client: c}, &lookups
+}
+
+// issueWithFields returns PROJ-1 with the given custom field values.
+func issueWithFields(t *testing.T, custom string) *client.Issue {
+	t.Helper()
+	var issue client.Issue
+	if err := json.Unmarshal([]byte(`{"key":"PROJ-1","fields":`+custom+`}`), &issue); err != nil {
+		t.Fatal(err)
+	}
+	return &issue
+}
+
+const startDateFields = `[{"id":"customfield_10015","name":"Start date","custom":true,"schema":{"type":"date"}}]`
+
+func TestReadStartDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      string
+		issue       string
+		prior       types.String
+		want        types.String
+		wantWarning bool
+	}{
+		{
+			name:   "set",
+			fields: startDateFields,
+			issue:  `{"customfield_10015":"2024-03-01"}`,
+			prior:  types.StringValue("2024-02-01"),
+			want:   types.StringValue("2024-03-01"),
+		},
+		{
+			name:   "empty field",
+			fields: startDateFields,
+			issue:  `{"customfield_10015":null}`,
+			prior:  types.StringValue("2024-02-01"),
+			want:   types.StringNull(),
+		},
+		{
+			name:        "field missing while managed",
+			fields:      `[{"id":"customfield_10016","name":"Story Points","custom":true,"schema":{"type":"number"}}]`,
+			issue:       `{}`,
+			prior:       types.StringValue("2024-02-01"),
+			want:        types.StringNull(),
+			wantWarning: true,
+		},
+		{
+			name:   "field missing while unmanaged",
+			fields: `[]`,
+			issue:  `{}`,
+			prior:  types.StringNull(),
+			want:   types.StringNull(),
+		},
+		{
+			// A text field named Start date isn't the roadmap field.
+			name:        "field of another type",
+			fields:      `[{"id":"customfield_10020","name":"Start date","custom":true,"schema":{"type":"string"}}]`,
+			issue:       `{"customfield_10020":"soon"}`,
+			prior:       types.StringValue("2024-02-01"),
+			want:        types.StringNull(),
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newStartDateResource(t, tt.fields)
+
+			var diags diag.Diagnostics
+			got := r.readStartDate(context.Background(), issueWithFields(t, tt.issue), tt.prior, &diags)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("readStartDate() = %s, want %s", got, tt.want)
+			}
+			if warned := diags.WarningsCount() > 0; warned != tt.wantWarning {
+				t.Errorf("warned = %v, want %v: %v", warned, tt.wantWarning, diags)
+			}
+		})
+	}
+}
+
+func TestStartDateFieldOverride(t *testing.T) {
+	// The override is used as is, without listing the site's fields.
+	r, lookups := newStartDateResource(t, startDateFields)
+	r.client.StartDateField = "customfield_20000"
+
+	var diags diag.Diagnostics
+	got := r.readStartDate(context.Background(), issueWithFields(t, `{"customfield_10015":"2024-01-01","customfield_20000":"2024-05-06"}`), types.StringNull(), &diags)
+	if len(diags) != 0 {
+		t.Fatal(diags)
+	}
+	if want := types.StringValue("2024-05-06"); !got.Equal(want) {
+		t.Errorf("readStartDate() = %s, want %s", got, want)
+	}
+	if field := r.startDateField(context.Background(), &diags); field != "customfield_20000" || diags.HasError() {
+		t.Errorf("startDateField() = %q, %v, want the override", field, diags)
+	}
+	if *lookups != 0 {
+		t.Errorf("fields listed %d times, want none", *lookups)
+	}
+}
+
+func TestStartDateFieldMissing(t *testing.T) {
+	r, _ := newStartDateResource(t, `[]`)
+
+	var diags diag.Diagnostics
+	if field := r.startDateField(context.Background(), &diags); field != "" {
+		t.Errorf("startDateField() = %q, want none", field)
+	}
+	paths := diagnosticPaths(diags)["Start Date Not Available"]
+	if len(paths) != 1 || !paths[0].Equal(path.Root("start_date")) {
+		t.Errorf("diagnostics = %v, want an error on start_date", diags)
+	}
+}
+
+func TestStartDateValidation(t *testing.T) {
+	var schemaResp resource.SchemaResponse
+	(&IssueResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	validators := schemaResp.Schema.Attributes["start_date"].(schema.StringAttribute).Validators
+
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"2024-01-31", true},
+		{"2024-12-01", true},
+		{"2024-1-31", false},
+		{"2024-13-01", false},
+		{"2024-01-32", false},
+		{"01/31/2024", false},
+		{"2024-01-31T00:00:00Z", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		var diags diag.Diagnostics
+		for _, v := range validators {
+			resp := validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("start_date"), ConfigValue: types.StringValue(tt.value)}, &resp)
+			diags.Append(resp.Diagnostics...)
+		}
+		if valid := !diags.HasError(); valid != tt.valid {
+			t.Errorf("start_date %q valid = %v, want %v", tt.value, valid, tt.valid)
+		}
+	}
+}
+
+func TestIssueUpdateClearsStartDate(t *testing.T) {
+	c, server := newIssueUpdateServer(t)
+	r := &IssueResource{client: c, description: descriptionText{client: c}}
+
+	applied := map[string]attr.Value{"start_date": types.StringValue("2024-01-15")}
+	for name, value := range appliedIssue {
+		applied[name] = value
+	}
+
+	resp := updateIssue(t, r, applied, map[string]attr.Value{"start_date": types.StringNull()})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if len(server.updates) != 1 || !strings.Contains(server.updates[0], `"customfield_10015":null`) {
+		t.Errorf("updates = %v, want one clearing customfield_10015", server.updates)
+	}
+}
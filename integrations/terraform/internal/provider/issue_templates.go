@@ -0,0 +1,95 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IssueTemplateModel describes one entry of the provider's issue_templates
+// attribute.
+type IssueTemplateModel struct {
+	Description types.String `tfsdk:"description"`
+	Labels      types.Set    `tfsdk:"labels"`
+}
+
+// issueTemplates holds the default content for new issues, keyed by the
+// lowercased issue type name. A nil issueTemplates has no templates.
+type issueTemplates map[string]IssueTemplateModel
+
+// newIssueTemplates indexes the provider's issue_templates. It returns nil
+// when no templates are configured.
+func newIssueTemplates(models map[string]IssueTemplateModel) issueTemplates {
+	if len(models) == 0 {
+		return nil
+	}
+
+	templates := make(issueTemplates, len(models))
+	for issueType, model := range models {
+		templates[strings.ToLower(issueType)] = model
+	}
+	return templates
+}
+
+// forType returns the template for an issue type name, matched
+// case-insensitively.
+func (t issueTemplates) forType(issueType string) (IssueTemplateModel, bool) {
+	template, ok := t[strings.ToLower(issueType)]
+	return template, ok
+}
+
+// planIssueTemplate decides where an issue's description and labels come
+// from when description is left unset. New issues start from the template
+// for their type; issues that started from a template keep whatever content
+// they have, so later edits in Jira aren't reverted; everything else plans
+// the configuration as written. Both attributes are computed only so the
+// template can be shown in the plan, so every path sets them explicitly.
+func (r *IssueResource) planIssueTemplate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, plan, state *IssueResourceModel) {
+	var config IssueResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Description = config.Description
+	plan.Labels = config.Labels
+	plan.TemplateApplied = types.BoolValue(false)
+
+	creating := req.State.Raw.IsNull() || len(resp.RequiresReplace) > 0
+	switch {
+	case !config.Description.IsNull() || !config.DescriptionSourceFile.IsNull():
+		// The issue sets its own description.
+	case !creating:
+		if state.TemplateApplied.ValueBool() {
+			plan.Description = state.Description
+			if config.Labels.IsNull() {
+				plan.Labels = state.Labels
+			}
+			plan.TemplateApplied = types.BoolValue(true)
+		}
+	case config.IssueType.IsUnknown():
+		plan.Description = types.StringUnknown()
+		if config.Labels.IsNull() {
+			plan.Labels = types.SetUnknown(types.StringType)
+		}
+		plan.TemplateApplied = types.BoolUnknown()
+	default:
+		if template, ok := r.templates.forType(config.IssueType.ValueString()); ok {
+			plan.Description = template.Description
+			if config.Labels.IsNull() {
+				plan.Labels = template.Labels
+			}
+			plan.TemplateApplied = types.BoolValue(true)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("description"), plan.Description)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("labels"), plan.Labels)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("template_applied"), plan.TemplateApplied)...)
+}
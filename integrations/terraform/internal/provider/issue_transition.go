@@ -0,0 +1,107 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// transitionToStatus moves an issue from its current status to desired,
+// passing through each status in via first. Hops up to and including the
+// current status are skipped, so a path interrupted by a failed apply
+// resumes where it stopped. It returns the status the issue ends up in,
+// which is also meaningful alongside an error.
+func transitionToStatus(ctx context.Context, c *client.JiraClient, key, current string, via []string, desired string) (string, error) {
+	if strings.EqualFold(current, desired) {
+		return current, nil
+	}
+
+	hops := append(append([]string{}, via...), desired)
+	for i, hop := range hops {
+		if strings.EqualFold(hop, current) {
+			hops = hops[i+1:]
+			break
+		}
+	}
+
+	for _, hop := range hops {
+		transitions, err := c.GetTransitions(ctx, key)
+		if err != nil {
+			return current, fmt.Errorf("failed to read transitions of issue %s: %w", key, err)
+		}
+
+		transition := findTransition(transitions, hop)
+		if transition == nil {
+			return current, fmt.Errorf("no transition leads from %q to %q for issue %s; available target statuses: %s",
+				current, hop, key, transitionTargets(transitions))
+		}
+
+		tflog.Debug(ctx, "Transitioning Jira issue", map[string]any{
+			"key":        key,
+			"transition": transition.Name,
+			"from":       current,
+			"to":         transition.To.Name,
+		})
+
+		if err := c.TransitionIssue(ctx, key, transition.ID); err != nil {
+			return current, fmt.Errorf("failed to transition issue %s to %q: %w", key, transition.To.Name, err)
+		}
+		current = transition.To.Name
+	}
+
+	return current, nil
+}
+
+// applyDesiredStatus transitions the issue in data to its desired_status,
+// if one is configured, and records the resulting status.
+func (r *IssueResource) applyDesiredStatus(ctx context.Context, data *IssueResourceModel) error {
+	if data.DesiredStatus.IsNull() {
+		return nil
+	}
+
+	var via []string
+	if !data.TransitionPath.IsNull() {
+		if diags := data.TransitionPath.ElementsAs(ctx, &via, false); diags.HasError() {
+			return fmt.Errorf("failed to read transition_path")
+		}
+	}
+
+	status, err := transitionToStatus(ctx, r.client, data.Key.ValueString(), data.Status.ValueString(), via, data.DesiredStatus.ValueString())
+	data.Status = types.StringValue(status)
+	return err
+}
+
+// findTransition returns the transition leading to the named status, or nil.
+func findTransition(transitions []client.Transition, status string) *client.Transition {
+	for i, transition := range transitions {
+		if strings.EqualFold(transition.To.Name, status) {
+			return &transitions[i]
+		}
+	}
+	return nil
+}
+
+// transitionTargets lists the distinct statuses the transitions lead to.
+func transitionTargets(transitions []client.Transition) string {
+	seen := make(map[string]bool, len(transitions))
+	var targets []string
+	for _, transition := range transitions {
+		if !seen[transition.To.Name] {
+			seen[transition.To.Name] = true
+			targets = append(targets, fmt.Sprintf("%q", transition.To.Name))
+		}
+	}
+	if len(targets) == 0 {
+		return "none"
+	}
+	sort.Strings(targets)
+	return strings.Join(targets, ", ")
+}
@@ -0,0 +1,164 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssueTypesDataSource{}
+
+// NewIssueTypesDataSource creates a new issue types data source.
+func NewIssueTypesDataSource() datasource.DataSource {
+	return &IssueTypesDataSource{}
+}
+
+// IssueTypesDataSource defines the data source implementation.
+type IssueTypesDataSource struct {
+	client *client.JiraClient
+}
+
+// IssueTypesDataSourceModel describes the data source data model.
+type IssueTypesDataSourceModel struct {
+	Project    types.String     `tfsdk:"project"`
+	IssueTypes []IssueTypeModel `tfsdk:"issue_types"`
+}
+
+// IssueTypeModel describes a single issue type.
+type IssueTypeModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	IconURL        types.String `tfsdk:"icon_url"`
+	Subtask        types.Bool   `tfsdk:"subtask"`
+	HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
+}
+
+// Metadata returns the data source type name.
+func (d *IssueTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_types"
+}
+
+// Schema defines the schema for the data source.
+func (d *IssueTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Jira issue types, optionally only those used by a project." + scopesNote("data.jira_issue_types"),
+		MarkdownDescription: `
+Lists the Jira issue types available to the user, or only those used by a project when
+` + "`project`" + ` is set.
+
+` + "`hierarchy_level`" + ` is -1 for subtasks, 0 for standard issue types, 1 for epics, and 2 or
+higher for custom levels above epics.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_issue_types" "proj" {
+  project = "PROJ"
+}
+
+output "issue_type_icons" {
+  value = { for t in data.jira_issue_types.proj.issue_types : t.name => t.icon_url }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "Only list issue types used by this project key.",
+				Optional:    true,
+			},
+			"issue_types": schema.ListNestedAttribute{
+				Description: "The issue types, in the order Jira returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The issue type ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The issue type name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The issue type description, or null when unset.",
+							Computed:    true,
+						},
+						"icon_url": schema.StringAttribute{
+							Description: "URL of the issue type icon.",
+							Computed:    true,
+						},
+						"subtask": schema.BoolAttribute{
+							Description: "Whether issues of this type are subtasks.",
+							Computed:    true,
+						},
+						"hierarchy_level": schema.Int64Attribute{
+							Description: "The hierarchy level: -1 for subtasks, 0 for standard types, 1 for epics.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IssueTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IssueTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssueTypesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue types", map[string]any{
+		"project": data.Project.ValueString(),
+	})
+
+	issueTypes, err := d.client.GetIssueTypes(ctx, data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issue types", err.Error())
+		return
+	}
+
+	data.IssueTypes = make([]IssueTypeModel, 0, len(issueTypes))
+	for _, issueType := range issueTypes {
+		data.IssueTypes = append(data.IssueTypes, IssueTypeModel{
+			ID:             types.StringValue(issueType.ID),
+			Name:           types.StringValue(issueType.Name),
+			Description:    stringOrNull(issueType.Description),
+			IconURL:        stringOrNull(issueType.IconURL),
+			Subtask:        types.BoolValue(issueType.Subtask),
+			HierarchyLevel: types.Int64Value(int64(issueType.HierarchyLevel)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
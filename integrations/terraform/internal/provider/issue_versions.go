@@ -0,0 +1,137 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// versionResolver maps version names to a project's versions, listing the
+// versions at most once per operation.
+type versionResolver struct {
+	client   *client.JiraClient
+	project  string
+	versions []client.Version
+	loaded   bool
+}
+
+// resolve returns the versions named in names, which is attribute's value.
+// Unknown names are reported against attribute with the valid versions for
+// the project.
+func (v *versionResolver) resolve(ctx context.Context, attribute string, names types.Set) ([]client.Version, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if names.IsNull() || names.IsUnknown() || len(names.Elements()) == 0 {
+		return nil, diags
+	}
+
+	var wanted []string
+	diags.Append(names.ElementsAs(ctx, &wanted, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !v.loaded {
+		versions, err := v.client.GetProjectVersions(ctx, v.project)
+		if err != nil {
+			diags.AddAttributeError(path.Root(attribute), "Failed to read project versions", err.Error())
+			return nil, diags
+		}
+		v.versions, v.loaded = versions, true
+	}
+
+	byName := make(map[string]client.Version, len(v.versions))
+	for _, version := range v.versions {
+		byName[version.Name] = version
+	}
+
+	refs := make([]client.Version, 0, len(wanted))
+	for _, name := range wanted {
+		version, ok := byName[name]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Unknown Version",
+				fmt.Sprintf("Project %s has no version named %q. Valid versions: %s.", v.project, name, versionNames(v.versions)),
+			)
+			continue
+		}
+		refs = append(refs, client.Version{ID: version.ID})
+	}
+
+	return refs, diags
+}
+
+// versionNames lists version names for error messages.
+func versionNames(versions []client.Version) string {
+	if len(versions) == 0 {
+		return "none"
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, version := range versions {
+		names = append(names, fmt.Sprintf("%q", version.Name))
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// versionSet converts versions read from Jira to a set of names. Like
+// labels, a configured empty set is kept rather than flipped to null.
+func versionSet(ctx context.Context, versions []client.Version, prior types.Set) (types.Set, diag.Diagnostics) {
+	if len(versions) == 0 {
+		if !prior.IsNull() {
+			return types.SetValueMust(types.StringType, nil), nil
+		}
+		return types.SetNull(types.StringType), nil
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, version := range versions {
+		names = append(names, version.Name)
+	}
+	return types.SetValueFrom(ctx, types.StringType, names)
+}
+
+// setChanges returns the values planned but not in the prior state, and
+// those in the prior state but no longer planned, each sorted. An unknown
+// plan changes nothing.
+func setChanges(ctx context.Context, prior, planned types.Set, diags *diag.Diagnostics) (added, removed []string) {
+	if planned.IsUnknown() {
+		return nil, nil
+	}
+
+	var before, after []string
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &before, false)...)
+	}
+	if !planned.IsNull() {
+		diags.Append(planned.ElementsAs(ctx, &after, false)...)
+	}
+
+	had := make(map[string]bool, len(before))
+	for _, value := range before {
+		had[value] = true
+	}
+	for _, value := range after {
+		if had[value] {
+			delete(had, value)
+		} else {
+			added = append(added, value)
+		}
+	}
+	for value := range had {
+		removed = append(removed, value)
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,50 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSetChanges(t *testing.T) {
+	set := func(values ...string) types.Set {
+		return types.SetValueMust(types.StringType, stringValues(values))
+	}
+
+	tests := []struct {
+		name        string
+		prior       types.Set
+		planned     types.Set
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{"unchanged", set("a", "b"), set("b", "a"), nil, nil},
+		{"added and removed", set("a", "b"), set("b", "c", "d"), []string{"c", "d"}, []string{"a"}},
+		{"from null", types.SetNull(types.StringType), set("x"), []string{"x"}, nil},
+		{"to null", set("x", "y"), types.SetNull(types.StringType), nil, []string{"x", "y"}},
+		{"to empty", set("x"), set(), nil, []string{"x"}},
+		{"unknown plan", set("x"), types.SetUnknown(types.StringType), nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			added, removed := setChanges(context.Background(), tt.prior, tt.planned, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("added = %v, want %v", added, tt.wantAdded)
+			}
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("removed = %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssueWorklogsDataSource{}
+
+// NewIssueWorklogsDataSource creates a new issue worklogs data source.
+func NewIssueWorklogsDataSource() datasource.DataSource {
+	return &IssueWorklogsDataSource{}
+}
+
+// IssueWorklogsDataSource defines the data source implementation.
+type IssueWorklogsDataSource struct {
+	client *client.JiraClient
+}
+
+// IssueWorklogsDataSourceModel describes the data source data model.
+type IssueWorklogsDataSourceModel struct {
+	IssueKey       types.String        `tfsdk:"issue_key"`
+	ResolveAuthors types.Bool          `tfsdk:"resolve_authors"`
+	Worklogs       []IssueWorklogModel `tfsdk:"worklogs"`
+}
+
+// IssueWorklogModel describes a single worklog entry.
+type IssueWorklogModel struct {
+	ID                types.String `tfsdk:"id"`
+	AuthorAccountID   types.String `tfsdk:"author_account_id"`
+	AuthorDisplayName types.String `tfsdk:"author_display_name"`
+	Comment           types.String `tfsdk:"comment"`
+	Started           types.String `tfsdk:"started"`
+	TimeSpent         types.String `tfsdk:"time_spent"`
+	TimeSpentSeconds  types.Int64  `tfsdk:"time_spent_seconds"`
+}
+
+// Metadata returns the data source type name.
+func (d *IssueWorklogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issue_worklogs"
+}
+
+// Schema defines the schema for the data source.
+func (d *IssueWorklogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches all worklogs on a Jira issue." + scopesNote("data.jira_issue_worklogs"),
+		MarkdownDescription: `
+Fetches all worklogs on a Jira issue.
+
+Set ` + "`resolve_authors`" + ` to resolve author account IDs to display names. Authors are
+looked up in batches, so this costs one extra request per 90 distinct authors. Deleted
+users render as ` + "`Former user (<account id>)`" + `.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_issue_worklogs" "incident" {
+  issue_key       = "OPS-42"
+  resolve_authors = true
+}
+
+output "total_seconds" {
+  value = sum(data.jira_issue_worklogs.incident.worklogs[*].time_spent_seconds)
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"issue_key": schema.StringAttribute{
+				Description: "The Jira issue key (e.g., PROJ-123).",
+				Required:    true,
+			},
+			"resolve_authors": schema.BoolAttribute{
+				Description: "Resolve author account IDs to display names (costs extra API calls).",
+				Optional:    true,
+			},
+			"worklogs": schema.ListNestedAttribute{
+				Description: "The issue worklogs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The worklog ID.",
+							Computed:    true,
+						},
+						"author_account_id": schema.StringAttribute{
+							Description: "Account ID of the worklog author.",
+							Computed:    true,
+						},
+						"author_display_name": schema.StringAttribute{
+							Description: "Display name of the worklog author. Null unless resolve_authors is set.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The worklog comment (plain text).",
+							Computed:    true,
+						},
+						"started": schema.StringAttribute{
+							Description: "When the logged work started.",
+							Computed:    true,
+						},
+						"time_spent": schema.StringAttribute{
+							Description: "Time spent as a Jira duration (e.g., 3h 30m).",
+							Computed:    true,
+						},
+						"time_spent_seconds": schema.Int64Attribute{
+							Description: "Time spent in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IssueWorklogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IssueWorklogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssueWorklogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira issue worklogs", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+	})
+
+	worklogs, err := d.client.GetWorklogs(ctx, data.IssueKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read worklogs", err.Error())
+		return
+	}
+
+	var names map[string]string
+	if data.ResolveAuthors.ValueBool() {
+		authors := make([]string, 0, len(worklogs))
+		for _, worklog := range worklogs {
+			if worklog.Author != nil {
+				authors = append(authors, worklog.Author.AccountID)
+			}
+		}
+
+		names, err = d.client.ResolveDisplayNames(ctx, authors)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to resolve worklog authors", err.Error())
+			return
+		}
+	}
+
+	data.Worklogs = make([]IssueWorklogModel, 0, len(worklogs))
+	for _, worklog := range worklogs {
+		comment := types.StringNull()
+		if worklog.Comment != nil {
+			comment = types.StringValue(client.ADFToText(worklog.Comment))
+		}
+
+		data.Worklogs = append(data.Worklogs, IssueWorklogModel{
+			ID:                types.StringValue(worklog.ID),
+			AuthorAccountID:   userAccountID(worklog.Author),
+			AuthorDisplayName: authorDisplayName(names, worklog.Author),
+			Comment:           comment,
+			Started:           types.StringValue(worklog.Started),
+			TimeSpent:         types.StringValue(worklog.TimeSpent),
+			TimeSpentSeconds:  types.Int64Value(worklog.TimeSpentSeconds),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
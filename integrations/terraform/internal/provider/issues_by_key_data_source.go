@@ -0,0 +1,192 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IssuesByKeyDataSource{}
+
+// NewIssuesByKeyDataSource creates a new issues by key data source.
+func NewIssuesByKeyDataSource() datasource.DataSource {
+	return &IssuesByKeyDataSource{}
+}
+
+// IssuesByKeyDataSource defines the data source implementation.
+type IssuesByKeyDataSource struct {
+	client *client.JiraClient
+}
+
+// IssuesByKeyDataSourceModel describes the data source data model.
+type IssuesByKeyDataSourceModel struct {
+	Keys          types.Set                       `tfsdk:"keys"`
+	FailOnMissing types.Bool                      `tfsdk:"fail_on_missing"`
+	Issues        map[string]IssueAttributesModel `tfsdk:"issues"`
+	MissingKeys   types.Set                       `tfsdk:"missing_keys"`
+}
+
+// Metadata returns the data source type name.
+func (d *IssuesByKeyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_issues_by_key"
+}
+
+// Schema defines the schema for the data source.
+func (d *IssuesByKeyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches several Jira issues by key with batched searches." + scopesNote("data.jira_issues_by_key"),
+		MarkdownDescription: `
+Fetches several Jira issues by key in one or a few ` + "`key in (...)`" + ` searches (up to 100 keys
+each), instead of one request per ` + "`jira_issue`" + ` data source. Each issue has the same
+attributes as the ` + "`jira_issue`" + ` data source.
+
+Keys that don't exist or aren't visible are listed in ` + "`missing_keys`" + `; set
+` + "`fail_on_missing`" + ` to fail the read instead.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_issues_by_key" "release" {
+  keys = ["PROJ-101", "PROJ-102", "PROJ-103"]
+}
+
+output "release_statuses" {
+  value = { for key, issue in data.jira_issues_by_key.release.issues : key => issue.status }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.SetAttribute{
+				Description: "Keys of the issues to fetch.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"fail_on_missing": schema.BoolAttribute{
+				Description: "Fail when any key doesn't exist or isn't visible, instead of listing it in missing_keys.",
+				Optional:    true,
+			},
+			"issues": schema.MapNestedAttribute{
+				Description: "The issues found, keyed by issue key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key":                 schema.StringAttribute{Description: "The issue key.", Computed: true},
+						"id":                  schema.StringAttribute{Description: "The issue ID.", Computed: true},
+						"project":             schema.StringAttribute{Description: "The project key.", Computed: true},
+						"summary":             schema.StringAttribute{Description: "The issue summary.", Computed: true},
+						"description":         schema.StringAttribute{Description: "The issue description (plain text).", Computed: true},
+						"issue_type":          schema.StringAttribute{Description: "The issue type.", Computed: true},
+						"status":              schema.StringAttribute{Description: "The issue status.", Computed: true},
+						"priority":            schema.StringAttribute{Description: "The issue priority.", Computed: true},
+						"parent_key":          schema.StringAttribute{Description: "Parent issue key, or null when the issue has no parent.", Computed: true},
+						"parent_summary":      schema.StringAttribute{Description: "Summary of the parent issue.", Computed: true},
+						"parent_status":       schema.StringAttribute{Description: "Status of the parent issue.", Computed: true},
+						"labels":              schema.SetAttribute{Description: "Issue labels.", Computed: true, ElementType: types.StringType},
+						"due_date":            schema.StringAttribute{Description: "Due date in YYYY-MM-DD format, or null when unset.", Computed: true},
+						"assignee":            schema.StringAttribute{Description: "Account ID of the assignee, or null when unassigned.", Computed: true},
+						"reporter":            schema.StringAttribute{Description: "Account ID of the issue reporter.", Computed: true},
+						"creator_account_id":  schema.StringAttribute{Description: "Account ID of the user that created the issue.", Computed: true},
+						"priority_icon_url":   schema.StringAttribute{Description: "URL of the priority icon.", Computed: true},
+						"priority_color":      schema.StringAttribute{Description: "Hex color Jira uses for the priority.", Computed: true},
+						"issue_type_icon_url": schema.StringAttribute{Description: "URL of the issue type icon.", Computed: true},
+					},
+				},
+			},
+			"missing_keys": schema.SetAttribute{
+				Description: "Requested keys that don't exist or aren't visible.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *IssuesByKeyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *IssuesByKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IssuesByKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []string
+	resp.Diagnostics.Append(data.Keys.ElementsAs(ctx, &keys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sort.Strings(keys)
+
+	tflog.Debug(ctx, "Reading Jira issues by key", map[string]any{
+		"count": len(keys),
+	})
+
+	issues, err := d.client.SearchIssuesByKey(ctx, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to search issues", err.Error())
+		return
+	}
+
+	data.Issues = make(map[string]IssueAttributesModel, len(issues))
+	for i := range issues {
+		attrs, diags := mapIssue(ctx, &issues[i])
+		resp.Diagnostics.Append(diags...)
+		data.Issues[issues[i].Key] = attrs
+	}
+
+	missing := []string{}
+	for _, key := range keys {
+		if _, ok := data.Issues[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 && data.FailOnMissing.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keys"),
+			"Issues Not Found",
+			fmt.Sprintf("%d issue(s) don't exist or aren't visible to the configured credentials: %s.", len(missing), strings.Join(missing, ", ")),
+		)
+		return
+	}
+
+	missingKeys, diags := types.SetValueFrom(ctx, types.StringType, missing)
+	resp.Diagnostics.Append(diags...)
+	data.MissingKeys = missingKeys
+
+	tflog.Info(ctx, "Read Jira issues by key", map[string]any{
+		"found":   len(data.Issues),
+		"missing": len(missing),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,178 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// keyManifestVersion is the schema_version written to key manifests.
+const keyManifestVersion = 1
+
+// keyManifestFileMu serializes manifest flushes within the process, since
+// several provider configurations may share one path. Flushes from other
+// provider processes are serialized by a lock on a file next to the
+// manifest; see lockKeyManifest.
+var keyManifestFileMu sync.Mutex
+
+// keyManifest records the issues created, updated and deleted during a run
+// so tools that don't read Terraform state can find them by key. Terraform
+// doesn't tell providers resource addresses, so entries are keyed by issue
+// key and note the resource type. Changes are merged into the file when the
+// provider exits. A nil manifest records nothing.
+type keyManifest struct {
+	path string
+
+	mu      sync.Mutex
+	changes map[string]*keyManifestEntry // nil entries are deletions
+}
+
+// keyManifestEntry describes one issue in the manifest.
+type keyManifestEntry struct {
+	Key          string `json:"key"`
+	URL          string `json:"url"`
+	ResourceType string `json:"resource_type"`
+	Project      string `json:"project,omitempty"`
+	Summary      string `json:"summary,omitempty"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+// keyManifestFile is the JSON layout of the manifest file.
+type keyManifestFile struct {
+	SchemaVersion int                         `json:"schema_version"`
+	Issues        map[string]keyManifestEntry `json:"issues"`
+}
+
+// newKeyManifest returns a manifest that is written to path on shutdown.
+func newKeyManifest(path string) *keyManifest {
+	m := &keyManifest{path: path, changes: make(map[string]*keyManifestEntry)}
+	onShutdown(m.flush)
+	return m
+}
+
+// record notes a successful create or update of an issue.
+func (m *keyManifest) record(entry keyManifestEntry) {
+	if m == nil {
+		return
+	}
+	entry.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changes[entry.Key] = &entry
+}
+
+// remove notes that an issue was deleted.
+func (m *keyManifest) remove(key string) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.changes[key] = nil
+}
+
+// flush merges the recorded changes into the manifest file, keeping entries
+// written by earlier runs. The file is replaced atomically so readers never
+// see a partial write, and an unreadable manifest is left alone rather than
+// overwritten.
+func (m *keyManifest) flush() error {
+	m.mu.Lock()
+	changes := m.changes
+	m.changes = make(map[string]*keyManifestEntry)
+	m.mu.Unlock()
+	if len(changes) == 0 {
+		return nil
+	}
+
+	keyManifestFileMu.Lock()
+	defer keyManifestFileMu.Unlock()
+
+	unlock, err := lockKeyManifest(m.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	manifest := keyManifestFile{Issues: make(map[string]keyManifestEntry)}
+	existing, err := os.ReadFile(m.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("failed to read key manifest %s: %w", m.path, err)
+	default:
+		if err := json.Unmarshal(existing, &manifest); err != nil {
+			return fmt.Errorf("key manifest %s is not valid JSON, leaving it unchanged: %w", m.path, err)
+		}
+		if manifest.Issues == nil {
+			manifest.Issues = make(map[string]keyManifestEntry)
+		}
+	}
+
+	for key, entry := range changes {
+		if entry == nil {
+			delete(manifest.Issues, key)
+			continue
+		}
+		manifest.Issues[key] = *entry
+	}
+	manifest.SchemaVersion = keyManifestVersion
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(m.path, append(data, '\n'))
+}
+
+// lockKeyManifest takes an exclusive lock shared with other processes
+// flushing the manifest at path, and returns the function releasing it. The
+// lock is held on a separate file, since the manifest itself is replaced on
+// every flush.
+func lockKeyManifest(path string) (func(), error) {
+	lockPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".lock")
+	f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open key manifest lock %s: %w", lockPath, err)
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to lock key manifest %s: %w", path, err)
+	}
+	return func() {
+		_ = unlockFile(f)
+		f.Close()
+	}, nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames
+// it into place.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
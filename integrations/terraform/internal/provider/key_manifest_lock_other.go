@@ -0,0 +1,19 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+//go:build !(darwin || dragonfly || freebsd || illumos || linux || netbsd || openbsd || windows)
+
+package provider
+
+import "os"
+
+// lockFile does nothing on platforms without flock; flushes are then only
+// serialized within the process.
+func lockFile(f *os.File) error {
+	return nil
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,26 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+//go:build darwin || dragonfly || freebsd || illumos || linux || netbsd || openbsd
+
+package provider
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile blocks until it holds an exclusive advisory lock on f.
+func lockFile(f *os.File) error {
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+		if err != syscall.EINTR {
+			return err
+		}
+	}
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// testKeyManifest returns a manifest for path that isn't flushed on
+// shutdown.
+func testKeyManifest(path string) *keyManifest {
+	return &keyManifest{path: path, changes: make(map[string]*keyManifestEntry)}
+}
+
+func readKeyManifest(t *testing.T, path string) keyManifestFile {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var manifest keyManifestFile
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatal(err)
+	}
+	return manifest
+}
+
+func manifestKeys(manifest keyManifestFile) []string {
+	keys := make([]string, 0, len(manifest.Issues))
+	for key := range manifest.Issues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestKeyManifestFlush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.json")
+
+	first := testKeyManifest(path)
+	first.record(keyManifestEntry{Key: "PROJ-1", URL: "https://example.atlassian.net/browse/PROJ-1", ResourceType: "jira_issue", Project: "PROJ"})
+	first.record(keyManifestEntry{Key: "PROJ-2", ResourceType: "jira_subtask"})
+	if err := first.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := readKeyManifest(t, path)
+	if manifest.SchemaVersion != keyManifestVersion {
+		t.Errorf("schema_version = %d, want %d", manifest.SchemaVersion, keyManifestVersion)
+	}
+	if got, want := manifestKeys(manifest), []string{"PROJ-1", "PROJ-2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("issues = %v, want %v", got, want)
+	}
+	if entry := manifest.Issues["PROJ-1"]; entry.ResourceType != "jira_issue" || entry.Project != "PROJ" || entry.UpdatedAt == "" {
+		t.Errorf("PROJ-1 entry = %+v", entry)
+	}
+
+	// A later run merges into the file: entries it didn't touch are kept.
+	second := testKeyManifest(path)
+	second.remove("PROJ-2")
+	second.record(keyManifestEntry{Key: "PROJ-3", ResourceType: "jira_issue"})
+	if err := second.flush(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := manifestKeys(readKeyManifest(t, path)), []string{"PROJ-1", "PROJ-3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("issues after the second run = %v, want %v", got, want)
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		if name := entry.Name(); name != "issues.json" && name != ".issues.json.lock" {
+			t.Errorf("temporary file %s left behind", name)
+		}
+	}
+}
+
+// recordKeyManifestKeys records keys PROJ-<first> through PROJ-<first+count-1>
+// in m, flushing after each one.
+func recordKeyManifestKeys(m *keyManifest, first, count int) error {
+	for i := first; i < first+count; i++ {
+		m.record(keyManifestEntry{Key: fmt.Sprintf("PROJ-%d", i), ResourceType: "jira_issue"})
+		if err := m.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wantKeyManifestKeys returns the keys PROJ-0 through PROJ-<count-1>, sorted.
+func wantKeyManifestKeys(count int) []string {
+	keys := make([]string, count)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestKeyManifestConcurrentFlush(t *testing.T) {
+	const writers, perWriter = 8, 10
+	path := filepath.Join(t.TempDir(), "issues.json")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(m *keyManifest, first int) {
+			defer wg.Done()
+			errs <- recordKeyManifestKeys(m, first, perWriter)
+		}(testKeyManifest(path), w*perWriter)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got, want := manifestKeys(readKeyManifest(t, path)), wantKeyManifestKeys(writers*perWriter); !reflect.DeepEqual(got, want) {
+		t.Errorf("issues = %v, want %v", got, want)
+	}
+}
+
+// TestKeyManifestFlushAcrossProcesses runs several copies of the test binary
+// flushing to one manifest, as separate provider processes sharing
+// key_manifest_path do, where keyManifestFileMu doesn't apply.
+func TestKeyManifestFlushAcrossProcesses(t *testing.T) {
+	if path := os.Getenv("KEY_MANIFEST_HELPER_PATH"); path != "" {
+		first, _ := strconv.Atoi(os.Getenv("KEY_MANIFEST_HELPER_FIRST"))
+		if err := recordKeyManifestKeys(testKeyManifest(path), first, 20); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	const processes = 4
+	path := filepath.Join(t.TempDir(), "issues.json")
+
+	var wg sync.WaitGroup
+	for p := 0; p < processes; p++ {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestKeyManifestFlushAcrossProcesses$")
+		cmd.Env = append(os.Environ(), "KEY_MANIFEST_HELPER_PATH="+path, "KEY_MANIFEST_HELPER_FIRST="+strconv.Itoa(p*20))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("helper process failed: %v\n%s", err, out)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := manifestKeys(readKeyManifest(t, path)), wantKeyManifestKeys(processes*20); !reflect.DeepEqual(got, want) {
+		t.Errorf("issues = %v, want %v", got, want)
+	}
+}
+
+func TestKeyManifestFlushWithoutChanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.json")
+	if err := testKeyManifest(path).flush(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("manifest written without changes: %v", err)
+	}
+
+	// A nil manifest records nothing.
+	var none *keyManifest
+	none.record(keyManifestEntry{Key: "PROJ-1"})
+	none.remove("PROJ-1")
+}
+
+func TestKeyManifestInvalidFileKept(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	m := testKeyManifest(path)
+	m.record(keyManifestEntry{Key: "PROJ-1"})
+	if err := m.flush(); err == nil {
+		t.Fatal("flush() over an invalid manifest succeeded")
+	}
+	if data, _ := os.ReadFile(path); string(data) != "not json" {
+		t.Errorf("invalid manifest overwritten with %q", data)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LinkRewriteRuleModel describes one entry of the provider's
+// link_rewrite_rules attribute.
+type LinkRewriteRuleModel struct {
+	Pattern     types.String `tfsdk:"pattern"`
+	Replacement types.String `tfsdk:"replacement"`
+}
+
+// linkRewriter expands short links in text sent to Jira, e.g. go/runbook to
+// a full URL, while state keeps the short form from configuration. A nil
+// *linkRewriter leaves text unchanged.
+type linkRewriter struct {
+	rules []linkRewriteRule
+}
+
+// linkRewriteRule is a compiled link_rewrite_rules entry.
+type linkRewriteRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// newLinkRewriter compiles the provider's link_rewrite_rules. It returns nil
+// when no rules are configured.
+func newLinkRewriter(models []LinkRewriteRuleModel) (*linkRewriter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(models) == 0 {
+		return nil, diags
+	}
+
+	rewriter := &linkRewriter{}
+	for i, model := range models {
+		pattern, err := regexp.Compile(model.Pattern.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("link_rewrite_rules").AtListIndex(i).AtName("pattern"),
+				"Invalid Link Rewrite Pattern",
+				err.Error(),
+			)
+			continue
+		}
+		rewriter.rules = append(rewriter.rules, linkRewriteRule{
+			pattern:     pattern,
+			replacement: model.Replacement.ValueString(),
+		})
+	}
+
+	return rewriter, diags
+}
+
+// expand applies every rule in order to text on its way to Jira. Later rules
+// see the output of earlier ones.
+func (w *linkRewriter) expand(text string) string {
+	if w == nil {
+		return text
+	}
+	for _, rule := range w.rules {
+		text = rule.pattern.ReplaceAllString(text, rule.replacement)
+	}
+	return text
+}
+
+// restore maps text read from Jira back to the form in state. Regex
+// replacements can't be inverted in general, and two rules may expand to the
+// same output, so rather than guessing which short link produced a URL the
+// prior value is kept whenever expanding it reproduces what Jira holds.
+// Anything else is real drift and is returned as Jira has it.
+func (w *linkRewriter) restore(remote string, prior types.String) types.String {
+	if w != nil && !prior.IsNull() && !prior.IsUnknown() && w.expand(prior.ValueString()) == remote {
+		return prior
+	}
+	return types.StringValue(remote)
+}
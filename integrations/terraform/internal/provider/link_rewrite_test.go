@@ -0,0 +1,100 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+func linkRule(pattern, replacement string) LinkRewriteRuleModel {
+	return LinkRewriteRuleModel{Pattern: types.StringValue(pattern), Replacement: types.StringValue(replacement)}
+}
+
+func mustLinkRewriter(t *testing.T, models ...LinkRewriteRuleModel) *linkRewriter {
+	t.Helper()
+	rewriter, diags := newLinkRewriter(models)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return rewriter
+}
+
+func TestNewLinkRewriter(t *testing.T) {
+	if rewriter, diags := newLinkRewriter(nil); rewriter != nil || diags.HasError() {
+		t.Errorf("newLinkRewriter(nil) = %v, %v, want nil", rewriter, diags)
+	}
+
+	_, diags := newLinkRewriter([]LinkRewriteRuleModel{
+		linkRule(`go/(\w+)`, "https://go.example.com/$1"),
+		linkRule(`(unclosed`, "x"),
+	})
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("diagnostics = %v, want one error", diags)
+	}
+	want := path.Root("link_rewrite_rules").AtListIndex(1).AtName("pattern")
+	if got := diags[0].(interface{ Path() path.Path }).Path(); !got.Equal(want) {
+		t.Errorf("error path = %s, want %s", got, want)
+	}
+}
+
+func TestLinkRewriterExpand(t *testing.T) {
+	rewriter := mustLinkRewriter(t,
+		linkRule(`\bgo/([\w-]+)`, "https://go.example.com/$1"),
+		linkRule(`https://go\.example\.com/wiki`, "https://wiki.example.com"),
+	)
+
+	tests := map[string]string{
+		"See go/runbook-7 first": "See https://go.example.com/runbook-7 first",
+		"go/wiki":                "https://wiki.example.com",
+		"no links":               "no links",
+		"ergo/not-a-link":        "ergo/not-a-link",
+	}
+	for text, want := range tests {
+		if got := rewriter.expand(text); got != want {
+			t.Errorf("expand(%q) = %q, want %q", text, got, want)
+		}
+	}
+
+	var none *linkRewriter
+	if got := none.expand("go/x"); got != "go/x" {
+		t.Errorf("nil rewriter expand() = %q, want the text unchanged", got)
+	}
+}
+
+func TestLinkRewriterRestore(t *testing.T) {
+	rewriter := mustLinkRewriter(t, linkRule(`go/(\w+)`, "https://go.example.com/$1"))
+
+	tests := []struct {
+		name   string
+		remote string
+		prior  types.String
+		want   types.String
+	}{
+		{"keeps short form", "See https://go.example.com/runbook", types.StringValue("See go/runbook"), types.StringValue("See go/runbook")},
+		{"expanded in config", "See https://go.example.com/runbook", types.StringValue("See https://go.example.com/runbook"), types.StringValue("See https://go.example.com/runbook")},
+		{"drift", "See https://go.example.com/other", types.StringValue("See go/runbook"), types.StringValue("See https://go.example.com/other")},
+		{"import", "See https://go.example.com/runbook", types.StringNull(), types.StringValue("See https://go.example.com/runbook")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rewriter.restore(tt.remote, tt.prior); !got.Equal(tt.want) {
+				t.Errorf("restore() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadDescriptionRestoresShortLinks(t *testing.T) {
+	rewriter := mustLinkRewriter(t, linkRule(`go/(\w+)`, "https://go.example.com/$1"))
+	prior := types.StringValue("Follow go/runbook")
+	remote := client.TextToADF("Follow https://go.example.com/runbook")
+
+	if got := readDescription(rewriter, remote, prior, types.StringValue(descriptionFormatPlain)); !got.Equal(prior) {
+		t.Errorf("readDescription() = %s, want %s", got, prior)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NextWeekdayFunction{}
+
+// NewNextWeekdayFunction creates a new next_weekday function.
+func NewNextWeekdayFunction() function.Function {
+	return &NextWeekdayFunction{}
+}
+
+// NextWeekdayFunction defines the function implementation.
+type NextWeekdayFunction struct{}
+
+// Metadata returns the function name.
+func (f *NextWeekdayFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_weekday"
+}
+
+// Definition defines the function's parameters and return type.
+func (f *NextWeekdayFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the next date falling on a given weekday.",
+		MarkdownDescription: `
+Returns the first date after ` + "`date`" + ` that falls on ` + "`weekday`" + `. A date that is already on
+that weekday moves a full week ahead. Weekdays are English names, full or abbreviated and in
+any case (` + "`friday`" + `, ` + "`Fri`" + `).
+
+Dates are calendar dates in YYYY-MM-DD format, the same format as ` + "`due_date`" + `, so the result
+doesn't depend on any time zone.
+
+` + "```hcl" + `
+locals {
+  # The retro happens on the Friday after the sprint starts.
+  retro_date = provider::jira::next_weekday(var.sprint_start, "friday")
+}
+` + "```" + `
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "date",
+				Description: "The start date, in YYYY-MM-DD format.",
+			},
+			function.StringParameter{
+				Name:        "weekday",
+				Description: "The weekday to find, e.g. monday or Mon.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the result.
+func (f *NextWeekdayFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dateArg, weekdayArg string
+	resp.Error = req.Arguments.Get(ctx, &dateArg, &weekdayArg)
+	if resp.Error != nil {
+		return
+	}
+
+	date, err := parseFunctionDate(dateArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	weekday, err := parseWeekday(weekdayArg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, nextWeekday(date, weekday).Format(dueDateLayout))
+}
+
+// nextWeekday returns the first date after date that falls on weekday.
+func nextWeekday(date time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday)-int(date.Weekday())+6)%7 + 1
+	return date.AddDate(0, 0, days)
+}
+
+// parseWeekday parses an English weekday name, full or abbreviated to its
+// first three letters, in any case.
+func parseWeekday(value string) (time.Weekday, error) {
+	name := strings.ToLower(strings.TrimSpace(value))
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("%q is not a weekday; use a name such as monday or mon", value)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNextWeekday(t *testing.T) {
+	tests := []struct {
+		name    string
+		date    string
+		weekday time.Weekday
+		want    string
+	}{
+		{"later this week", "2024-06-03", time.Friday, "2024-06-07"},
+		{"same weekday is a week later", "2024-06-07", time.Friday, "2024-06-14"},
+		{"next day", "2024-06-07", time.Saturday, "2024-06-08"},
+		{"over weekend", "2024-06-07", time.Monday, "2024-06-10"},
+		{"from sunday", "2024-06-09", time.Sunday, "2024-06-16"},
+		{"into new year", "2024-12-30", time.Friday, "2025-01-03"},
+		{"new year's eve", "2024-12-31", time.Tuesday, "2025-01-07"},
+		{"across month end", "2024-02-27", time.Saturday, "2024-03-02"},
+		{"leap day", "2024-02-22", time.Thursday, "2024-02-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextWeekday(mustFunctionDate(t, tt.date), tt.weekday).Format(dueDateLayout)
+			if got != tt.want {
+				t.Errorf("nextWeekday(%s, %s) = %s, want %s", tt.date, tt.weekday, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	tests := []struct {
+		value     string
+		want      time.Weekday
+		wantError bool
+	}{
+		{value: "monday", want: time.Monday},
+		{value: "Mon", want: time.Monday},
+		{value: " FRIDAY ", want: time.Friday},
+		{value: "sun", want: time.Sunday},
+		{value: "thurs", wantError: true},
+		{value: "mo", wantError: true},
+		{value: "", wantError: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseWeekday(tt.value)
+		if (err != nil) != tt.wantError || (!tt.wantError && got != tt.want) {
+			t.Errorf("parseWeekday(%q) = %s, %v, want %s (error %v)", tt.value, got, err, tt.want, tt.wantError)
+		}
+	}
+}
+
+func TestNextWeekdayFunctionRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		date      string
+		weekday   string
+		want      string
+		wantError string
+	}{
+		{name: "weekday", date: "2024-12-31", weekday: "Fri", want: "2025-01-03"},
+		{name: "invalid date", date: "2024-02-30", weekday: "friday", wantError: "not a valid date"},
+		{name: "invalid weekday", date: "2024-06-03", weekday: "someday", wantError: "is not a weekday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errText := runFunction(&NextWeekdayFunction{}, types.StringUnknown(), types.StringValue(tt.date), types.StringValue(tt.weekday))
+			if tt.wantError != "" {
+				if !strings.Contains(errText, tt.wantError) {
+					t.Fatalf("Run() error = %q, want one containing %q", errText, tt.wantError)
+				}
+				return
+			}
+			if errText != "" {
+				t.Fatalf("Run() error = %s", errText)
+			}
+			if got.(types.String).ValueString() != tt.want {
+				t.Errorf("Run() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
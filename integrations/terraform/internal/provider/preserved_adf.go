@@ -0,0 +1,71 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// preservedADFKey is the private state key holding the ADF blocks of a
+// description or comment body that plain text can't represent.
+const preservedADFKey = "preserved_adf"
+
+// privateStateReader is implemented by the private state of resource
+// requests.
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateStateWriter is implemented by the private state of resource
+// responses.
+type privateStateWriter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// preserveADF records the blocks of adf that ADFToText can't represent, such
+// as panels, media or node types newer than this provider, so a later write
+// of the text can put them back. Content without such blocks clears the key.
+func preserveADF(ctx context.Context, private privateStateWriter, adf interface{}) diag.Diagnostics {
+	blocks := client.PreservedADFBlocks(adf)
+	if len(blocks) == 0 {
+		return private.SetKey(ctx, preservedADFKey, nil)
+	}
+
+	value, err := json.Marshal(blocks)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Failed to preserve rich content", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, preservedADFKey, value)
+}
+
+// restoreADF splices blocks recorded by preserveADF back into a document
+// built from text. Plain-string documents (REST API version 2) are returned
+// unchanged.
+func restoreADF(ctx context.Context, private privateStateReader, text interface{}) (interface{}, diag.Diagnostics) {
+	doc, ok := text.(map[string]interface{})
+	if !ok {
+		return text, nil
+	}
+
+	value, diags := private.GetKey(ctx, preservedADFKey)
+	if diags.HasError() || len(value) == 0 {
+		return doc, diags
+	}
+
+	var blocks []client.ADFBlock
+	if err := json.Unmarshal(value, &blocks); err != nil {
+		diags.AddWarning(
+			"Rich Content Not Restored",
+			"Jira content that plain text can't represent could not be restored and will be dropped: "+err.Error(),
+		)
+		return doc, diags
+	}
+	return client.SpliceADFBlocks(doc, blocks), diags
+}
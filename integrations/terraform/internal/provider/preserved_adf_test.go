@@ -0,0 +1,92 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// mapPrivateState is private state held in a map.
+type mapPrivateState map[string][]byte
+
+func (m mapPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return m[key], nil
+}
+
+func (m mapPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(m, key)
+	} else {
+		m[key] = value
+	}
+	return nil
+}
+
+func parsedADF(t *testing.T, raw string) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+const panelDocument = `{"type":"doc","version":1,"content":[
+	{"type":"paragraph","content":[{"type":"text","text":"Intro"}]},
+	{"type":"panel","attrs":{"panelType":"info"},"content":[{"type":"paragraph","content":[{"type":"text","text":"Note"}]}]}
+]}`
+
+func TestPreserveAndRestoreADF(t *testing.T) {
+	ctx := context.Background()
+	private := mapPrivateState{}
+	remote := parsedADF(t, panelDocument)
+
+	if diags := preserveADF(ctx, private, remote); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(private[preservedADFKey]) == 0 {
+		t.Fatal("panel was not preserved")
+	}
+
+	restored, diags := restoreADF(ctx, private, client.TextToADF(client.ADFToText(remote)))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	content := restored.(map[string]interface{})["content"].([]interface{})
+	if len(content) != 2 || content[1].(map[string]interface{})["type"] != "panel" {
+		t.Errorf("restored content = %v, want the paragraph and the panel", content)
+	}
+
+	// Text-only content has nothing to preserve and clears the key.
+	if diags := preserveADF(ctx, private, client.TextToADF("Intro")); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if _, ok := private[preservedADFKey]; ok {
+		t.Error("preserved blocks kept after a text-only read")
+	}
+}
+
+func TestRestoreADFPassesThrough(t *testing.T) {
+	ctx := context.Background()
+	private := mapPrivateState{preservedADFKey: []byte(`[{"text":"Note","index":1,"node":{"type":"panel"}}]`)}
+
+	if got, diags := restoreADF(ctx, private, "v2 wiki text"); got != "v2 wiki text" || diags.HasError() {
+		t.Errorf("restoreADF(string) = %v, %v, want the string unchanged", got, diags)
+	}
+
+	private[preservedADFKey] = []byte(`not json`)
+	doc := client.TextToADF("Note")
+	got, diags := restoreADF(ctx, private, doc)
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Errorf("diagnostics = %v, want one warning", diags)
+	}
+	if content := got.(map[string]interface{})["content"]; len(content.([]map[string]interface{})) != 1 {
+		t.Errorf("restoreADF() with unreadable blocks changed the document: %v", got)
+	}
+}
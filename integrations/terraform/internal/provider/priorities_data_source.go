@@ -0,0 +1,152 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PrioritiesDataSource{}
+
+// NewPrioritiesDataSource creates a new priorities data source.
+func NewPrioritiesDataSource() datasource.DataSource {
+	return &PrioritiesDataSource{}
+}
+
+// PrioritiesDataSource defines the data source implementation.
+type PrioritiesDataSource struct {
+	client *client.JiraClient
+}
+
+// PrioritiesDataSourceModel describes the data source data model.
+type PrioritiesDataSourceModel struct {
+	Priorities []PriorityModel `tfsdk:"priorities"`
+}
+
+// PriorityModel describes a single priority.
+type PriorityModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Color       types.String `tfsdk:"color"`
+	IconURL     types.String `tfsdk:"icon_url"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+}
+
+// Metadata returns the data source type name.
+func (d *PrioritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_priorities"
+}
+
+// Schema defines the schema for the data source.
+func (d *PrioritiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the priorities of the Jira site." + scopesNote("data.jira_priorities"),
+		MarkdownDescription: `
+Lists the priorities of the Jira site in the site's order, usually highest first, with the
+default priority flagged. Priority names can repeat across priority schemes; the ID is unique.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_priorities" "all" {}
+
+locals {
+  priority_ids = { for p in data.jira_priorities.all.priorities : p.name => p.id }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"priorities": schema.ListNestedAttribute{
+				Description: "The priorities, in the order Jira returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The priority ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The priority name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The priority description, or null when unset.",
+							Computed:    true,
+						},
+						"color": schema.StringAttribute{
+							Description: "The priority color (hex).",
+							Computed:    true,
+						},
+						"icon_url": schema.StringAttribute{
+							Description: "URL of the priority icon.",
+							Computed:    true,
+						},
+						"is_default": schema.BoolAttribute{
+							Description: "Whether new issues get this priority when none is set.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *PrioritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *PrioritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PrioritiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira priorities")
+
+	priorities, err := d.client.GetPriorities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read priorities", err.Error())
+		return
+	}
+
+	data.Priorities = make([]PriorityModel, 0, len(priorities))
+	for _, priority := range priorities {
+		data.Priorities = append(data.Priorities, PriorityModel{
+			ID:          types.StringValue(priority.ID),
+			Name:        types.StringValue(priority.Name),
+			Description: stringOrNull(priority.Description),
+			Color:       stringOrNull(priority.StatusColor),
+			IconURL:     stringOrNull(priority.IconURL),
+			IsDefault:   types.BoolValue(priority.IsDefault),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
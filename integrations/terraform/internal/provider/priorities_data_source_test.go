@@ -0,0 +1,44 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPrioritiesDataSourceRead(t *testing.T) {
+	c := newJiraServer(t, map[string]string{
+		"/rest/api/3/priority/search": `{"startAt":0,"maxResults":50,"total":2,"values":[
+			{"id":"1","name":"High","description":"Fix soon","statusColor":"#ff7452","iconUrl":"https://example.atlassian.net/high.svg"},
+			{"id":"3","name":"Medium","isDefault":true}
+		]}`,
+	})
+
+	resp := readDataSource(t, &PrioritiesDataSource{client: c}, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var data PrioritiesDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := []PriorityModel{
+		{
+			ID: types.StringValue("1"), Name: types.StringValue("High"), Description: types.StringValue("Fix soon"),
+			Color: types.StringValue("#ff7452"), IconURL: types.StringValue("https://example.atlassian.net/high.svg"), IsDefault: types.BoolValue(false),
+		},
+		{
+			ID: types.StringValue("3"), Name: types.StringValue("Medium"), Description: types.StringNull(),
+			Color: types.StringNull(), IconURL: types.StringNull(), IsDefault: types.BoolValue(true),
+		},
+	}
+	if !reflect.DeepEqual(data.Priorities, want) {
+		t.Errorf("priorities = %+v, want %+v", data.Priorities, want)
+	}
+}
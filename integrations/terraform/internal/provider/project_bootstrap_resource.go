@@ -0,0 +1,910 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectBootstrapResource{}
+var _ resource.ResourceWithImportState = &ProjectBootstrapResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectBootstrapResource{}
+
+// bootstrapProperty is the project property recording what a bootstrap
+// created. A create interrupted by an error resumes from it on the next
+// apply instead of creating everything again.
+const bootstrapProperty = "terraform-jira-bootstrap"
+
+// bootstrapEpicType is the issue type of the welcome epic.
+const bootstrapEpicType = "Epic"
+
+// bootstrapVersions are the versions every bootstrapped project starts with.
+var bootstrapVersions = []string{"Backlog", "Next"}
+
+// defaultBootstrapComponents is the standard component set.
+var defaultBootstrapComponents = []string{"Backend", "Frontend", "Infrastructure", "Documentation"}
+
+// projectKeyPattern matches the keys Jira accepts by default.
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// bootstrapCreatedAttrTypes are the attribute types of the created object.
+var bootstrapCreatedAttrTypes = map[string]attr.Type{
+	"project_id":       types.StringType,
+	"components":       types.MapType{ElemType: types.StringType},
+	"versions":         types.MapType{ElemType: types.StringType},
+	"filter_id":        types.StringType,
+	"board_id":         types.Int64Type,
+	"welcome_epic_key": types.StringType,
+}
+
+// NewProjectBootstrapResource creates a new project bootstrap resource.
+func NewProjectBootstrapResource() resource.Resource {
+	return &ProjectBootstrapResource{}
+}
+
+// ProjectBootstrapResource defines the resource implementation.
+type ProjectBootstrapResource struct {
+	client *client.JiraClient
+}
+
+// ProjectBootstrapResourceModel describes the resource data model.
+type ProjectBootstrapResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	LeadAccountID      types.String `tfsdk:"lead_account_id"`
+	ProjectTypeKey     types.String `tfsdk:"project_type_key"`
+	ProjectTemplateKey types.String `tfsdk:"project_template_key"`
+	Components         types.Set    `tfsdk:"components"`
+	WelcomeEpicSummary types.String `tfsdk:"welcome_epic_summary"`
+	Created            types.Object `tfsdk:"created"`
+}
+
+// bootstrapRecord lists what a bootstrap created. It is stored both as the
+// created attribute and as the bootstrapProperty project property.
+type bootstrapRecord struct {
+	ProjectID   string            `tfsdk:"project_id" json:"project_id"`
+	Components  map[string]string `tfsdk:"components" json:"components,omitempty"`
+	Versions    map[string]string `tfsdk:"versions" json:"versions,omitempty"`
+	FilterID    *string           `tfsdk:"filter_id" json:"filter_id,omitempty"`
+	BoardID     *int64            `tfsdk:"board_id" json:"board_id,omitempty"`
+	WelcomeEpic *string           `tfsdk:"welcome_epic_key" json:"welcome_epic_key,omitempty"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectBootstrapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_bootstrap"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectBootstrapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a Jira project with a standard set of components, versions, a kanban board, and a welcome epic." + scopesNote("jira_project_bootstrap"),
+		MarkdownDescription: `
+Creates a Jira project in one step, together with a standard component set, the versions
+"Backlog" and "Next", a kanban board backed by a project filter, and a welcome epic. The IDs of
+everything created are exported in ` + "`created`" + `.
+
+Progress is recorded on the project as it is made. When a step fails, applying again resumes
+where the previous apply stopped rather than creating duplicates, and pieces deleted in Jira
+are recreated.
+
+~> **Note:** Destroying this resource deletes the board and its filter and moves the project,
+with all its issues, to the Jira trash.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_project_bootstrap" "payments" {
+  key             = "PAY"
+  name            = "Payments"
+  lead_account_id = "5b10ac8d82e05b22cc7d4ef5"
+  components      = ["API", "Ledger", "Documentation"]
+}
+
+output "payments_board" {
+  value = jira_project_bootstrap.payments.created.board_id
+}
+` + "```" + `
+
+## Import
+
+Projects created by this resource can be imported using the project key:
+
+` + "```bash" + `
+terraform import jira_project_bootstrap.payments PAY
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The project key (e.g., PAY). Changing this forces a new project.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(projectKeyPattern, "must be 2-10 uppercase letters or digits, starting with a letter"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The project name.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The project description.",
+				Optional:    true,
+			},
+			"lead_account_id": schema.StringAttribute{
+				Description: "Account ID of the project lead.",
+				Required:    true,
+			},
+			"project_type_key": schema.StringAttribute{
+				Description: "The project type. Defaults to software. Only used when creating the project.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.ProjectTypeSoftware),
+			},
+			"project_template_key": schema.StringAttribute{
+				Description: "The project template. Defaults to the kanban software template. Only used when creating the project.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.ProjectTemplateKanban),
+			},
+			"components": schema.SetAttribute{
+				Description: "Components to create. Defaults to Backend, Frontend, Infrastructure, and Documentation. Removing a name deletes the component.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, stringValues(defaultBootstrapComponents))),
+			},
+			"welcome_epic_summary": schema.StringAttribute{
+				Description: "Summary of the welcome epic. Defaults to \"Welcome to <name>\".",
+				Optional:    true,
+			},
+			"created": schema.SingleNestedAttribute{
+				Description: "IDs of everything the bootstrap created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Description: "The project ID.",
+						Computed:    true,
+					},
+					"components": schema.MapAttribute{
+						Description: "Component IDs keyed by component name.",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"versions": schema.MapAttribute{
+						Description: "Version IDs keyed by version name (Backlog, Next).",
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"filter_id": schema.StringAttribute{
+						Description: "ID of the filter backing the board.",
+						Computed:    true,
+					},
+					"board_id": schema.Int64Attribute{
+						Description: "ID of the kanban board.",
+						Computed:    true,
+					},
+					"welcome_epic_key": schema.StringAttribute{
+						Description: "Key of the welcome epic.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectBootstrapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// ModifyPlan plans an update whenever the component set changed or a piece
+// of the bootstrap is missing, so the apply recreates it.
+func (r *ProjectBootstrapResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ProjectBootstrapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	complete := !plan.Components.IsUnknown() && !state.Created.IsNull()
+	if complete {
+		var components []string
+		var record bootstrapRecord
+		resp.Diagnostics.Append(plan.Components.ElementsAs(ctx, &components, false)...)
+		resp.Diagnostics.Append(state.Created.As(ctx, &record, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		complete = len(record.missing(components)) == 0 && len(record.Components) == len(components)
+	}
+
+	if !complete {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created"), types.ObjectUnknown(bootstrapCreatedAttrTypes))...)
+	}
+}
+
+// Create creates the project and everything that comes with it, or resumes
+// a bootstrap an earlier apply left incomplete.
+func (r *ProjectBootstrapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectBootstrapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+
+	tflog.Debug(ctx, "Bootstrapping Jira project", map[string]any{
+		"key": key,
+	})
+
+	var record *bootstrapRecord
+	_, err := r.client.GetProject(ctx, key)
+	switch {
+	case err == nil:
+		record, err = r.loadRecord(ctx, key)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read project bootstrap progress", err.Error())
+			return
+		}
+		if record == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key"),
+				"Project Already Exists",
+				fmt.Sprintf("Project %s already exists and was not created by jira_project_bootstrap. Choose another key.", key),
+			)
+			return
+		}
+		tflog.Info(ctx, "Resuming Jira project bootstrap", map[string]any{
+			"key": key,
+		})
+
+	case client.IsNotFound(err):
+		project, err := r.client.CreateProject(ctx, &client.CreateProjectRequest{
+			Key:                key,
+			Name:               data.Name.ValueString(),
+			Description:        data.Description.ValueString(),
+			LeadAccountID:      data.LeadAccountID.ValueString(),
+			ProjectTypeKey:     data.ProjectTypeKey.ValueString(),
+			ProjectTemplateKey: data.ProjectTemplateKey.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to create project", err.Error())
+			return
+		}
+		record = &bootstrapRecord{ProjectID: project.ID}
+		if err := r.saveRecord(ctx, key, record); err != nil {
+			resp.Diagnostics.AddError("Failed to record project bootstrap progress", err.Error())
+			return
+		}
+
+	default:
+		resp.Diagnostics.AddError("Failed to read project", err.Error())
+		return
+	}
+
+	// State is only written once everything exists: a failed create would
+	// otherwise be tainted, and replacing it would delete the project.
+	if err := r.bootstrap(ctx, &data, record); err != nil {
+		resp.Diagnostics.AddError(
+			"Project Bootstrap Incomplete",
+			fmt.Sprintf("%s\n\nCreated so far: %s. Progress is recorded on project %s, so applying again resumes from here.",
+				err.Error(), record.describe(key), key),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(record.ProjectID)
+	resp.Diagnostics.Append(r.setCreated(ctx, &data, record)...)
+
+	tflog.Info(ctx, "Bootstrapped Jira project", map[string]any{
+		"key": key,
+		"id":  record.ProjectID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the project details and drops anything deleted in Jira
+// from created, which the next plan then recreates.
+func (r *ProjectBootstrapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectBootstrapResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+
+	tflog.Debug(ctx, "Reading Jira project bootstrap", map[string]any{
+		"key": key,
+	})
+
+	project, err := r.client.GetProject(ctx, key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read project", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(project.ID)
+	data.Name = types.StringValue(project.Name)
+	data.Description = stringOrNull(project.Description)
+	if project.Lead != nil {
+		data.LeadAccountID = types.StringValue(project.Lead.AccountID)
+	}
+
+	// Imports start with nothing but the key.
+	var record *bootstrapRecord
+	if data.Created.IsNull() {
+		record, err = r.loadRecord(ctx, key)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read project bootstrap progress", err.Error())
+			return
+		}
+		if record == nil {
+			resp.Diagnostics.AddError(
+				"Not a Bootstrapped Project",
+				fmt.Sprintf("Project %s was not created by jira_project_bootstrap, so there is nothing to import.", key),
+			)
+			return
+		}
+
+		components := make([]string, 0, len(record.Components))
+		for name := range record.Components {
+			components = append(components, name)
+		}
+		set, diags := types.SetValueFrom(ctx, types.StringType, components)
+		resp.Diagnostics.Append(diags...)
+		data.Components = set
+		if data.ProjectTypeKey.IsNull() {
+			data.ProjectTypeKey = types.StringValue(client.ProjectTypeSoftware)
+		}
+		if data.ProjectTemplateKey.IsNull() {
+			data.ProjectTemplateKey = types.StringValue(client.ProjectTemplateKanban)
+		}
+	} else {
+		record = &bootstrapRecord{}
+		resp.Diagnostics.Append(data.Created.As(ctx, record, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err := r.refresh(ctx, key, record); err != nil {
+		resp.Diagnostics.AddError("Failed to read project bootstrap", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.setCreated(ctx, &data, record)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies project detail changes, creates added or missing pieces,
+// and deletes components removed from the configuration.
+func (r *ProjectBootstrapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProjectBootstrapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+
+	tflog.Debug(ctx, "Updating Jira project bootstrap", map[string]any{
+		"key": key,
+	})
+
+	if !data.Name.Equal(state.Name) || !data.Description.Equal(state.Description) || !data.LeadAccountID.Equal(state.LeadAccountID) {
+		description := data.Description.ValueString()
+		err := r.client.UpdateProject(ctx, key, &client.UpdateProjectRequest{
+			Name:          data.Name.ValueString(),
+			Description:   &description,
+			LeadAccountID: data.LeadAccountID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update project", err.Error())
+			return
+		}
+	}
+
+	record := &bootstrapRecord{}
+	resp.Diagnostics.Append(state.Created.As(ctx, record, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hadEpic := record.WelcomeEpic != nil
+
+	// Keep what was created in state even when a step fails; unlike a
+	// failed create, a failed update isn't tainted.
+	fail := func(summary string, err error) {
+		resp.Diagnostics.AddError(summary, err.Error())
+		resp.Diagnostics.Append(r.setCreated(ctx, &data, record)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	if err := r.removeComponents(ctx, &data, record); err != nil {
+		fail("Failed to delete removed components", err)
+		return
+	}
+
+	if err := r.bootstrap(ctx, &data, record); err != nil {
+		fail("Project Bootstrap Incomplete", err)
+		return
+	}
+
+	if hadEpic && !data.WelcomeEpicSummary.Equal(state.WelcomeEpicSummary) {
+		fields := client.IssueFields{Summary: welcomeEpicSummary(data)}
+		if err := r.client.UpdateIssue(ctx, *record.WelcomeEpic, &client.UpdateIssueRequest{Fields: fields}); err != nil {
+			fail("Failed to update welcome epic", err)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.setCreated(ctx, &data, record)...)
+
+	tflog.Info(ctx, "Updated Jira project bootstrap", map[string]any{
+		"key": key,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the board and its filter, which live outside the project,
+// then moves the project to the trash. Every step is attempted and each
+// failure is reported.
+func (r *ProjectBootstrapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectBootstrapResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+
+	tflog.Debug(ctx, "Deleting Jira project bootstrap", map[string]any{
+		"key": key,
+	})
+
+	record := &bootstrapRecord{}
+	if !data.Created.IsNull() {
+		resp.Diagnostics.Append(data.Created.As(ctx, record, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if record.BoardID != nil {
+		if err := r.client.DeleteBoard(ctx, *record.BoardID); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete board", err.Error())
+		}
+	}
+
+	if record.FilterID != nil {
+		if err := r.client.DeleteFilter(ctx, *record.FilterID); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete board filter", err.Error())
+		}
+	}
+
+	if err := r.client.DeleteProject(ctx, key, true); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete project", err.Error())
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira project bootstrap", map[string]any{
+		"key": key,
+	})
+}
+
+// ImportState imports a bootstrapped project by key.
+func (r *ProjectBootstrapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
+
+// bootstrap creates every piece missing from record, saving progress to
+// the project after each one. Components and versions that already exist
+// by name are adopted, in case progress was lost before it was saved.
+func (r *ProjectBootstrapResource) bootstrap(ctx context.Context, data *ProjectBootstrapResourceModel, record *bootstrapRecord) error {
+	key := data.Key.ValueString()
+
+	var components []string
+	if diags := data.Components.ElementsAs(ctx, &components, false); diags.HasError() {
+		return fmt.Errorf("failed to read components")
+	}
+	sort.Strings(components)
+
+	missing := record.missing(components)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	save := func() error {
+		if err := r.saveRecord(ctx, key, record); err != nil {
+			return fmt.Errorf("failed to record bootstrap progress: %w", err)
+		}
+		return nil
+	}
+
+	if missing["components"] {
+		existing, err := r.client.GetProjectComponents(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to read components: %w", err)
+		}
+		if record.Components == nil {
+			record.Components = make(map[string]string)
+		}
+
+		for _, name := range components {
+			if _, ok := record.Components[name]; ok {
+				continue
+			}
+			id := ""
+			for _, component := range existing {
+				if component.Name == name {
+					id = component.ID
+				}
+			}
+			if id == "" {
+				component, err := r.client.CreateComponent(ctx, &client.Component{Name: name, Project: key})
+				if err != nil {
+					return fmt.Errorf("failed to create component %q: %w", name, err)
+				}
+				id = component.ID
+			}
+			record.Components[name] = id
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if missing["versions"] {
+		projectID, err := strconv.ParseInt(record.ProjectID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid project ID %q", record.ProjectID)
+		}
+		existing, err := r.client.GetProjectVersions(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to read versions: %w", err)
+		}
+		if record.Versions == nil {
+			record.Versions = make(map[string]string)
+		}
+
+		for _, name := range bootstrapVersions {
+			if _, ok := record.Versions[name]; ok {
+				continue
+			}
+			id := ""
+			for _, version := range existing {
+				if version.Name == name {
+					id = version.ID
+				}
+			}
+			if id == "" {
+				version, err := r.client.CreateVersion(ctx, &client.Version{Name: name, ProjectID: projectID})
+				if err != nil {
+					return fmt.Errorf("failed to create version %q: %w", name, err)
+				}
+				id = version.ID
+			}
+			record.Versions[name] = id
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if missing["filter"] {
+		filter, err := r.client.CreateFilter(ctx, &client.Filter{
+			Name:             key + " board",
+			Description:      "Issues shown on the " + key + " board.",
+			JQL:              "project = " + client.QuoteJQL(key) + " ORDER BY Rank ASC",
+			SharePermissions: []client.SharePermission{{Type: "project", Project: &client.Project{ID: record.ProjectID}}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create board filter: %w", err)
+		}
+		record.FilterID = &filter.ID
+		if err := save(); err != nil {
+			return err
+		}
+	}
+
+	if missing["board"] {
+		filterID, err := strconv.ParseInt(*record.FilterID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid filter ID %q", *record.FilterID)
+		}
+		board, err := r.client.CreateBoard(ctx, data.Name.ValueString()+" board", client.BoardTypeKanban, filterID, key)
+		if err != nil {
+			return fmt.Errorf("failed to create board: %w", err)
+		}
+		record.BoardID = &board.ID
+		if err := save(); err != nil {
+			return err
+		}
+	}
+
+	if missing["welcome_epic"] {
+		issue, err := r.client.CreateIssue(ctx, &client.CreateIssueRequest{Fields: client.IssueFields{
+			Project:   &client.Project{Key: key},
+			Summary:   welcomeEpicSummary(*data),
+			IssueType: &client.IssueType{Name: bootstrapEpicType},
+		}})
+		if err != nil {
+			return fmt.Errorf("failed to create welcome epic: %w", err)
+		}
+		record.WelcomeEpic = &issue.Key
+		if err := save(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeComponents deletes recorded components that are no longer
+// configured.
+func (r *ProjectBootstrapResource) removeComponents(ctx context.Context, data *ProjectBootstrapResourceModel, record *bootstrapRecord) error {
+	var components []string
+	if diags := data.Components.ElementsAs(ctx, &components, false); diags.HasError() {
+		return fmt.Errorf("failed to read components")
+	}
+
+	configured := make(map[string]bool, len(components))
+	for _, name := range components {
+		configured[name] = true
+	}
+
+	for name, id := range record.Components {
+		if configured[name] {
+			continue
+		}
+		if err := r.client.DeleteComponent(ctx, id); err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("failed to delete component %q: %w", name, err)
+		}
+		delete(record.Components, name)
+		if err := r.saveRecord(ctx, data.Key.ValueString(), record); err != nil {
+			return fmt.Errorf("failed to record bootstrap progress: %w", err)
+		}
+	}
+	return nil
+}
+
+// refresh drops the pieces of record that no longer exist in Jira, and
+// follows components and versions that were renamed.
+func (r *ProjectBootstrapResource) refresh(ctx context.Context, key string, record *bootstrapRecord) error {
+	components, err := r.client.GetProjectComponents(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to read components: %w", err)
+	}
+	componentNames := make(map[string]string, len(components))
+	for _, component := range components {
+		componentNames[component.ID] = component.Name
+	}
+	record.Components = refreshNamedIDs(record.Components, componentNames)
+
+	versions, err := r.client.GetProjectVersions(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to read versions: %w", err)
+	}
+	versionNames := make(map[string]string, len(versions))
+	for _, version := range versions {
+		versionNames[version.ID] = version.Name
+	}
+	record.Versions = refreshNamedIDs(record.Versions, versionNames)
+
+	if record.FilterID != nil {
+		if _, err := r.client.GetFilter(ctx, *record.FilterID); client.IsNotFound(err) {
+			record.FilterID = nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read board filter: %w", err)
+		}
+	}
+
+	if record.BoardID != nil {
+		if _, err := r.client.GetBoard(ctx, *record.BoardID); client.IsNotFound(err) {
+			record.BoardID = nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read board: %w", err)
+		}
+	}
+
+	if record.WelcomeEpic != nil {
+		issue, err := r.client.GetIssue(ctx, *record.WelcomeEpic)
+		switch {
+		case client.IsNotFound(err):
+			record.WelcomeEpic = nil
+		case err != nil:
+			return fmt.Errorf("failed to read welcome epic: %w", err)
+		default:
+			// Follow the epic if it was moved and rekeyed.
+			record.WelcomeEpic = &issue.Key
+		}
+	}
+
+	return nil
+}
+
+// refreshNamedIDs keeps the recorded IDs (keyed by name) that still exist
+// in names (keyed by ID), under their current names.
+func refreshNamedIDs(recorded, names map[string]string) map[string]string {
+	current := make(map[string]string, len(recorded))
+	for _, id := range recorded {
+		if name, ok := names[id]; ok {
+			current[name] = id
+		}
+	}
+	return current
+}
+
+// loadRecord reads the bootstrap progress stored on a project, or nil when
+// the project wasn't bootstrapped.
+func (r *ProjectBootstrapResource) loadRecord(ctx context.Context, key string) (*bootstrapRecord, error) {
+	raw, err := r.client.GetProjectProperty(ctx, key, bootstrapProperty)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var record bootstrapRecord
+	if err := json.Unmarshal(raw, &record); err != nil {
+		return nil, fmt.Errorf("failed to parse project property %s: %w", bootstrapProperty, err)
+	}
+	return &record, nil
+}
+
+// saveRecord stores the bootstrap progress on the project.
+func (r *ProjectBootstrapResource) saveRecord(ctx context.Context, key string, record *bootstrapRecord) error {
+	return r.client.SetProjectProperty(ctx, key, bootstrapProperty, record)
+}
+
+// setCreated stores record in the created attribute.
+func (r *ProjectBootstrapResource) setCreated(ctx context.Context, data *ProjectBootstrapResourceModel, record *bootstrapRecord) diag.Diagnostics {
+	created, diags := types.ObjectValueFrom(ctx, bootstrapCreatedAttrTypes, record)
+	data.Created = created
+	return diags
+}
+
+// missing reports which pieces of the bootstrap still need creating.
+func (b *bootstrapRecord) missing(components []string) map[string]bool {
+	missing := make(map[string]bool)
+	for _, name := range components {
+		if _, ok := b.Components[name]; !ok {
+			missing["components"] = true
+		}
+	}
+	for _, name := range bootstrapVersions {
+		if _, ok := b.Versions[name]; !ok {
+			missing["versions"] = true
+		}
+	}
+	if b.FilterID == nil {
+		missing["filter"] = true
+	}
+	if b.BoardID == nil {
+		missing["board"] = true
+	}
+	if b.WelcomeEpic == nil {
+		missing["welcome_epic"] = true
+	}
+	return missing
+}
+
+// describe summarizes what the record holds, for diagnostics.
+func (b *bootstrapRecord) describe(key string) string {
+	parts := []string{"project " + key}
+	if names := sortedKeys(b.Components); len(names) > 0 {
+		parts = append(parts, "components "+strings.Join(names, ", "))
+	}
+	if names := sortedKeys(b.Versions); len(names) > 0 {
+		parts = append(parts, "versions "+strings.Join(names, ", "))
+	}
+	if b.FilterID != nil {
+		parts = append(parts, "filter "+*b.FilterID)
+	}
+	if b.BoardID != nil {
+		parts = append(parts, "board "+strconv.FormatInt(*b.BoardID, 10))
+	}
+	if b.WelcomeEpic != nil {
+		parts = append(parts, "welcome epic "+*b.WelcomeEpic)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// welcomeEpicSummary returns the configured welcome epic summary or the
+// default one.
+func welcomeEpicSummary(data ProjectBootstrapResourceModel) string {
+	if !data.WelcomeEpicSummary.IsNull() {
+		return data.WelcomeEpicSummary.ValueString()
+	}
+	return "Welcome to " + data.Name.ValueString()
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringValues converts strings to framework values.
+func stringValues(values []string) []attr.Value {
+	result := make([]attr.Value, len(values))
+	for i, value := range values {
+		result[i] = types.StringValue(value)
+	}
+	return result
+}
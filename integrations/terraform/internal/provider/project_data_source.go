@@ -32,6 +32,9 @@ type ProjectDataSourceModel struct {
 	Key  types.String `tfsdk:"key"`
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
+
+	AllowMissing types.Bool `tfsdk:"allow_missing"`
+	Found        types.Bool `tfsdk:"found"`
 }
 
 // Metadata returns the data source type name.
@@ -42,7 +45,7 @@ func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.Metadat
 // Schema defines the schema for the data source.
 func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Fetches a Jira project by key.",
+		Description: "Fetches a Jira project by key." + scopesNote("data.jira_project"),
 		MarkdownDescription: `
 Fetches a Jira project by its key.
 
@@ -71,6 +74,14 @@ output "project_name" {
 				Description: "The project name.",
 				Computed:    true,
 			},
+			"allow_missing": schema.BoolAttribute{
+				Description: "Don't fail when the project doesn't exist; found is false and all other attributes are null instead.",
+				Optional:    true,
+			},
+			"found": schema.BoolAttribute{
+				Description: "Whether the project exists.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -81,16 +92,16 @@ func (d *ProjectDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.JiraClient)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.JiraClient, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -105,12 +116,23 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		"key": data.Key.ValueString(),
 	})
 
-	project, err := d.client.GetProject(data.Key.ValueString())
+	project, err := d.client.GetProject(ctx, data.Key.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) && data.AllowMissing.ValueBool() {
+			tflog.Debug(ctx, "Jira project not found", map[string]any{
+				"key": data.Key.ValueString(),
+			})
+			data.ID = types.StringNull()
+			data.Name = types.StringNull()
+			data.Found = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
 		resp.Diagnostics.AddError("Failed to read project", err.Error())
 		return
 	}
 
+	data.Found = types.BoolValue(true)
 	data.ID = types.StringValue(project.ID)
 	data.Name = types.StringValue(project.Name)
 
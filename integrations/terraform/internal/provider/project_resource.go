@@ -0,0 +1,301 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectResource{}
+var _ resource.ResourceWithImportState = &ProjectResource{}
+
+// NewProjectResource creates a new project resource.
+func NewProjectResource() resource.Resource {
+	return &ProjectResource{}
+}
+
+// ProjectResource defines the resource implementation.
+type ProjectResource struct {
+	client *client.JiraClient
+}
+
+// ProjectResourceModel describes the resource data model.
+type ProjectResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Key                types.String `tfsdk:"key"`
+	Name               types.String `tfsdk:"name"`
+	ProjectTypeKey     types.String `tfsdk:"project_type_key"`
+	LeadAccountID      types.String `tfsdk:"lead_account_id"`
+	Description        types.String `tfsdk:"description"`
+	ProjectTemplateKey types.String `tfsdk:"project_template_key"`
+	EnableUndo         types.Bool   `tfsdk:"enable_undo"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira project." + scopesNote("jira_project"),
+		MarkdownDescription: `
+Manages a Jira project. The name, lead, and description update in place; changing the key
+or project type creates a new project.
+
+~> **Note:** By default destroying the resource moves the project, with all its issues, to
+the Jira trash, where it can be restored for 60 days. Set ` + "`enable_undo = false`" + ` to delete
+it permanently.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_project" "payments" {
+  key             = "PAY"
+  name            = "Payments"
+  lead_account_id = "5b10ac8d82e05b22cc7d4ef5"
+  description     = "Payments team backlog"
+}
+` + "```" + `
+
+## Import
+
+Projects can be imported using the project key:
+
+` + "```bash" + `
+terraform import jira_project.payments PAY
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The project key (e.g., PAY). Changing this forces a new project.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(projectKeyPattern, "must be 2-10 uppercase letters or digits, starting with a letter"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The project name.",
+				Required:    true,
+			},
+			"project_type_key": schema.StringAttribute{
+				Description: "The project type (software or business). Defaults to software. Changing this forces a new project.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.ProjectTypeSoftware),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ProjectTypeSoftware, client.ProjectTypeBusiness),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lead_account_id": schema.StringAttribute{
+				Description: "Account ID of the project lead.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The project description.",
+				Optional:    true,
+			},
+			"project_template_key": schema.StringAttribute{
+				Description: "The project template (e.g., " + client.ProjectTemplateKanban + "). Only used when creating the project; Jira picks a default template for the type when unset.",
+				Optional:    true,
+			},
+			"enable_undo": schema.BoolAttribute{
+				Description: "Move the project to the trash on destroy, where it can be restored for 60 days, instead of deleting it permanently. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira project", map[string]any{
+		"key": data.Key.ValueString(),
+	})
+
+	project, err := r.client.CreateProject(ctx, &client.CreateProjectRequest{
+		Key:                data.Key.ValueString(),
+		Name:               data.Name.ValueString(),
+		Description:        data.Description.ValueString(),
+		LeadAccountID:      data.LeadAccountID.ValueString(),
+		ProjectTypeKey:     data.ProjectTypeKey.ValueString(),
+		ProjectTemplateKey: data.ProjectTemplateKey.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create project", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(project.ID)
+
+	tflog.Info(ctx, "Created Jira project", map[string]any{
+		"key": project.Key,
+		"id":  project.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira project", map[string]any{
+		"key": data.Key.ValueString(),
+	})
+
+	project, err := r.client.GetProject(ctx, data.Key.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read project", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(project.ID)
+	data.Key = types.StringValue(project.Key)
+	data.Name = types.StringValue(project.Name)
+	data.Description = stringOrNull(project.Description)
+	if project.ProjectTypeKey != "" {
+		data.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
+	}
+	if project.Lead != nil {
+		data.LeadAccountID = types.StringValue(project.Lead.AccountID)
+	}
+
+	// Imports don't know the delete behavior yet.
+	if data.EnableUndo.IsNull() {
+		data.EnableUndo = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the name, lead, or description in place.
+func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira project", map[string]any{
+		"key": data.Key.ValueString(),
+	})
+
+	if !data.Name.Equal(state.Name) || !data.Description.Equal(state.Description) || !data.LeadAccountID.Equal(state.LeadAccountID) {
+		description := data.Description.ValueString()
+		err := r.client.UpdateProject(ctx, data.Key.ValueString(), &client.UpdateProjectRequest{
+			Name:          data.Name.ValueString(),
+			Description:   &description,
+			LeadAccountID: data.LeadAccountID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to update project", err.Error())
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Updated Jira project", map[string]any{
+		"key": data.Key.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete moves the project to the trash, or deletes it permanently when
+// enable_undo is false.
+func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enableUndo := data.EnableUndo.IsNull() || data.EnableUndo.ValueBool()
+
+	tflog.Debug(ctx, "Deleting Jira project", map[string]any{
+		"key":         data.Key.ValueString(),
+		"enable_undo": enableUndo,
+	})
+
+	err := r.client.DeleteProject(ctx, data.Key.ValueString(), enableUndo)
+	if err != nil {
+		if !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Failed to delete project", err.Error())
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Deleted Jira project", map[string]any{
+		"key": data.Key.ValueString(),
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+}
@@ -0,0 +1,321 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectShortcutResource{}
+var _ resource.ResourceWithImportState = &ProjectShortcutResource{}
+
+// NewProjectShortcutResource creates a new project shortcut resource.
+func NewProjectShortcutResource() resource.Resource {
+	return &ProjectShortcutResource{}
+}
+
+// ProjectShortcutResource defines the resource implementation.
+type ProjectShortcutResource struct {
+	client *client.JiraClient
+}
+
+// ProjectShortcutResourceModel describes the resource data model.
+type ProjectShortcutResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Project    types.String `tfsdk:"project"`
+	ShortcutID types.String `tfsdk:"shortcut_id"`
+	URL        types.String `tfsdk:"url"`
+	Title      types.String `tfsdk:"title"`
+	Icon       types.String `tfsdk:"icon"`
+}
+
+// Metadata returns the resource type name.
+func (r *ProjectShortcutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_shortcut"
+}
+
+// Schema defines the schema for the resource.
+func (r *ProjectShortcutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a shortcut link in the sidebar of a team-managed Jira project." + scopesNote("jira_project_shortcut"),
+		MarkdownDescription: `
+Manages a shortcut link in the sidebar of a team-managed project, such as the team's
+runbook, dashboard, or repository. Other shortcuts of the project are left alone. Edits
+made in Jira show up as drift, and a shortcut removed in Jira is added again on the next
+apply. Company-managed projects are rejected, since the shortcuts API only covers
+team-managed ones.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_project_shortcut" "runbook" {
+  project = "PAY"
+  title   = "Runbook"
+  url     = "https://wiki.example.com/payments/runbook"
+}
+` + "```" + `
+
+## Import
+
+Shortcuts can be imported using the project key and shortcut ID separated by a colon:
+
+` + "```bash" + `
+terraform import jira_project_shortcut.runbook PAY:10002
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project key and shortcut ID separated by a colon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The key of the team-managed project. Changing this forces a new shortcut.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shortcut_id": schema.StringAttribute{
+				Description: "The shortcut ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL the shortcut opens.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The shortcut's title in the sidebar.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"icon": schema.StringAttribute{
+				Description: "The shortcut's icon, as Jira names it. Unset, Jira picks one from the URL.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ProjectShortcutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ProjectShortcutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectShortcutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"title":   data.Title.ValueString(),
+	})
+
+	r.requireTeamManaged(ctx, data.Project.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateProjectShortcut(ctx, data.Project.ValueString(), projectShortcut(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create project shortcut", err.Error())
+		return
+	}
+
+	data.ShortcutID = types.StringValue(created.ID)
+	data.ID = types.StringValue(data.Project.ValueString() + ":" + created.ID)
+
+	tflog.Info(ctx, "Created Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"id":      created.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data. A shortcut
+// removed in Jira is removed from state.
+func (r *ProjectShortcutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectShortcutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira project shortcuts", map[string]any{
+		"project": data.Project.ValueString(),
+	})
+
+	shortcuts, err := r.client.GetProjectShortcuts(ctx, data.Project.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read project shortcuts", err.Error())
+		return
+	}
+
+	var shortcut *client.ProjectShortcut
+	for i := range shortcuts {
+		if shortcuts[i].ID == data.ShortcutID.ValueString() {
+			shortcut = &shortcuts[i]
+			break
+		}
+	}
+	if shortcut == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.Project.ValueString() + ":" + shortcut.ID)
+	data.URL = types.StringValue(shortcut.URL)
+	data.Title = types.StringValue(shortcut.Name)
+	// Jira picks an icon for shortcuts created without one, which isn't
+	// drift while icon is unset.
+	if !data.Icon.IsNull() || shortcut.Icon == "" {
+		data.Icon = stringOrNull(shortcut.Icon)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ProjectShortcutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectShortcutResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"id":      data.ShortcutID.ValueString(),
+	})
+
+	err := r.client.UpdateProjectShortcut(ctx, data.Project.ValueString(), data.ShortcutID.ValueString(), projectShortcut(data))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update project shortcut", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"id":      data.ShortcutID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ProjectShortcutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectShortcutResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"id":      data.ShortcutID.ValueString(),
+	})
+
+	err := r.client.DeleteProjectShortcut(ctx, data.Project.ValueString(), data.ShortcutID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete project shortcut", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira project shortcut", map[string]any{
+		"project": data.Project.ValueString(),
+		"id":      data.ShortcutID.ValueString(),
+	})
+}
+
+// ImportState imports a shortcut from a "<project key>:<shortcut id>" ID.
+func (r *ProjectShortcutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	project, id, ok := strings.Cut(req.ID, ":")
+	if !ok || project == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <project key>:<shortcut id> (e.g., PAY:10002), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shortcut_id"), id)...)
+}
+
+// requireTeamManaged reports an error unless the project is team-managed.
+// The shortcuts API only covers team-managed projects, and company-managed
+// ones answer with errors that don't say so.
+func (r *ProjectShortcutResource) requireTeamManaged(ctx context.Context, projectKey string, diags *diag.Diagnostics) {
+	project, err := r.client.GetProjectMetadata(ctx, projectKey)
+	if err != nil {
+		diags.AddAttributeError(path.Root("project"), "Failed to read project", err.Error())
+		return
+	}
+
+	if project.Style != client.ProjectStyleTeamManaged {
+		diags.AddAttributeError(
+			path.Root("project"),
+			"Project Shortcuts Not Supported",
+			fmt.Sprintf("Project %s is company-managed, and Jira only supports managing shortcuts of team-managed projects. "+
+				"Add links to company-managed projects in the project sidebar by hand.", projectKey),
+		)
+	}
+}
+
+// projectShortcut returns the shortcut described by the resource data.
+func projectShortcut(data ProjectShortcutResourceModel) *client.ProjectShortcut {
+	return &client.ProjectShortcut{
+		URL:  data.URL.ValueString(),
+		Name: data.Title.ValueString(),
+		Icon: data.Icon.ValueString(),
+	}
+}
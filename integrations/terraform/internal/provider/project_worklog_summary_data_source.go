@@ -0,0 +1,415 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// worklogSummaryWorkers is the number of issues whose worklogs are fetched
+// concurrently. All requests still go through the client's rate limiter.
+const worklogSummaryWorkers = 4
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectWorklogSummaryDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &ProjectWorklogSummaryDataSource{}
+
+// NewProjectWorklogSummaryDataSource creates a new project worklog summary
+// data source.
+func NewProjectWorklogSummaryDataSource() datasource.DataSource {
+	return &ProjectWorklogSummaryDataSource{}
+}
+
+// ProjectWorklogSummaryDataSource defines the data source implementation.
+type ProjectWorklogSummaryDataSource struct {
+	client *client.JiraClient
+}
+
+// ProjectWorklogSummaryDataSourceModel describes the data source data model.
+type ProjectWorklogSummaryDataSourceModel struct {
+	Project      types.String               `tfsdk:"project"`
+	From         types.String               `tfsdk:"from"`
+	To           types.String               `tfsdk:"to"`
+	Detail       types.Bool                 `tfsdk:"detail"`
+	TotalSeconds types.Int64                `tfsdk:"total_seconds"`
+	IssueCount   types.Int64                `tfsdk:"issue_count"`
+	ByAuthor     []WorklogAuthorTotalModel  `tfsdk:"by_author"`
+	ByIssue      []WorklogIssueTotalModel   `tfsdk:"by_issue"`
+	Entries      []WorklogSummaryEntryModel `tfsdk:"entries"`
+}
+
+// WorklogAuthorTotalModel describes the time logged by one user.
+type WorklogAuthorTotalModel struct {
+	AccountID        types.String `tfsdk:"account_id"`
+	TimeSpentSeconds types.Int64  `tfsdk:"time_spent_seconds"`
+}
+
+// WorklogIssueTotalModel describes the time logged on one issue.
+type WorklogIssueTotalModel struct {
+	IssueKey         types.String `tfsdk:"issue_key"`
+	TimeSpentSeconds types.Int64  `tfsdk:"time_spent_seconds"`
+}
+
+// WorklogSummaryEntryModel describes a single worklog counted in the summary.
+type WorklogSummaryEntryModel struct {
+	IssueKey         types.String `tfsdk:"issue_key"`
+	ID               types.String `tfsdk:"id"`
+	AuthorAccountID  types.String `tfsdk:"author_account_id"`
+	Started          types.String `tfsdk:"started"`
+	TimeSpentSeconds types.Int64  `tfsdk:"time_spent_seconds"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProjectWorklogSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_worklog_summary"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProjectWorklogSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sums the time logged in a Jira project over a date range, per user and per issue." + scopesNote("data.jira_project_worklog_summary"),
+		MarkdownDescription: `
+Sums the time logged in a Jira project over a date range, per user and per issue.
+
+The issues are found with a ` + "`worklogDate`" + ` JQL search, and then every worklog of each
+matching issue is read, four issues at a time. A worklog counts when the date it started on,
+in its own time zone, falls between ` + "`from`" + ` and ` + "`to`" + ` inclusive. Time is
+attributed to the user who logged it, not to the issue's assignee.
+
+This costs one request per matching issue (more for issues with many worklogs), so keep the
+range narrow on busy projects. Set ` + "`detail`" + ` to also list every counted worklog.
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_project_worklog_summary" "september" {
+  project = "OPS"
+  from    = "2024-09-01"
+  to      = "2024-09-30"
+}
+
+output "hours_by_user" {
+  value = {
+    for total in data.jira_project_worklog_summary.september.by_author :
+    total.account_id => total.time_spent_seconds / 3600
+  }
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project key.",
+				Required:    true,
+			},
+			"from": schema.StringAttribute{
+				Description: "The first day of the range, in YYYY-MM-DD format.",
+				Required:    true,
+			},
+			"to": schema.StringAttribute{
+				Description: "The last day of the range, in YYYY-MM-DD format.",
+				Required:    true,
+			},
+			"detail": schema.BoolAttribute{
+				Description: "List every counted worklog in entries. Defaults to false.",
+				Optional:    true,
+			},
+			"total_seconds": schema.Int64Attribute{
+				Description: "The total time logged in the range, in seconds.",
+				Computed:    true,
+			},
+			"issue_count": schema.Int64Attribute{
+				Description: "The number of issues with time logged in the range.",
+				Computed:    true,
+			},
+			"by_author": schema.ListNestedAttribute{
+				Description: "The time logged per user, ordered by account ID.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							Description: "Account ID of the user who logged the time.",
+							Computed:    true,
+						},
+						"time_spent_seconds": schema.Int64Attribute{
+							Description: "Time logged by the user, in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"by_issue": schema.ListNestedAttribute{
+				Description: "The time logged per issue, ordered by issue key.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_key": schema.StringAttribute{
+							Description: "The issue key.",
+							Computed:    true,
+						},
+						"time_spent_seconds": schema.Int64Attribute{
+							Description: "Time logged on the issue, in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Every counted worklog, ordered by start time. Null unless detail is set.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_key": schema.StringAttribute{
+							Description: "The issue key.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "The worklog ID.",
+							Computed:    true,
+						},
+						"author_account_id": schema.StringAttribute{
+							Description: "Account ID of the worklog author.",
+							Computed:    true,
+						},
+						"started": schema.StringAttribute{
+							Description: "When the logged work started.",
+							Computed:    true,
+						},
+						"time_spent_seconds": schema.Int64Attribute{
+							Description: "Time spent in seconds.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ProjectWorklogSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// ValidateConfig checks that from and to are real dates in order.
+func (d *ProjectWorklogSummaryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data ProjectWorklogSummaryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, fromOK := parseSummaryDate(data.From, path.Root("from"), "Invalid From Date", resp)
+	to, toOK := parseSummaryDate(data.To, path.Root("to"), "Invalid To Date", resp)
+	if fromOK && toOK && to.Before(from) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("to"),
+			"Invalid Date Range",
+			fmt.Sprintf("to (%s) is before from (%s).", data.To.ValueString(), data.From.ValueString()),
+		)
+	}
+}
+
+// parseSummaryDate parses a known date attribute, reporting an error against
+// it when the value isn't in YYYY-MM-DD format.
+func parseSummaryDate(value types.String, p path.Path, summary string, resp *datasource.ValidateConfigResponse) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+	date, err := time.Parse(dueDateLayout, value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			p,
+			summary,
+			fmt.Sprintf("%q is not a valid date in YYYY-MM-DD format.", value.ValueString()),
+		)
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ProjectWorklogSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectWorklogSummaryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from := data.From.ValueString()
+	to := data.To.ValueString()
+	jql := fmt.Sprintf("project = %s AND worklogDate >= %q AND worklogDate <= %q",
+		client.QuoteJQL(data.Project.ValueString()), from, to)
+
+	tflog.Debug(ctx, "Summarizing Jira project worklogs", map[string]any{
+		"jql": jql,
+	})
+
+	keys, err := d.client.SearchIssueKeys(ctx, jql)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to search issues", err.Error())
+		return
+	}
+	sort.Strings(keys)
+
+	worklogs, err := d.fetchWorklogs(ctx, keys)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read worklogs", err.Error())
+		return
+	}
+
+	byAuthor := map[string]int64{}
+	byIssue := map[string]int64{}
+	entries := []WorklogSummaryEntryModel{}
+	var total int64
+	for _, key := range keys {
+		for _, worklog := range worklogs[key] {
+			started, err := client.ParseTime(worklog.Started)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Failed to read worklogs",
+					fmt.Sprintf("worklog %s on %s: %s", worklog.ID, key, err),
+				)
+				return
+			}
+			// Compare calendar days where the work was logged, so a late
+			// evening entry isn't moved to the next day by a UTC conversion.
+			if day := started.Format(dueDateLayout); day < from || day > to {
+				continue
+			}
+
+			account := userAccountID(worklog.Author)
+			total += worklog.TimeSpentSeconds
+			byIssue[key] += worklog.TimeSpentSeconds
+			byAuthor[account.ValueString()] += worklog.TimeSpentSeconds
+
+			if data.Detail.ValueBool() {
+				entries = append(entries, WorklogSummaryEntryModel{
+					IssueKey:         types.StringValue(key),
+					ID:               types.StringValue(worklog.ID),
+					AuthorAccountID:  account,
+					Started:          types.StringValue(started.UTC().Format(time.RFC3339)),
+					TimeSpentSeconds: types.Int64Value(worklog.TimeSpentSeconds),
+				})
+			}
+		}
+	}
+
+	data.TotalSeconds = types.Int64Value(total)
+	data.IssueCount = types.Int64Value(int64(len(byIssue)))
+
+	data.ByAuthor = make([]WorklogAuthorTotalModel, 0, len(byAuthor))
+	for account, seconds := range byAuthor {
+		data.ByAuthor = append(data.ByAuthor, WorklogAuthorTotalModel{
+			AccountID:        stringOrNull(account),
+			TimeSpentSeconds: types.Int64Value(seconds),
+		})
+	}
+	sort.Slice(data.ByAuthor, func(i, j int) bool {
+		return data.ByAuthor[i].AccountID.ValueString() < data.ByAuthor[j].AccountID.ValueString()
+	})
+
+	data.ByIssue = make([]WorklogIssueTotalModel, 0, len(byIssue))
+	for _, key := range keys {
+		if seconds, ok := byIssue[key]; ok {
+			data.ByIssue = append(data.ByIssue, WorklogIssueTotalModel{
+				IssueKey:         types.StringValue(key),
+				TimeSpentSeconds: types.Int64Value(seconds),
+			})
+		}
+	}
+
+	if data.Detail.ValueBool() {
+		sort.Slice(entries, func(i, j int) bool {
+			a, b := entries[i], entries[j]
+			if a.Started.ValueString() != b.Started.ValueString() {
+				return a.Started.ValueString() < b.Started.ValueString()
+			}
+			if a.IssueKey.ValueString() != b.IssueKey.ValueString() {
+				return a.IssueKey.ValueString() < b.IssueKey.ValueString()
+			}
+			return a.ID.ValueString() < b.ID.ValueString()
+		})
+		data.Entries = entries
+	}
+
+	tflog.Debug(ctx, "Summarized Jira project worklogs", map[string]any{
+		"issues":        len(keys),
+		"total_seconds": total,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchWorklogs reads the worklogs of every issue, a few issues at a time.
+// It returns the first error in key order, so a failed read is reported the
+// same way on every run.
+func (d *ProjectWorklogSummaryDataSource) fetchWorklogs(ctx context.Context, keys []string) (map[string][]client.Worklog, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	worklogs := make(map[string][]client.Worklog, len(keys))
+	failed := map[string]error{}
+
+	work := make(chan string)
+	for i := 0; i < worklogSummaryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				issueWorklogs, err := d.client.GetWorklogs(ctx, key)
+				mu.Lock()
+				if err != nil {
+					failed[key] = err
+				} else {
+					worklogs[key] = issueWorklogs
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i, key := range keys {
+		if ctx.Err() != nil {
+			mu.Lock()
+			for _, skipped := range keys[i:] {
+				failed[skipped] = ctx.Err()
+			}
+			mu.Unlock()
+			break
+		}
+		work <- key
+	}
+	close(work)
+	wg.Wait()
+
+	for _, key := range keys {
+		if err, ok := failed[key]; ok {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return worklogs, nil
+}
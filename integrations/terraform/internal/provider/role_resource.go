@@ -0,0 +1,260 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoleResource{}
+var _ resource.ResourceWithImportState = &RoleResource{}
+
+// NewRoleResource creates a new project role resource.
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client *client.JiraClient
+}
+
+// RoleResourceModel describes the resource data model.
+type RoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// Metadata returns the resource type name.
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira project role definition (e.g., \"Security Champion\")." + scopesNote("jira_role"),
+		MarkdownDescription: `
+Manages a Jira project role definition. Roles are defined once for the site and can then be
+granted permissions in schemes and assigned actors in each project.
+
+~> **Note:** Jira refuses to delete a role that permission or notification schemes still
+reference. The error lists the permission schemes that use the role.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_role" "security_champion" {
+  name        = "Security Champion"
+  description = "Reviews security-sensitive changes"
+}
+` + "```" + `
+
+## Import
+
+Roles can be imported using the role ID:
+
+` + "```bash" + `
+terraform import jira_role.security_champion 10100
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The project role ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The role name. Must be unique on the site.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The role description.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira project role", map[string]any{
+		"name": data.Name.ValueString(),
+	})
+
+	role, err := r.client.CreateRole(ctx, &client.ProjectRole{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create project role", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(role.ID, 10))
+
+	tflog.Info(ctx, "Created Jira project role", map[string]any{
+		"id":   role.ID,
+		"name": role.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data, picking up
+// renames made in the UI.
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira project role", map[string]any{
+		"id": id,
+	})
+
+	role, err := r.client.GetRole(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read project role", err.Error())
+		return
+	}
+
+	data.Name = types.StringValue(role.Name)
+	data.Description = stringOrNull(role.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update renames the role or changes its description in place.
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira project role", map[string]any{
+		"id": id,
+	})
+
+	_, err = r.client.UpdateRole(ctx, id, &client.ProjectRole{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update project role", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira project role", map[string]any{
+		"id": id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project role ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira project role", map[string]any{
+		"id": id,
+	})
+
+	err = r.client.DeleteRole(ctx, id)
+	switch {
+	case err == nil, client.IsNotFound(err):
+	case client.IsConflict(err):
+		detail := "Remove the role from the schemes that reference it, then delete it again."
+		if schemes, lookupErr := r.client.PermissionSchemesUsingRole(ctx, id); lookupErr == nil && len(schemes) > 0 {
+			detail = fmt.Sprintf("The role is granted permissions in these permission schemes: %s. "+
+				"Remove those grants (and any notification scheme entries), then delete it again.",
+				strings.Join(schemes, ", "))
+		}
+		resp.Diagnostics.AddError(
+			"Project role is still in use",
+			fmt.Sprintf("Jira refused to delete project role %q: %s\n\n%s", data.Name.ValueString(), err.Error(), detail),
+		)
+		return
+	default:
+		resp.Diagnostics.AddError("Failed to delete project role", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira project role", map[string]any{
+		"id": id,
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Scopes that Atlassian scoped API tokens can be granted.
+const (
+	scopeReadUser     = "read:jira-user"
+	scopeReadWork     = "read:jira-work"
+	scopeWriteWork    = "write:jira-work"
+	scopeManageConfig = "manage:jira-configuration"
+)
+
+// scopeProbes maps scopes to a read-only endpoint that only succeeds when the
+// token holds the scope. Write scopes can't be tested without making changes
+// and are therefore absent.
+var scopeProbes = map[string]string{
+	scopeReadUser: "/myself",
+	scopeReadWork: "/project/search?maxResults=1",
+}
+
+// scopeRequirements lists the token scopes each resource and data source
+// needs. Schema descriptions and the configure-time scope check are both
+// generated from this table, so new types only need an entry here.
+var scopeRequirements = map[string][]string{
+	"jira_issue":                        {scopeReadWork, scopeWriteWork, scopeReadUser},
+	"jira_subtask":                      {scopeReadWork, scopeWriteWork},
+	"jira_issue_link_type":              {scopeReadWork, scopeManageConfig},
+	"jira_status":                       {scopeReadWork, scopeManageConfig},
+	"jira_bulk_label":                   {scopeReadWork, scopeWriteWork},
+	"jira_role":                         {scopeManageConfig},
+	"jira_comment":                      {scopeReadWork, scopeWriteWork},
+	"jira_issue_link":                   {scopeReadWork, scopeWriteWork},
+	"jira_project":                      {scopeReadWork, scopeManageConfig},
+	"jira_project_bootstrap":            {scopeReadWork, scopeWriteWork, scopeManageConfig},
+	"jira_project_shortcut":             {scopeReadWork, scopeManageConfig},
+	"jira_issue_ranking":                {scopeReadWork, scopeWriteWork},
+	"jira_custom_field_options":         {scopeManageConfig},
+	"jira_sprint":                       {scopeReadWork, scopeWriteWork},
+	"jira_worklog":                      {scopeReadWork, scopeWriteWork},
+	"jira_watcher":                      {scopeReadWork, scopeWriteWork},
+	"jira_attachment":                   {scopeReadWork, scopeWriteWork},
+	"data.jira_issue":                   {scopeReadWork},
+	"data.jira_issues_by_key":           {scopeReadWork},
+	"data.jira_project":                 {scopeReadWork},
+	"data.jira_issue_comments":          {scopeReadWork, scopeReadUser},
+	"data.jira_issue_worklogs":          {scopeReadWork, scopeReadUser},
+	"data.jira_issue_activity":          {scopeReadWork},
+	"data.jira_issue_types":             {scopeReadWork},
+	"data.jira_priorities":              {scopeReadWork},
+	"data.jira_statuses":                {scopeReadWork},
+	"data.jira_export":                  {scopeReadWork},
+	"data.jira_destroy_impact":          {scopeReadWork},
+	"data.jira_dependency_graph":        {scopeReadWork},
+	"data.jira_boards":                  {scopeReadWork},
+	"data.jira_board":                   {scopeReadWork},
+	"data.jira_project_worklog_summary": {scopeReadWork, scopeReadUser},
+}
+
+// scopesNote returns a sentence documenting the scopes a type needs, for
+// appending to its schema description.
+func scopesNote(typeName string) string {
+	scopes := scopeRequirements[typeName]
+	if len(scopes) == 0 {
+		return ""
+	}
+	return " Scoped API tokens need: " + strings.Join(scopes, ", ") + "."
+}
+
+// scopeUsers returns the types that need a scope, sorted for stable output.
+func scopeUsers(scope string) []string {
+	var users []string
+	for typeName, scopes := range scopeRequirements {
+		for _, s := range scopes {
+			if s == scope {
+				users = append(users, typeName)
+				break
+			}
+		}
+	}
+	sort.Strings(users)
+	return users
+}
+
+// checkTokenScopes probes every testable scope and reports all missing ones
+// in a single warning, since which types a configuration uses isn't known
+// at configure time.
+func checkTokenScopes(ctx context.Context, jiraClient *client.JiraClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	probed := make([]string, 0, len(scopeProbes))
+	for scope := range scopeProbes {
+		probed = append(probed, scope)
+	}
+	sort.Strings(probed)
+
+	var missing []string
+	for _, scope := range probed {
+		err := jiraClient.Probe(ctx, scopeProbes[scope])
+		if err == nil {
+			continue
+		}
+		if client.IsScopeError(err) {
+			missing = append(missing, fmt.Sprintf("- %s (needed by %s)", scope, strings.Join(scopeUsers(scope), ", ")))
+			continue
+		}
+		tflog.Debug(ctx, "Token scope probe failed", map[string]any{
+			"scope": scope,
+			"error": err.Error(),
+		})
+	}
+
+	if len(missing) > 0 {
+		diags.AddWarning(
+			"API Token Missing Scopes",
+			"The configured scoped API token is not authorized for the following scopes. "+
+				"Resources and data sources that need them will fail with 401 errors:\n\n"+
+				strings.Join(missing, "\n"),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,38 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"log"
+	"sync"
+)
+
+var (
+	shutdownMu    sync.Mutex
+	shutdownHooks []func() error
+)
+
+// onShutdown registers a function to run when the provider process exits.
+// The plugin framework has no stop hook, so main calls Shutdown once the
+// provider server has stopped serving.
+func onShutdown(hook func() error) {
+	shutdownMu.Lock()
+	defer shutdownMu.Unlock()
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// Shutdown runs the registered shutdown hooks. Failures are logged, since
+// Terraform has already stopped listening for diagnostics by then.
+func Shutdown() {
+	shutdownMu.Lock()
+	hooks := shutdownHooks
+	shutdownHooks = nil
+	shutdownMu.Unlock()
+
+	for _, hook := range hooks {
+		if err := hook(); err != nil {
+			log.Printf("[ERROR] provider shutdown: %s", err)
+		}
+	}
+}
@@ -0,0 +1,475 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SprintResource{}
+var _ resource.ResourceWithImportState = &SprintResource{}
+var _ resource.ResourceWithValidateConfig = &SprintResource{}
+
+// NewSprintResource creates a new sprint resource.
+func NewSprintResource() resource.Resource {
+	return &SprintResource{}
+}
+
+// SprintResource defines the resource implementation.
+type SprintResource struct {
+	client *client.JiraClient
+}
+
+// SprintResourceModel describes the resource data model.
+type SprintResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	BoardID   types.Int64  `tfsdk:"board_id"`
+	Name      types.String `tfsdk:"name"`
+	Goal      types.String `tfsdk:"goal"`
+	StartDate types.String `tfsdk:"start_date"`
+	EndDate   types.String `tfsdk:"end_date"`
+	State     types.String `tfsdk:"state"`
+	IssueKeys types.Set    `tfsdk:"issue_keys"`
+}
+
+// Metadata returns the resource type name.
+func (r *SprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sprint"
+}
+
+// Schema defines the schema for the resource.
+func (r *SprintResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira Software sprint on a scrum board, optionally moving issues into it." + scopesNote("jira_sprint"),
+		MarkdownDescription: `
+Manages a Jira Software sprint through the Agile API. New sprints start in the future state;
+starting and completing sprints is left to the board.
+
+Issues listed in ` + "`issue_keys`" + ` are moved into the sprint, and issues removed from the list
+are moved back to the backlog if they are still in the sprint. Only the listed issues are
+tracked, so issues planned into the sprint in the Jira UI don't show up as drift. Don't also set
+` + "`sprint_id`" + ` on the same issues' ` + "`jira_issue`" + ` resources.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_sprint" "sprint_42" {
+  board_id   = 12
+  name       = "Sprint 42"
+  goal       = "Ship the billing migration"
+  start_date = "2026-03-02T09:00:00Z"
+  end_date   = "2026-03-16T17:00:00Z"
+
+  issue_keys = [jira_issue.migration.key, jira_issue.cutover.key]
+}
+` + "```" + `
+
+## Import
+
+Sprints can be imported using the sprint ID:
+
+` + "```bash" + `
+terraform import jira_sprint.sprint_42 137
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The sprint ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"board_id": schema.Int64Attribute{
+				Description: "ID of the scrum board the sprint is created on. Changing this forces a new sprint.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The sprint name.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 30),
+				},
+			},
+			"goal": schema.StringAttribute{
+				Description: "The sprint goal.",
+				Optional:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Planned start, as an RFC 3339 timestamp (2026-03-02T09:00:00Z).",
+				Optional:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Planned end, as an RFC 3339 timestamp. Must be after start_date.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "The sprint state: future, active, or closed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_keys": schema.SetAttribute{
+				Description: "Keys of issues to move into the sprint. Issues removed from the set are moved back to the backlog if they are still in the sprint.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the dates are timestamps in order.
+func (r *SprintResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SprintResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOK := parseSprintDate(data.StartDate, "start_date", resp)
+	end, endOK := parseSprintDate(data.EndDate, "end_date", resp)
+	if startOK && endOK && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end_date"),
+			"Invalid Sprint Dates",
+			"end_date must be after start_date.",
+		)
+	}
+}
+
+// parseSprintDate parses a configured sprint date. It reports false when
+// the date is unset, unknown, or invalid; invalid dates add an error.
+func parseSprintDate(value types.String, attribute string, resp *resource.ValidateConfigResponse) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Sprint Date",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as 2026-03-02T09:00:00Z, got %q.", value.ValueString()),
+		)
+		return time.Time{}, false
+	}
+	return date, true
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *SprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *SprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SprintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira sprint", map[string]any{
+		"board_id": data.BoardID.ValueInt64(),
+		"name":     data.Name.ValueString(),
+	})
+
+	sprint, err := r.client.CreateSprint(ctx, &client.Sprint{
+		Name:          data.Name.ValueString(),
+		Goal:          data.Goal.ValueString(),
+		StartDate:     data.StartDate.ValueString(),
+		EndDate:       data.EndDate.ValueString(),
+		OriginBoardID: data.BoardID.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create sprint", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(sprint.ID, 10))
+	data.State = types.StringValue(sprint.State)
+
+	if !data.IssueKeys.IsNull() {
+		var keys []string
+		resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &keys, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.client.MoveIssuesToSprint(ctx, sprint.ID, keys); err != nil {
+			// The sprint exists, so keep it in state and let the next apply retry.
+			resp.Diagnostics.AddError("Failed to move issues into sprint", err.Error())
+			data.IssueKeys = types.SetNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Created Jira sprint", map[string]any{
+		"id":   sprint.ID,
+		"name": sprint.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *SprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid sprint ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira sprint", map[string]any{
+		"id": id,
+	})
+
+	sprint, err := r.client.GetSprint(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read sprint", err.Error())
+		return
+	}
+
+	data.BoardID = types.Int64Value(sprint.OriginBoardID)
+	data.Name = types.StringValue(sprint.Name)
+	data.State = types.StringValue(sprint.State)
+	data.Goal = types.StringValue(sprint.Goal)
+	if sprint.Goal == "" {
+		data.Goal = types.StringNull()
+	}
+	data.StartDate = sprintDate(sprint.StartDate, data.StartDate)
+	data.EndDate = sprintDate(sprint.EndDate, data.EndDate)
+
+	// Only the listed issues are tracked; issues planned in the UI aren't drift.
+	if !data.IssueKeys.IsNull() {
+		keys, err := r.client.GetSprintIssueKeys(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read sprint issues", err.Error())
+			return
+		}
+		inSprint := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			inSprint[key] = true
+		}
+
+		var tracked []string
+		resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &tracked, false)...)
+		var still []string
+		for _, key := range tracked {
+			if inSprint[key] {
+				still = append(still, key)
+			}
+		}
+
+		issueKeys, diags := types.SetValueFrom(ctx, types.StringType, still)
+		resp.Diagnostics.Append(diags...)
+		data.IssueKeys = issueKeys
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *SprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SprintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid sprint ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira sprint", map[string]any{
+		"id": id,
+	})
+
+	sprint, err := r.client.UpdateSprint(ctx, id, &client.Sprint{
+		Name:      data.Name.ValueString(),
+		Goal:      data.Goal.ValueString(),
+		State:     state.State.ValueString(),
+		StartDate: data.StartDate.ValueString(),
+		EndDate:   data.EndDate.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update sprint", err.Error())
+		return
+	}
+	data.State = types.StringValue(sprint.State)
+
+	var planned, prior []string
+	resp.Diagnostics.Append(data.IssueKeys.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.IssueKeys.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.moveIssues(ctx, id, planned, prior); err != nil {
+		resp.Diagnostics.AddError("Failed to move sprint issues", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira sprint", map[string]any{
+		"id": id,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *SprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseInt(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid sprint ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira sprint", map[string]any{
+		"id": id,
+	})
+
+	err = r.client.DeleteSprint(ctx, id)
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete sprint", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira sprint", map[string]any{
+		"id": id,
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *SprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// moveIssues moves newly listed issues into the sprint and returns issues
+// no longer listed to the backlog, unless they have already left the
+// sprint. Read drops issues moved out in the UI from state, so they count
+// as newly listed and are moved back.
+func (r *SprintResource) moveIssues(ctx context.Context, id int64, planned, prior []string) error {
+	wanted := make(map[string]bool, len(planned))
+	for _, key := range planned {
+		wanted[key] = true
+	}
+	tracked := make(map[string]bool, len(prior))
+	for _, key := range prior {
+		tracked[key] = true
+	}
+
+	var added []string
+	for _, key := range planned {
+		if !tracked[key] {
+			added = append(added, key)
+		}
+	}
+
+	var removed []string
+	for _, key := range prior {
+		if !wanted[key] {
+			removed = append(removed, key)
+		}
+	}
+	if len(removed) > 0 {
+		keys, err := r.client.GetSprintIssueKeys(ctx, id)
+		if err != nil {
+			return err
+		}
+		inSprint := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			inSprint[key] = true
+		}
+
+		var backlog []string
+		for _, key := range removed {
+			if inSprint[key] {
+				backlog = append(backlog, key)
+			}
+		}
+		if len(backlog) > 0 {
+			if err := r.client.MoveIssuesToBacklog(ctx, backlog); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	return r.client.MoveIssuesToSprint(ctx, id, added)
+}
+
+// sprintDate maps a sprint date read from Jira to state, keeping the
+// configured timestamp while it names the same instant, since Jira returns
+// dates in its own format.
+func sprintDate(remote string, prior types.String) types.String {
+	if remote == "" {
+		return types.StringNull()
+	}
+
+	got, err := time.Parse(time.RFC3339, remote)
+	if err == nil && !prior.IsNull() && !prior.IsUnknown() {
+		if want, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && want.Equal(got) {
+			return prior
+		}
+	}
+	return types.StringValue(remote)
+}
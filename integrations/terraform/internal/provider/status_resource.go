@@ -0,0 +1,322 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusResource{}
+var _ resource.ResourceWithImportState = &StatusResource{}
+var _ resource.ResourceWithValidateConfig = &StatusResource{}
+
+// NewStatusResource creates a new status resource.
+func NewStatusResource() resource.Resource {
+	return &StatusResource{}
+}
+
+// StatusResource defines the resource implementation.
+type StatusResource struct {
+	client *client.JiraClient
+}
+
+// StatusResourceModel describes the resource data model.
+type StatusResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Category    types.String `tfsdk:"category"`
+	Description types.String `tfsdk:"description"`
+	Scope       types.String `tfsdk:"scope"`
+	Project     types.String `tfsdk:"project"`
+}
+
+// Metadata returns the resource type name.
+func (r *StatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+// Schema defines the schema for the resource.
+func (r *StatusResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jira workflow status, either global or scoped to a team-managed project." + scopesNote("jira_status"),
+		MarkdownDescription: `
+Manages a Jira workflow status through the Jira Cloud ` + "`/statuses`" + ` API. Statuses can be
+global or scoped to a team-managed project.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_status" "in_review" {
+  name        = "In Review"
+  category    = "IN_PROGRESS"
+  description = "Work is waiting for peer review"
+  scope       = "PROJECT"
+  project     = "PROJ"
+}
+` + "```" + `
+
+## Import
+
+Statuses can be imported using the status ID:
+
+` + "```bash" + `
+terraform import jira_status.in_review 10012
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The status ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The status name.",
+				Required:    true,
+			},
+			"category": schema.StringAttribute{
+				Description: "The status category (TODO, IN_PROGRESS, DONE).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.StatusCategoryToDo, client.StatusCategoryInProgress, client.StatusCategoryDone),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The status description.",
+				Optional:    true,
+			},
+			"scope": schema.StringAttribute{
+				Description: "The status scope (GLOBAL or PROJECT). Defaults to GLOBAL. Changing this forces a new status.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.StatusScopeGlobal),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.StatusScopeGlobal, client.StatusScopeProject),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The team-managed project key that owns the status. Required when scope is PROJECT.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures project is set exactly when the scope requires it.
+func (r *StatusResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StatusResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Scope.IsUnknown() || data.Project.IsUnknown() {
+		return
+	}
+
+	if data.Scope.ValueString() == client.StatusScopeProject && data.Project.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project"),
+			"Missing Project",
+			"A project key must be set when scope is PROJECT.",
+		)
+	}
+
+	if data.Scope.ValueString() != client.StatusScopeProject && !data.Project.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project"),
+			"Unexpected Project",
+			"A project key can only be set when scope is PROJECT.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StatusResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *StatusResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StatusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira status", map[string]any{
+		"name":  data.Name.ValueString(),
+		"scope": data.Scope.ValueString(),
+	})
+
+	scope := client.StatusScope{Type: data.Scope.ValueString()}
+	if scope.Type == client.StatusScopeProject {
+		project, err := r.client.GetProject(ctx, data.Project.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read project", err.Error())
+			return
+		}
+		scope.Project = &client.ProjectIDRef{ID: project.ID}
+	}
+
+	status, err := r.client.CreateStatus(ctx, scope, client.WorkflowStatus{
+		Name:           data.Name.ValueString(),
+		StatusCategory: data.Category.ValueString(),
+		Description:    data.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create status", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(status.ID)
+
+	tflog.Info(ctx, "Created Jira status", map[string]any{
+		"id":   status.ID,
+		"name": status.Name,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *StatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StatusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira status", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	status, err := r.client.GetStatus(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read status", err.Error())
+		return
+	}
+
+	if status == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = types.StringValue(status.Name)
+	data.Category = types.StringValue(status.StatusCategory)
+
+	if status.Description != "" {
+		data.Description = types.StringValue(status.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	if status.Scope != nil {
+		data.Scope = types.StringValue(status.Scope.Type)
+
+		// Imported project-scoped statuses only know the project ID.
+		if status.Scope.Project != nil && data.Project.IsNull() {
+			project, err := r.client.GetProject(ctx, status.Scope.Project.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to read project", err.Error())
+				return
+			}
+			data.Project = types.StringValue(project.Key)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *StatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StatusResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira status", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.UpdateStatus(ctx, client.WorkflowStatus{
+		ID:             data.ID.ValueString(),
+		Name:           data.Name.ValueString(),
+		StatusCategory: data.Category.ValueString(),
+		Description:    data.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update status", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Updated Jira status", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *StatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StatusResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira status", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteStatus(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to delete status", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira status", map[string]any{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports the resource into Terraform state.
+func (r *StatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StatusesDataSource{}
+
+// NewStatusesDataSource creates a new statuses data source.
+func NewStatusesDataSource() datasource.DataSource {
+	return &StatusesDataSource{}
+}
+
+// StatusesDataSource defines the data source implementation.
+type StatusesDataSource struct {
+	client *client.JiraClient
+}
+
+// StatusesDataSourceModel describes the data source data model.
+type StatusesDataSourceModel struct {
+	Project    types.String             `tfsdk:"project"`
+	IssueTypes []IssueTypeStatusesModel `tfsdk:"issue_types"`
+}
+
+// IssueTypeStatusesModel describes the statuses of one issue type.
+type IssueTypeStatusesModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Name     types.String         `tfsdk:"name"`
+	Subtask  types.Bool           `tfsdk:"subtask"`
+	Statuses []ProjectStatusModel `tfsdk:"statuses"`
+}
+
+// ProjectStatusModel describes a single status.
+type ProjectStatusModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+}
+
+// Metadata returns the data source type name.
+func (d *StatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statuses"
+}
+
+// Schema defines the schema for the data source.
+func (d *StatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the statuses of a Jira project, grouped by issue type." + scopesNote("data.jira_statuses"),
+		MarkdownDescription: `
+Lists the statuses each issue type's workflow uses in a project, with their status category
+(` + "`TODO`" + `, ` + "`IN_PROGRESS`" + ` or ` + "`DONE`" + `, as in ` + "`wait_for`" + `).
+
+## Example Usage
+
+` + "```hcl" + `
+data "jira_statuses" "proj" {
+  project = "PROJ"
+}
+
+locals {
+  done_statuses = distinct(flatten([
+    for t in data.jira_statuses.proj.issue_types : [
+      for s in t.statuses : s.name if s.category == "DONE"
+    ]
+  ]))
+}
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project key.",
+				Required:    true,
+			},
+			"issue_types": schema.ListNestedAttribute{
+				Description: "The project's issue types with their statuses, in the order Jira returns them.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The issue type ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The issue type name.",
+							Computed:    true,
+						},
+						"subtask": schema.BoolAttribute{
+							Description: "Whether issues of this type are subtasks.",
+							Computed:    true,
+						},
+						"statuses": schema.ListNestedAttribute{
+							Description: "The statuses of the issue type's workflow.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The status ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The status name.",
+										Computed:    true,
+									},
+									"category": schema.StringAttribute{
+										Description: "The status category: TODO, IN_PROGRESS or DONE, or null when Jira reports none.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira project statuses", map[string]any{
+		"project": data.Project.ValueString(),
+	})
+
+	issueTypes, err := d.client.GetProjectStatuses(ctx, data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read project statuses", err.Error())
+		return
+	}
+
+	data.IssueTypes = make([]IssueTypeStatusesModel, 0, len(issueTypes))
+	for _, issueType := range issueTypes {
+		statuses := make([]ProjectStatusModel, 0, len(issueType.Statuses))
+		for i := range issueType.Statuses {
+			status := &issueType.Statuses[i]
+			statuses = append(statuses, ProjectStatusModel{
+				ID:       types.StringValue(status.ID),
+				Name:     types.StringValue(status.Name),
+				Category: stringOrNull(status.Category()),
+			})
+		}
+		data.IssueTypes = append(data.IssueTypes, IssueTypeStatusesModel{
+			ID:       types.StringValue(issueType.ID),
+			Name:     types.StringValue(issueType.Name),
+			Subtask:  types.BoolValue(issueType.Subtask),
+			Statuses: statuses,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// readDataSource reads d with the given configuration attributes set.
+func readDataSource(t *testing.T, d datasource.DataSource, attributes map[string]attr.Value) datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	// Start from an object with every attribute null.
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	nulls := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attrType, nil)
+	}
+	config := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nulls)}
+	for name, value := range attributes {
+		if diags := config.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, req, &resp)
+	return resp
+}
+
+// newJiraServer serves the given bodies by request path.
+func newJiraServer(t *testing.T, bodies map[string]string) *client.JiraClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages":["Not found"]}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewJiraClient(server.URL, "user@example.com", "token", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestStatusesDataSourceRead(t *testing.T) {
+	c := newJiraServer(t, map[string]string{
+		"/rest/api/3/project/PROJ/statuses": `[
+			{"id":"10001","name":"Task","subtask":false,"statuses":[
+				{"id":"1","name":"To Do","statusCategory":{"key":"new"}},
+				{"id":"3","name":"In Progress","statusCategory":{"key":"indeterminate"}},
+				{"id":"5","name":"Done","statusCategory":{"key":"done"}}]},
+			{"id":"10002","name":"Sub-task","subtask":true,"statuses":[
+				{"id":"9","name":"Legacy"}]}
+		]`,
+	})
+
+	resp := readDataSource(t, &StatusesDataSource{client: c}, map[string]attr.Value{"project": types.StringValue("PROJ")})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var data StatusesDataSourceModel
+	if diags := resp.State.Get(context.Background(), &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := []IssueTypeStatusesModel{
+		{
+			ID: types.StringValue("10001"), Name: types.StringValue("Task"), Subtask: types.BoolValue(false),
+			Statuses: []ProjectStatusModel{
+				{ID: types.StringValue("1"), Name: types.StringValue("To Do"), Category: types.StringValue("TODO")},
+				{ID: types.StringValue("3"), Name: types.StringValue("In Progress"), Category: types.StringValue("IN_PROGRESS")},
+				{ID: types.StringValue("5"), Name: types.StringValue("Done"), Category: types.StringValue("DONE")},
+			},
+		},
+		{
+			ID: types.StringValue("10002"), Name: types.StringValue("Sub-task"), Subtask: types.BoolValue(true),
+			Statuses: []ProjectStatusModel{
+				{ID: types.StringValue("9"), Name: types.StringValue("Legacy"), Category: types.StringNull()},
+			},
+		},
+	}
+	if !reflect.DeepEqual(data.IssueTypes, want) {
+		t.Errorf("issue_types = %+v, want %+v", data.IssueTypes, want)
+	}
+}
+
+func TestStatusesDataSourceReadMissingProject(t *testing.T) {
+	c := newJiraServer(t, nil)
+	resp := readDataSource(t, &StatusesDataSource{client: c}, map[string]attr.Value{"project": types.StringValue("NOPE")})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Failed to read project statuses" {
+		t.Errorf("diagnostics = %v, want a read error", resp.Diagnostics)
+	}
+}
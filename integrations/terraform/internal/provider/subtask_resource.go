@@ -6,8 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -22,6 +22,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SubtaskResource{}
 var _ resource.ResourceWithImportState = &SubtaskResource{}
+var _ resource.ResourceWithModifyPlan = &SubtaskResource{}
+var _ resource.ResourceWithValidateConfig = &SubtaskResource{}
 
 // NewSubtaskResource creates a new subtask resource.
 func NewSubtaskResource() resource.Resource {
@@ -30,7 +32,12 @@ func NewSubtaskResource() resource.Resource {
 
 // SubtaskResource defines the resource implementation.
 type SubtaskResource struct {
-	client *client.JiraClient
+	client          *client.JiraClient
+	description     descriptionText
+	validationRules *validationRules
+	manifest        *keyManifest
+
+	removeWithoutProjectAccess bool
 }
 
 // SubtaskResourceModel describes the resource data model.
@@ -41,8 +48,18 @@ type SubtaskResourceModel struct {
 	ParentKey   types.String `tfsdk:"parent_key"`
 	Summary     types.String `tfsdk:"summary"`
 	Description types.String `tfsdk:"description"`
-	StoryPoints types.Int64  `tfsdk:"story_points"`
-	Status      types.String `tfsdk:"status"`
+
+	DescriptionFormat types.String `tfsdk:"description_format"`
+	StoryPoints       types.Int64  `tfsdk:"story_points"`
+	Status            types.String `tfsdk:"status"`
+
+	AutoTrimSummary types.Bool `tfsdk:"auto_trim_summary"`
+
+	OnDestroy   types.String `tfsdk:"on_destroy"`
+	CloseStatus types.String `tfsdk:"close_status"`
+	CloseLabel  types.String `tfsdk:"close_label"`
+
+	Handle types.Object `tfsdk:"handle"`
 }
 
 // Metadata returns the resource type name.
@@ -53,7 +70,7 @@ func (r *SubtaskResource) Metadata(ctx context.Context, req resource.MetadataReq
 // Schema defines the schema for the resource.
 func (r *SubtaskResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Jira subtask under a parent issue.",
+		Description: "Manages a Jira subtask under a parent issue." + scopesNote("jira_subtask"),
 		MarkdownDescription: `
 Manages a Jira subtask. Subtasks are child issues under a parent Story, Bug, or Task.
 
@@ -94,7 +111,8 @@ resource "jira_subtask" "tests" {
 
 ## Import
 
-Subtasks can be imported using the issue key:
+Subtasks can be imported using the issue key. Importing an issue that isn't a subtask
+fails; import those as ` + "`jira_issue`" + ` instead.
 
 ` + "```bash" + `
 terraform import jira_subtask.example PROJ-456
@@ -130,15 +148,21 @@ terraform import jira_subtask.example PROJ-456
 				},
 			},
 			"summary": schema.StringAttribute{
-				Description: "The subtask summary/title.",
+				Description: "The subtask summary/title. It must be a single line of at most 255 characters, where most emoji count as two, unless auto_trim_summary is set.",
 				Required:    true,
 			},
+			"handle": issueHandleAttribute(),
+			"auto_trim_summary": schema.BoolAttribute{
+				Description: "Collapse whitespace and line breaks in summary into single spaces and truncate it to 255 characters with an ellipsis, with a plan-time warning, instead of rejecting it. For summaries generated from external text; state keeps the configured summary.",
+				Optional:    true,
+			},
 			"description": schema.StringAttribute{
 				Description: "The subtask description.",
 				Optional:    true,
 			},
+			"description_format": descriptionFormatAttribute(),
 			"story_points": schema.Int64Attribute{
-				Description: "Story points estimate.",
+				Description: "Story points estimate. Requires a story points field on the project's subtask screen.",
 				Optional:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
@@ -148,6 +172,9 @@ terraform import jira_subtask.example PROJ-456
 				Description: "The subtask status (read-only).",
 				Computed:    true,
 			},
+			"on_destroy":   onDestroyAttribute(),
+			"close_status": closeStatusAttribute(),
+			"close_label":  closeLabelAttribute(),
 		},
 	}
 }
@@ -158,16 +185,54 @@ func (r *SubtaskResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.JiraClient)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.JiraClient, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.validationRules = providerData.ValidationRules
+	r.description = newDescriptionText(providerData)
+	r.manifest = providerData.KeyManifest
+	r.removeWithoutProjectAccess = providerData.RemoveIssuesWithoutProjectAccess
+}
+
+// ModifyPlan checks new or changed content against the provider's
+// validation rules and previews long description changes.
+func (r *SubtaskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SubtaskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || plan.Summary.IsUnknown() || plan.Description.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || !plan.Summary.Equal(state.Summary) || !plan.Description.Equal(state.Description) {
+		r.validationRules.lint(lintTarget{summary: plan.Summary.ValueString(), description: plan.Description.ValueString()}, &resp.Diagnostics)
+	}
+
+	if !req.State.Raw.IsNull() && !plan.Description.Equal(state.Description) {
+		r.description.previewDiff(state.Description, plan.Description, resp)
+	}
+
+	if !req.State.Raw.IsNull() && !plan.Project.Equal(state.Project) {
+		warnMovedIssueReplacement(ctx, req.Private, state.Key, plan.Project, &resp.Diagnostics)
+	}
+}
+
+// ValidateConfig checks that the summary is one Jira accepts.
+func (r *SubtaskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSummary(ctx, req.Config, &resp.Diagnostics)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -188,23 +253,34 @@ func (r *SubtaskResource) Create(ctx context.Context, req resource.CreateRequest
 	fields := client.IssueFields{
 		Project:   &client.Project{Key: data.Project.ValueString()},
 		Parent:    &client.Parent{Key: data.ParentKey.ValueString()},
-		Summary:   data.Summary.ValueString(),
-		IssueType: &client.IssueType{Name: "Sub-task"},
+		Summary:   sentSummary(data.Summary, data.AutoTrimSummary),
+		IssueType: &client.IssueType{Name: client.SubtaskIssueTypeName},
 	}
 
 	if !data.Description.IsNull() {
-		fields.Description = client.TextToADF(data.Description.ValueString())
+		fields.Description = r.description.forCreate(data.Description, data.DescriptionFormat)
+	}
+
+	if !data.StoryPoints.IsNull() {
+		storyPoints := r.storyPointsField(ctx, data.Project.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := fields.SetCustom(storyPoints, data.StoryPoints.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Failed to set story points", err.Error())
+			return
+		}
 	}
 
 	// Create the subtask
-	issue, err := r.client.CreateIssue(&client.CreateIssueRequest{Fields: fields})
+	issue, err := r.client.CreateIssue(ctx, &client.CreateIssueRequest{Fields: fields})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create subtask", err.Error())
 		return
 	}
 
 	// Fetch the created issue
-	createdIssue, err := r.client.GetIssue(issue.Key)
+	createdIssue, err := r.client.GetIssue(ctx, issue.Key)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read created subtask", err.Error())
 		return
@@ -213,10 +289,15 @@ func (r *SubtaskResource) Create(ctx context.Context, req resource.CreateRequest
 	// Update state
 	data.ID = types.StringValue(createdIssue.ID)
 	data.Key = types.StringValue(createdIssue.Key)
+	handle, diags := issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	data.Handle = handle
 	if createdIssue.Fields.Status != nil {
 		data.Status = types.StringValue(createdIssue.Fields.Status.Name)
 	}
 
+	r.recordKey(data)
+
 	tflog.Info(ctx, "Created Jira subtask", map[string]any{
 		"key":        createdIssue.Key,
 		"parent_key": data.ParentKey.ValueString(),
@@ -235,12 +316,13 @@ func (r *SubtaskResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	tflog.Debug(ctx, "Reading Jira subtask", map[string]any{
 		"key": data.Key.ValueString(),
+		"id":  data.ID.ValueString(),
 	})
 
-	issue, err := r.client.GetIssue(data.Key.ValueString())
+	issue, err := getManagedIssue(ctx, r.client, data.ID, data.Key)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
-			resp.State.RemoveResource(ctx)
+		if client.IsNotFound(err) {
+			removeMissingIssue(ctx, r.client, data.Key.ValueString(), r.removeWithoutProjectAccess, resp)
 			return
 		}
 		resp.Diagnostics.AddError("Failed to read subtask", err.Error())
@@ -248,19 +330,16 @@ func (r *SubtaskResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Update state
+	priorKey, priorProject := data.Key, data.Project
 	data.ID = types.StringValue(issue.ID)
 	data.Key = types.StringValue(issue.Key)
-	data.Summary = types.StringValue(issue.Fields.Summary)
-
-	if issue.Fields.Description != nil {
-		data.Description = types.StringValue(client.ADFToText(issue.Fields.Description))
-	} else {
-		data.Description = types.StringNull()
-	}
+	data.Summary = readSummary(issue.Fields.Summary, data.Summary, data.AutoTrimSummary)
+	resp.Diagnostics.Append(r.description.read(ctx, resp.Private, issue.Fields.Description, &data.Description, &data.DescriptionFormat)...)
 
 	if issue.Fields.Project != nil {
-		data.Project = types.StringValue(issue.Fields.Project.Key)
+		data.Project = readProjectKey(ctx, r.client, data.Project, issue.Fields.Project.Key, &resp.Diagnostics)
 	}
+	warnRenamedIssue(ctx, resp.Private, priorKey, priorProject, data.Project, issue, false, &resp.Diagnostics)
 
 	if issue.Fields.Status != nil {
 		data.Status = types.StringValue(issue.Fields.Status.Name)
@@ -270,13 +349,39 @@ func (r *SubtaskResource) Read(ctx context.Context, req resource.ReadRequest, re
 		data.ParentKey = types.StringValue(issue.Fields.Parent.Key)
 	}
 
+	// Story points are only refreshed once managed; ImportState seeds them.
+	if !data.StoryPoints.IsNull() {
+		storyPoints, err := r.client.SubtaskStoryPointsFieldID(ctx, data.Project.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to look up story points field", err.Error())
+			return
+		}
+		if points, ok := issue.Fields.CustomNumber(storyPoints); ok && storyPoints != "" {
+			if points == float64(int64(points)) {
+				data.StoryPoints = types.Int64Value(int64(points))
+			} else {
+				resp.Diagnostics.AddWarning(
+					"Story Points Not Refreshed",
+					fmt.Sprintf("%s has %g story points, but story_points only holds whole numbers.", issue.Key, points),
+				)
+			}
+		} else {
+			data.StoryPoints = types.Int64Null()
+		}
+	}
+
+	handle, diags := issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	data.Handle = handle
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 // Update updates the resource.
 func (r *SubtaskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data SubtaskResourceModel
+	var data, state SubtaskResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -286,21 +391,35 @@ func (r *SubtaskResource) Update(ctx context.Context, req resource.UpdateRequest
 	})
 
 	fields := client.IssueFields{
-		Summary: data.Summary.ValueString(),
+		Summary: sentSummary(data.Summary, data.AutoTrimSummary),
 	}
 
-	if !data.Description.IsNull() {
-		fields.Description = client.TextToADF(data.Description.ValueString())
+	resp.Diagnostics.Append(r.description.setForUpdate(ctx, req.Private, &fields, data.Description, data.DescriptionFormat, !state.Description.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.StoryPoints.Equal(state.StoryPoints) {
+		storyPoints := r.storyPointsField(ctx, data.Project.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.StoryPoints.IsNull() {
+			fields.Clear = append(fields.Clear, storyPoints)
+		} else if err := fields.SetCustom(storyPoints, data.StoryPoints.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Failed to set story points", err.Error())
+			return
+		}
 	}
 
-	err := r.client.UpdateIssue(data.Key.ValueString(), &client.UpdateIssueRequest{Fields: fields})
+	err := r.client.UpdateIssue(ctx, data.Key.ValueString(), &client.UpdateIssueRequest{Fields: fields})
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update subtask", err.Error())
 		return
 	}
 
 	// Fetch updated issue
-	issue, err := r.client.GetIssue(data.Key.ValueString())
+	issue, err := r.client.GetIssue(ctx, data.Key.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read updated subtask", err.Error())
 		return
@@ -310,10 +429,18 @@ func (r *SubtaskResource) Update(ctx context.Context, req resource.UpdateRequest
 		data.Status = types.StringValue(issue.Fields.Status.Name)
 	}
 
+	handle, diags := issueHandle(ctx, r.client, data.Key, data.ID, data.Project)
+	resp.Diagnostics.Append(diags...)
+	data.Handle = handle
+
+	r.recordKey(data)
+
 	tflog.Info(ctx, "Updated Jira subtask", map[string]any{
 		"key": data.Key.ValueString(),
 	})
 
+	// An applied configuration settles a move made in Jira.
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, issueMovedKey, nil)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -329,21 +456,98 @@ func (r *SubtaskResource) Delete(ctx context.Context, req resource.DeleteRequest
 		"key": data.Key.ValueString(),
 	})
 
-	err := r.client.DeleteIssue(data.Key.ValueString())
+	err := destroyIssue(ctx, r.client, data.Key.ValueString(), data.OnDestroy, data.CloseStatus, data.CloseLabel, false)
 	if err != nil {
-		if !strings.Contains(err.Error(), "404") {
-			resp.Diagnostics.AddError("Failed to delete subtask", err.Error())
-			return
-		}
+		resp.Diagnostics.AddError("Failed to delete subtask", err.Error())
+		return
 	}
 
+	r.manifest.remove(data.Key.ValueString())
+
 	tflog.Info(ctx, "Deleted Jira subtask", map[string]any{
 		"key": data.Key.ValueString(),
 	})
 }
 
-// ImportState imports the resource.
+// recordKey adds the subtask to the provider's key manifest, if configured.
+func (r *SubtaskResource) recordKey(data SubtaskResourceModel) {
+	r.manifest.record(keyManifestEntry{
+		Key:          data.Key.ValueString(),
+		URL:          r.client.BrowseURL(data.Key.ValueString()),
+		ResourceType: "jira_subtask",
+		Project:      data.Project.ValueString(),
+		Summary:      data.Summary.ValueString(),
+	})
+}
+
+// ImportState imports a subtask by key. The issue is fetched up front so
+// imports of other issue types fail with guidance, and so the attributes
+// Read doesn't manage (parent_key, project, story_points) match Jira and the
+// first plan after import is clean.
 func (r *SubtaskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("key"), req, resp)
+	issue, err := r.client.GetIssue(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read subtask", err.Error())
+		return
+	}
+
+	if issue.Fields.IssueType == nil || !issue.Fields.IssueType.Subtask {
+		issueType := "an issue"
+		if issue.Fields.IssueType != nil {
+			issueType = "a " + issue.Fields.IssueType.Name
+		}
+		resp.Diagnostics.AddError(
+			"Not a Subtask",
+			fmt.Sprintf("%s is %s, not a subtask. Import it as a jira_issue instead:\n\n  terraform import jira_issue.<name> %s", issue.Key, issueType, issue.Key),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), issue.Key)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), issue.ID)...)
+	if issue.Fields.Project != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), issue.Fields.Project.Key)...)
+	}
+	if issue.Fields.Parent != nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_key"), issue.Fields.Parent.Key)...)
+	}
+
+	if issue.Fields.Project == nil {
+		return
+	}
+	storyPoints, err := r.client.SubtaskStoryPointsFieldID(ctx, issue.Fields.Project.Key)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Story Points Not Imported", "Could not look up the story points field: "+err.Error())
+		return
+	}
+	if points, ok := issue.Fields.CustomNumber(storyPoints); ok && storyPoints != "" {
+		if points != float64(int64(points)) {
+			resp.Diagnostics.AddWarning(
+				"Story Points Not Imported",
+				fmt.Sprintf("%s has %g story points, but story_points only holds whole numbers.", issue.Key, points),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("story_points"), int64(points))...)
+	}
 }
 
+// storyPointsField returns the story points field on the project's subtask
+// screen. Jira rejects fields that aren't on the screen with a bare 400, so
+// a missing field is reported against story_points instead.
+func (r *SubtaskResource) storyPointsField(ctx context.Context, project string, diags *diag.Diagnostics) string {
+	storyPoints, err := r.client.SubtaskStoryPointsFieldID(ctx, project)
+	if err != nil {
+		diags.AddError("Failed to look up story points field", err.Error())
+		return ""
+	}
+	if storyPoints == "" {
+		diags.AddAttributeError(
+			path.Root("story_points"),
+			"Story Points Not Available",
+			fmt.Sprintf("Project %s has no story points field on its subtask screen. "+
+				"Add the Story Points field to the subtask screen in Jira, or remove story_points from the configuration.", project),
+		)
+	}
+	return storyPoints
+}
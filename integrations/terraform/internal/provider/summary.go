@@ -0,0 +1,153 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maxSummaryLength is the longest summary Jira accepts. Jira counts UTF-16
+// code units, so characters outside the Basic Multilingual Plane, such as
+// most emoji, count twice; see summaryLength.
+const maxSummaryLength = 255
+
+// summaryEllipsis marks a summary shortened by auto_trim_summary.
+const summaryEllipsis = "…"
+
+// validateSummary rejects summaries Jira would refuse with a bare 400: ones
+// containing line breaks or longer than maxSummaryLength characters. With
+// auto_trim_summary set they are trimmed instead, with a warning.
+func validateSummary(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) {
+	var summary types.String
+	var autoTrim types.Bool
+	diags.Append(config.GetAttribute(ctx, path.Root("summary"), &summary)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("auto_trim_summary"), &autoTrim)...)
+	if diags.HasError() || summary.IsNull() || summary.IsUnknown() {
+		return
+	}
+
+	value := summary.ValueString()
+	if autoTrim.ValueBool() {
+		if trimmed := trimSummary(value); trimmed != value {
+			diags.AddAttributeWarning(
+				path.Root("summary"),
+				"Summary Will Be Trimmed",
+				fmt.Sprintf("The summary is sent to Jira as %q.", trimmed),
+			)
+		}
+		return
+	}
+
+	if strings.ContainsAny(value, "\r\n") {
+		diags.AddAttributeError(
+			path.Root("summary"),
+			"Summary Contains Line Breaks",
+			"Jira summaries must be a single line. Remove the line breaks, or set auto_trim_summary to collapse them into spaces.",
+		)
+	}
+	if length := summaryLength(value); length > maxSummaryLength {
+		diags.AddAttributeError(
+			path.Root("summary"),
+			"Summary Too Long",
+			fmt.Sprintf("The summary is %d characters long, but Jira allows at most %d. "+
+				"Jira counts most emoji and other characters outside the Basic Multilingual Plane twice. "+
+				"Shorten it, or set auto_trim_summary to truncate it.", length, maxSummaryLength),
+		)
+	}
+}
+
+// trimSummary collapses runs of whitespace, including line breaks, into
+// single spaces and truncates the result to maxSummaryLength, ending it with
+// an ellipsis when it was cut.
+func trimSummary(summary string) string {
+	trimmed := strings.Join(strings.Fields(summary), " ")
+	if summaryLength(trimmed) <= maxSummaryLength {
+		return trimmed
+	}
+
+	cut := cutText(trimmed, maxSummaryLength-summaryLength(summaryEllipsis), utf16Len)
+	return strings.TrimRight(cut, " ") + summaryEllipsis
+}
+
+// summaryLength returns the length of a summary as Jira measures it, in
+// UTF-16 code units.
+func summaryLength(summary string) int {
+	length := 0
+	for _, r := range summary {
+		length += utf16Len(r)
+	}
+	return length
+}
+
+// utf16Len returns the number of UTF-16 code units that encode r.
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// cutText returns the longest prefix of text no longer than limit, where
+// each rune counts runeLen(rune). The cut never separates a character from
+// the combining marks, variation selectors, skin tone modifiers or
+// zero-width joiners that belong to it, so accents and composed emoji are
+// dropped whole rather than left broken.
+func cutText(text string, limit int, runeLen func(rune) int) string {
+	end, length := 0, 0
+	for i, r := range text {
+		length += runeLen(r)
+		if length > limit {
+			break
+		}
+		end = i + utf8.RuneLen(r)
+	}
+
+	for end > 0 && end < len(text) {
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		last, size := utf8.DecodeLastRuneInString(text[:end])
+		if !extendsCharacter(next) && last != zeroWidthJoiner {
+			break
+		}
+		end -= size
+	}
+	return text[:end]
+}
+
+// zeroWidthJoiner joins emoji into a single character, e.g. 👩‍💻.
+const zeroWidthJoiner = '\u200d'
+
+// extendsCharacter reports whether r modifies the character before it
+// rather than starting a new one.
+func extendsCharacter(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner ||
+		(r >= 0x1F3FB && r <= 0x1F3FF) // emoji skin tone modifiers
+}
+
+// sentSummary returns the summary to send to Jira for a planned summary.
+func sentSummary(summary types.String, autoTrim types.Bool) string {
+	if autoTrim.ValueBool() {
+		return trimSummary(summary.ValueString())
+	}
+	return summary.ValueString()
+}
+
+// readSummary returns the summary for state. A summary Jira holds in the
+// trimmed form of the prior one keeps the configured text, so trimming
+// doesn't show as drift.
+func readSummary(summary string, prior types.String, autoTrim types.Bool) types.String {
+	if autoTrim.ValueBool() && !prior.IsNull() && trimSummary(prior.ValueString()) == summary {
+		return prior
+	}
+	return types.StringValue(summary)
+}
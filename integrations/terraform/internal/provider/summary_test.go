@@ -0,0 +1,196 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// issueConfig returns a jira_issue configuration with the given attributes
+// set and every other attribute null.
+func issueConfig(t *testing.T, attributes map[string]attr.Value) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&IssueResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attributes {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+	return tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+}
+
+func TestValidateSummary(t *testing.T) {
+	long := strings.Repeat("a", maxSummaryLength)
+
+	tests := []struct {
+		name         string
+		summary      types.String
+		autoTrim     bool
+		wantErrors   []string
+		wantWarnings int
+	}{
+		{name: "valid", summary: types.StringValue("Fix login")},
+		{name: "longest", summary: types.StringValue(long)},
+		{name: "unknown", summary: types.StringUnknown()},
+		{name: "line break", summary: types.StringValue("Fix\nlogin"), wantErrors: []string{"Summary Contains Line Breaks"}},
+		{name: "carriage return", summary: types.StringValue("Fix\rlogin"), wantErrors: []string{"Summary Contains Line Breaks"}},
+		{name: "too long", summary: types.StringValue(long + "a"), wantErrors: []string{"Summary Too Long"}},
+		{name: "emoji count twice", summary: types.StringValue(long[1:] + "🚀"), wantErrors: []string{"Summary Too Long"}},
+		{name: "both", summary: types.StringValue(long + "\n"), wantErrors: []string{"Summary Contains Line Breaks", "Summary Too Long"}},
+		{name: "auto-trimmed", summary: types.StringValue(long + "\n"), autoTrim: true, wantWarnings: 1},
+		{name: "auto-trim unneeded", summary: types.StringValue("Fix login"), autoTrim: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := issueConfig(t, map[string]attr.Value{
+				"summary":           tt.summary,
+				"auto_trim_summary": types.BoolValue(tt.autoTrim),
+			})
+
+			var diags diag.Diagnostics
+			validateSummary(context.Background(), config, &diags)
+
+			var errors []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			if strings.Join(errors, ", ") != strings.Join(tt.wantErrors, ", ") {
+				t.Errorf("errors = %v, want %v", errors, tt.wantErrors)
+			}
+			if n := diags.WarningsCount(); n != tt.wantWarnings {
+				t.Errorf("got %d warnings, want %d", n, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestTrimSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary string
+		want    string
+	}{
+		{"unchanged", "Fix login", "Fix login"},
+		{"whitespace collapsed", "  Fix\n\tlogin \r\n on Safari ", "Fix login on Safari"},
+		{"longest kept", strings.Repeat("a", maxSummaryLength), strings.Repeat("a", maxSummaryLength)},
+		{"truncated", strings.Repeat("a", maxSummaryLength+1), strings.Repeat("a", maxSummaryLength-1) + summaryEllipsis},
+		{"no space before ellipsis", strings.Repeat("a", maxSummaryLength-2) + " bb", strings.Repeat("a", maxSummaryLength-2) + summaryEllipsis},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trimSummary(tt.summary)
+			if got != tt.want {
+				t.Errorf("trimSummary() = %q, want %q", got, tt.want)
+			}
+			if n := summaryLength(got); n > maxSummaryLength {
+				t.Errorf("trimmed summary is %d long", n)
+			}
+		})
+	}
+}
+
+func TestSentAndReadSummary(t *testing.T) {
+	configured := types.StringValue("Fix\nlogin")
+	on, off := types.BoolValue(true), types.BoolNull()
+
+	if got := sentSummary(configured, on); got != "Fix login" {
+		t.Errorf("sentSummary(auto-trim) = %q, want %q", got, "Fix login")
+	}
+	if got := sentSummary(configured, off); got != "Fix\nlogin" {
+		t.Errorf("sentSummary() = %q, want the summary as configured", got)
+	}
+
+	tests := []struct {
+		name     string
+		remote   string
+		prior    types.String
+		autoTrim types.Bool
+		want     types.String
+	}{
+		{"trimmed form keeps configured text", "Fix login", configured, on, configured},
+		{"renamed in Jira", "Fix logout", configured, on, types.StringValue("Fix logout")},
+		{"auto-trim off", "Fix login", configured, off, types.StringValue("Fix login")},
+		{"import", "Fix login", types.StringNull(), on, types.StringValue("Fix login")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readSummary(tt.remote, tt.prior, tt.autoTrim); !got.Equal(tt.want) {
+				t.Errorf("readSummary() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCutText(t *testing.T) {
+	runes := func(rune) int { return 1 }
+
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  string
+	}{
+		{"ascii", "abcdef", 3, "abc"},
+		{"fits", "abc", 5, "abc"},
+		{"multibyte", "héllo", 2, "hé"},
+		{"combining mark dropped with its letter", "abe\u0301", 3, "ab"},
+		{"combining mark kept with its letter", "abe\u0301x", 4, "abe\u0301"},
+		{"variation selector", "ab\u2764\ufe0f", 3, "ab"},
+		{"skin tone", "ab\U0001F44D\U0001F3FD", 3, "ab"},
+		{"zero-width joiner sequence", "ab\U0001F469\u200d\U0001F4BB", 4, "ab"},
+		{"nothing fits", "e\u0301", 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cutText(tt.text, tt.limit, runes); got != tt.want {
+				t.Errorf("cutText(%q, %d) = %q, want %q", tt.text, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrimSummaryUnicode(t *testing.T) {
+	// The ellipsis leaves room for 254 code units; the emoji would need
+	// code units 254 and 255.
+	summary := strings.Repeat("a", maxSummaryLength-2) + "🚀🚀"
+	want := strings.Repeat("a", maxSummaryLength-2) + summaryEllipsis
+	if got := trimSummary(summary); got != want {
+		t.Errorf("trimSummary() = %q, want %q", got, want)
+	}
+
+	if got, want := summaryLength("a🚀é"), 4; got != want {
+		t.Errorf("summaryLength() = %d, want %d", got, want)
+	}
+}
+
+func TestCommentSummary(t *testing.T) {
+	long := strings.Repeat("a", activitySummaryLength-2) + "e\u0301e\u0301"
+	want := strings.Repeat("a", activitySummaryLength-2) + "…"
+	if got := commentSummary(long); got != want {
+		t.Errorf("commentSummary() = %q, want %q", got, want)
+	}
+	if got := commentSummary("  Short\n\ncomment "); got != "Short comment" {
+		t.Errorf("commentSummary() = %q, want %q", got, "Short comment")
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Validation rule severities.
+const (
+	ruleSeverityWarning = "warning"
+	ruleSeverityError   = "error"
+)
+
+// ValidationRulesModel describes the provider's validation_rules attribute.
+type ValidationRulesModel struct {
+	Severity                         types.String `tfsdk:"severity"`
+	MaxSummaryLength                 types.Int64  `tfsdk:"max_summary_length"`
+	ForbidWords                      types.List   `tfsdk:"forbid_words"`
+	RequireAcceptanceCriteriaHeading types.Bool   `tfsdk:"require_acceptance_criteria_heading"`
+}
+
+// validationRules is the parsed rule configuration shared with resources.
+type validationRules struct {
+	severity                  string
+	maxSummaryLength          int
+	forbidWords               []string
+	requireAcceptanceCriteria bool
+}
+
+// lintTarget is the issue content a rule inspects.
+type lintTarget struct {
+	summary     string
+	description string
+}
+
+// validationRule checks one aspect of an issue. check returns a message
+// describing the violation, or "" when the content passes or the rule is
+// not configured.
+type validationRule struct {
+	name      string
+	attribute string
+	check     func(rules *validationRules, target lintTarget) string
+}
+
+// validationRuleTable lists every rule; new rules only need an entry here
+// and a field in ValidationRulesModel.
+var validationRuleTable = []validationRule{
+	{
+		name:      "max_summary_length",
+		attribute: "summary",
+		check: func(rules *validationRules, target lintTarget) string {
+			length := len([]rune(target.summary))
+			if rules.maxSummaryLength > 0 && length > rules.maxSummaryLength {
+				return fmt.Sprintf("The summary is %d characters long; the limit is %d.", length, rules.maxSummaryLength)
+			}
+			return ""
+		},
+	},
+	{
+		name:      "forbid_words",
+		attribute: "summary",
+		check: func(rules *validationRules, target lintTarget) string {
+			text := strings.ToLower(target.summary + "\n" + target.description)
+			var found []string
+			for _, word := range rules.forbidWords {
+				if word != "" && strings.Contains(text, strings.ToLower(word)) {
+					found = append(found, fmt.Sprintf("%q", word))
+				}
+			}
+			if len(found) > 0 {
+				return "The summary or description contains forbidden words: " + strings.Join(found, ", ") + "."
+			}
+			return ""
+		},
+	},
+	{
+		name:      "require_acceptance_criteria_heading",
+		attribute: "description",
+		check: func(rules *validationRules, target lintTarget) string {
+			if rules.requireAcceptanceCriteria && !hasAcceptanceCriteriaHeading(target.description) {
+				return "The description has no \"Acceptance Criteria\" heading."
+			}
+			return ""
+		},
+	},
+}
+
+// hasAcceptanceCriteriaHeading reports whether a line of the description is
+// an "Acceptance Criteria" heading, written as a Markdown heading, a wiki
+// markup heading (h2.), or a line of its own, optionally bold or with a
+// trailing colon.
+func hasAcceptanceCriteriaHeading(description string) bool {
+	for _, line := range strings.Split(description, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimLeft(line, "#")
+		for level := '1'; level <= '6'; level++ {
+			line = strings.TrimPrefix(line, "h"+string(level)+".")
+		}
+		line = strings.Trim(strings.TrimSpace(line), "*_:")
+		if strings.EqualFold(strings.TrimSpace(line), "acceptance criteria") {
+			return true
+		}
+	}
+	return false
+}
+
+// newValidationRules parses the provider's validation_rules attribute. It
+// returns nil when no rules are configured.
+func newValidationRules(ctx context.Context, model *ValidationRulesModel) (*validationRules, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if model == nil {
+		return nil, diags
+	}
+
+	rules := &validationRules{
+		severity:                  ruleSeverityWarning,
+		maxSummaryLength:          int(model.MaxSummaryLength.ValueInt64()),
+		requireAcceptanceCriteria: model.RequireAcceptanceCriteriaHeading.ValueBool(),
+	}
+	if !model.Severity.IsNull() {
+		rules.severity = model.Severity.ValueString()
+	}
+	if !model.ForbidWords.IsNull() {
+		diags.Append(model.ForbidWords.ElementsAs(ctx, &rules.forbidWords, false)...)
+	}
+
+	return rules, diags
+}
+
+// lint evaluates every rule against an issue, reporting violations as
+// warnings or errors according to the configured severity.
+func (rules *validationRules) lint(target lintTarget, diags *diag.Diagnostics) {
+	if rules == nil {
+		return
+	}
+
+	for _, rule := range validationRuleTable {
+		msg := rule.check(rules, target)
+		if msg == "" {
+			continue
+		}
+
+		summary := "Validation rule " + rule.name + " failed"
+		if rules.severity == ruleSeverityError {
+			diags.AddAttributeError(path.Root(rule.attribute), summary, msg)
+		} else {
+			diags.AddAttributeWarning(path.Root(rule.attribute), summary, msg)
+		}
+	}
+}
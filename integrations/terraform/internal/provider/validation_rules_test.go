@@ -0,0 +1,132 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewValidationRules(t *testing.T) {
+	rules, diags := newValidationRules(context.Background(), nil)
+	if rules != nil || diags.HasError() {
+		t.Errorf("newValidationRules(nil) = %+v, %v, want nil", rules, diags)
+	}
+
+	rules, diags = newValidationRules(context.Background(), &ValidationRulesModel{
+		Severity:                         types.StringNull(),
+		MaxSummaryLength:                 types.Int64Value(80),
+		ForbidWords:                      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TODO")}),
+		RequireAcceptanceCriteriaHeading: types.BoolValue(true),
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	want := &validationRules{
+		severity:                  ruleSeverityWarning,
+		maxSummaryLength:          80,
+		forbidWords:               []string{"TODO"},
+		requireAcceptanceCriteria: true,
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("newValidationRules() = %+v, want %+v", rules, want)
+	}
+}
+
+func TestValidationRulesLint(t *testing.T) {
+	rules := &validationRules{
+		severity:                  ruleSeverityWarning,
+		maxSummaryLength:          10,
+		forbidWords:               []string{"todo", "", "FIXME"},
+		requireAcceptanceCriteria: true,
+	}
+
+	tests := []struct {
+		name   string
+		target lintTarget
+		want   map[string]path.Path
+	}{
+		{
+			name:   "passes",
+			target: lintTarget{summary: "Short", description: "## Acceptance Criteria\n- works"},
+			want:   map[string]path.Path{},
+		},
+		{
+			name:   "summary length counts runes",
+			target: lintTarget{summary: "éééééééééé", description: "Acceptance criteria:"},
+			want:   map[string]path.Path{},
+		},
+		{
+			name:   "every rule fails",
+			target: lintTarget{summary: "A summary that is too long", description: "fixme later"},
+			want: map[string]path.Path{
+				"Validation rule max_summary_length failed":                  path.Root("summary"),
+				"Validation rule forbid_words failed":                        path.Root("summary"),
+				"Validation rule require_acceptance_criteria_heading failed": path.Root("description"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			rules.lint(tt.target, &diags)
+
+			got := make(map[string]path.Path)
+			for _, d := range diags {
+				if d.Severity() != diag.SeverityWarning {
+					t.Errorf("%s has severity %s, want a warning", d.Summary(), d.Severity())
+				}
+				got[d.Summary()] = d.(diag.DiagnosticWithPath).Path()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("lint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationRulesLintSeverity(t *testing.T) {
+	rules := &validationRules{severity: ruleSeverityError, forbidWords: []string{"wip"}}
+
+	var diags diag.Diagnostics
+	rules.lint(lintTarget{summary: "WIP: login"}, &diags)
+	if !diags.HasError() || len(diags) != 1 {
+		t.Fatalf("lint() = %v, want one error", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, `"wip"`) {
+		t.Errorf("detail = %q, want it to name the word", detail)
+	}
+
+	// Unconfigured rules never run.
+	var none *validationRules
+	none.lint(lintTarget{summary: "WIP"}, &diags)
+	if len(diags) != 1 {
+		t.Errorf("nil rules added diagnostics: %v", diags)
+	}
+}
+
+func TestHasAcceptanceCriteriaHeading(t *testing.T) {
+	tests := map[string]bool{
+		"## Acceptance Criteria":             true,
+		"h2. Acceptance Criteria":            true,
+		"*Acceptance criteria:*":             true,
+		"  ACCEPTANCE CRITERIA  ":            true,
+		"Intro\n\n### Acceptance Criteria\n": true,
+		"See the acceptance criteria below":  false,
+		"":                                   false,
+	}
+	for description, want := range tests {
+		if got := hasAcceptanceCriteriaHeading(description); got != want {
+			t.Errorf("hasAcceptanceCriteriaHeading(%q) = %v, want %v", description, got, want)
+		}
+	}
+}
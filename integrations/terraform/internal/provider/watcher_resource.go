@@ -0,0 +1,260 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WatcherResource{}
+var _ resource.ResourceWithImportState = &WatcherResource{}
+
+// NewWatcherResource creates a new watcher resource.
+func NewWatcherResource() resource.Resource {
+	return &WatcherResource{}
+}
+
+// WatcherResource defines the resource implementation.
+type WatcherResource struct {
+	client *client.JiraClient
+}
+
+// WatcherResourceModel describes the resource data model.
+type WatcherResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	IssueKey  types.String `tfsdk:"issue_key"`
+	AccountID types.String `tfsdk:"account_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *WatcherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_watcher"
+}
+
+// Schema defines the schema for the resource.
+func (r *WatcherResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes a user watch a Jira issue." + scopesNote("jira_watcher"),
+		MarkdownDescription: `
+Makes a user watch a Jira issue. Other watchers of the issue are left alone. If the user
+stops watching the issue outside Terraform, the watcher is removed from state and added
+again on the next apply.
+
+Reading watchers requires the **View Voters and Watchers** project permission, and adding
+or removing anyone other than the provider's own account requires **Manage Watchers**.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_watcher" "on_call" {
+  issue_key  = jira_issue.incident.key
+  account_id = var.on_call_lead_account_id
+}
+` + "```" + `
+
+## Import
+
+Watchers can be imported using the issue key and account ID separated by a colon:
+
+` + "```bash" + `
+terraform import jira_watcher.on_call INC-42:5b10ac8d82e05b22cc7d4ef5
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The issue key and account ID separated by a colon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to watch (e.g., PROJ-123). Changing this forces a new watcher.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				Description: "The account ID of the watching user (the username on Jira Server and Data Center). Changing this forces a new watcher.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WatcherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WatcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WatcherResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Adding Jira watcher", map[string]any{
+		"issue_key":  data.IssueKey.ValueString(),
+		"account_id": data.AccountID.ValueString(),
+	})
+
+	err := r.client.AddWatcher(ctx, data.IssueKey.ValueString(), data.AccountID.ValueString())
+	if err != nil {
+		addWatcherError(&resp.Diagnostics, "add watcher", "Manage Watchers", err)
+		return
+	}
+
+	data.ID = types.StringValue(data.IssueKey.ValueString() + ":" + data.AccountID.ValueString())
+
+	tflog.Info(ctx, "Added Jira watcher", map[string]any{
+		"issue_key":  data.IssueKey.ValueString(),
+		"account_id": data.AccountID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read removes the watcher from state when the user no longer watches the
+// issue.
+func (r *WatcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WatcherResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira watchers", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+	})
+
+	watchers, err := r.client.GetWatchers(ctx, data.IssueKey.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addWatcherError(&resp.Diagnostics, "read watchers", "View Voters and Watchers", err)
+		return
+	}
+
+	watching := false
+	for _, watcher := range watchers {
+		if userAccountID(&watcher).ValueString() == data.AccountID.ValueString() {
+			watching = true
+			break
+		}
+	}
+	if !watching {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.IssueKey.ValueString() + ":" + data.AccountID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called with changes, since every argument forces a new
+// watcher; it only carries the state forward.
+func (r *WatcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WatcherResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WatcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WatcherResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Removing Jira watcher", map[string]any{
+		"issue_key":  data.IssueKey.ValueString(),
+		"account_id": data.AccountID.ValueString(),
+	})
+
+	err := r.client.RemoveWatcher(ctx, data.IssueKey.ValueString(), data.AccountID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		addWatcherError(&resp.Diagnostics, "remove watcher", "Manage Watchers", err)
+		return
+	}
+
+	tflog.Info(ctx, "Removed Jira watcher", map[string]any{
+		"issue_key":  data.IssueKey.ValueString(),
+		"account_id": data.AccountID.ValueString(),
+	})
+}
+
+// ImportState imports a watcher from an "<issue key>:<account id>" ID.
+func (r *WatcherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	issueKey, accountID, ok := strings.Cut(req.ID, ":")
+	if !ok || issueKey == "" || accountID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <issue key>:<account id> (e.g., INC-42:5b10ac8d82e05b22cc7d4ef5), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_key"), issueKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), accountID)...)
+}
+
+// addWatcherError reports a failed watcher request. Jira answers 403 when
+// the provider's account lacks the project permission the request needs, so
+// that case names the permission instead of passing on the bare API error.
+func addWatcherError(diags *diag.Diagnostics, action, permission string, err error) {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden {
+		diags.AddError(
+			"Missing Watcher Permission",
+			fmt.Sprintf("Jira refused to %s: %s\n\n"+
+				"The provider's account needs the %q permission in the issue's project. "+
+				"Ask a Jira administrator to grant it through the project's permission scheme.",
+				action, err.Error(), permission),
+		)
+		return
+	}
+	diags.AddError("Failed to "+action, err.Error())
+}
@@ -0,0 +1,407 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/spectra/terraform-provider-jira/internal/client"
+)
+
+// Jira's default time tracking settings, used to compare durations given in
+// days and weeks with the seconds Jira reports.
+const (
+	worklogSecondsPerDay  = 8 * 60 * 60
+	worklogSecondsPerWeek = 5 * worklogSecondsPerDay
+)
+
+var (
+	worklogDurationPattern = regexp.MustCompile(`^\s*(\d+[wdhm]\s*)+$|^\s*\d+\s*$`)
+	worklogDurationPart    = regexp.MustCompile(`(\d+)([wdhm])`)
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorklogResource{}
+var _ resource.ResourceWithImportState = &WorklogResource{}
+var _ resource.ResourceWithValidateConfig = &WorklogResource{}
+
+// NewWorklogResource creates a new worklog resource.
+func NewWorklogResource() resource.Resource {
+	return &WorklogResource{}
+}
+
+// WorklogResource defines the resource implementation.
+type WorklogResource struct {
+	client *client.JiraClient
+}
+
+// WorklogResourceModel describes the resource data model.
+type WorklogResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	IssueKey         types.String `tfsdk:"issue_key"`
+	TimeSpent        types.String `tfsdk:"time_spent"`
+	TimeSpentSeconds types.Int64  `tfsdk:"time_spent_seconds"`
+	Started          types.String `tfsdk:"started"`
+	Comment          types.String `tfsdk:"comment"`
+	AuthorAccountID  types.String `tfsdk:"author_account_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *WorklogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worklog"
+}
+
+// Schema defines the schema for the resource.
+func (r *WorklogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a worklog (time logged) on a Jira issue." + scopesNote("jira_worklog"),
+		MarkdownDescription: `
+Manages a worklog on a Jira issue. ` + "`time_spent`" + ` takes Jira's duration format (e.g. ` + "`3h 30m`" + `)
+or a number of seconds. Jira normalizes durations, so the configured value is kept while it
+amounts to the same time; days and weeks are counted as 8 and 40 hours, Jira's defaults.
+
+Jira adjusts the issue's remaining estimate when worklogs are added, changed, or deleted.
+If the worklog or its issue is deleted outside Terraform, the worklog is removed from state
+and recreated on the next apply.
+
+## Example Usage
+
+` + "```hcl" + `
+resource "jira_worklog" "triage" {
+  issue_key  = jira_issue.incident.key
+  time_spent = "1h 30m"
+  started    = "2026-03-02T09:00:00Z"
+  comment    = "Initial triage"
+}
+` + "```" + `
+
+## Import
+
+Worklogs can be imported using the issue key and worklog ID separated by a colon:
+
+` + "```bash" + `
+terraform import jira_worklog.triage PROJ-123:10045
+` + "```" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The worklog ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_key": schema.StringAttribute{
+				Description: "The key of the issue to log time on (e.g., PROJ-123). Changing this forces a new worklog.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"time_spent": schema.StringAttribute{
+				Description: "The time spent, in Jira's duration format (e.g., 3h 30m, 1d) or as a number of seconds.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(worklogDurationPattern, "must be a Jira duration such as 3h 30m, or a number of seconds"),
+				},
+			},
+			"time_spent_seconds": schema.Int64Attribute{
+				Description: "The time spent in seconds, as Jira counts it.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"started": schema.StringAttribute{
+				Description: "When the work started, as an RFC 3339 timestamp (e.g., 2026-03-02T09:00:00Z). Defaults to when the worklog is created.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Description: "A comment describing the work.",
+				Optional:    true,
+			},
+			"author_account_id": schema.StringAttribute{
+				Description: "The account ID of the worklog author.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that started is an RFC 3339 timestamp.
+func (r *WorklogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WorklogResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Started.IsNull() || data.Started.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, data.Started.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("started"),
+			"Invalid Worklog Start",
+			fmt.Sprintf("Expected an RFC 3339 timestamp such as 2026-03-02T09:00:00Z, got %q.", data.Started.ValueString()),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WorklogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *WorklogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorklogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Jira worklog", map[string]any{
+		"issue_key":  data.IssueKey.ValueString(),
+		"time_spent": data.TimeSpent.ValueString(),
+	})
+
+	worklog, err := r.client.AddWorklog(ctx, data.IssueKey.ValueString(), r.worklogRequest(&data, false))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create worklog", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(worklog.ID)
+	setWorklogState(&data, worklog)
+
+	tflog.Info(ctx, "Created Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        worklog.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *WorklogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorklogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	// Jira answers 404 both for a deleted worklog and a deleted issue.
+	worklog, err := r.client.GetWorklog(ctx, data.IssueKey.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Failed to read worklog", err.Error())
+		return
+	}
+
+	setWorklogState(&data, worklog)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update changes the worklog in place.
+func (r *WorklogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorklogResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	worklog, err := r.client.UpdateWorklog(ctx, data.IssueKey.ValueString(), data.ID.ValueString(), r.worklogRequest(&data, true))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update worklog", err.Error())
+		return
+	}
+
+	setWorklogState(&data, worklog)
+
+	tflog.Info(ctx, "Updated Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *WorklogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorklogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteWorklog(ctx, data.IssueKey.ValueString(), data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete worklog", err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Deleted Jira worklog", map[string]any{
+		"issue_key": data.IssueKey.ValueString(),
+		"id":        data.ID.ValueString(),
+	})
+}
+
+// ImportState imports a worklog from an "<issue key>:<worklog id>" ID.
+func (r *WorklogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	issueKey, id, ok := strings.Cut(req.ID, ":")
+	if !ok || issueKey == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form <issue key>:<worklog id> (e.g., PROJ-123:10045), got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_key"), issueKey)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// worklogRequest builds the request body for the planned worklog. Updates
+// always send the comment, so removing it from the configuration clears it.
+func (r *WorklogResource) worklogRequest(data *WorklogResourceModel, update bool) *client.Worklog {
+	worklog := &client.Worklog{}
+
+	value := strings.TrimSpace(data.TimeSpent.ValueString())
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		worklog.TimeSpentSeconds = seconds
+	} else {
+		worklog.TimeSpent = value
+	}
+
+	if !data.Started.IsNull() && !data.Started.IsUnknown() {
+		if started, err := time.Parse(time.RFC3339, data.Started.ValueString()); err == nil {
+			worklog.Started = client.FormatTime(started)
+		}
+	}
+
+	if update || !data.Comment.IsNull() {
+		worklog.Comment = r.client.FormatText(data.Comment.ValueString())
+	}
+	return worklog
+}
+
+// setWorklogState copies a worklog read from Jira into the model, keeping
+// the configured duration and start time while they amount to what Jira
+// reports.
+func setWorklogState(data *WorklogResourceModel, worklog *client.Worklog) {
+	if seconds, ok := worklogSeconds(data.TimeSpent.ValueString()); !ok || seconds != worklog.TimeSpentSeconds {
+		data.TimeSpent = types.StringValue(worklog.TimeSpent)
+	}
+	data.TimeSpentSeconds = types.Int64Value(worklog.TimeSpentSeconds)
+	data.Started = worklogStarted(worklog.Started, data.Started)
+
+	if comment := client.ADFToText(worklog.Comment); comment != "" {
+		data.Comment = types.StringValue(comment)
+	} else {
+		data.Comment = types.StringNull()
+	}
+	data.AuthorAccountID = userAccountID(worklog.Author)
+}
+
+// worklogStarted maps a worklog's start time read from Jira to state as an
+// RFC 3339 timestamp, keeping the configured one while it names the same
+// instant.
+func worklogStarted(remote string, prior types.String) types.String {
+	got, err := client.ParseTime(remote)
+	if err != nil {
+		return stringOrNull(remote)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if want, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && want.Equal(got) {
+			return prior
+		}
+	}
+	return types.StringValue(got.Format(time.RFC3339))
+}
+
+// worklogSeconds converts a configured duration to seconds, counting days
+// and weeks by Jira's default time tracking settings. It reports false when
+// the value isn't a duration.
+func worklogSeconds(value string) (int64, bool) {
+	if !worklogDurationPattern.MatchString(value) {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+		return seconds, true
+	}
+
+	var total int64
+	for _, part := range worklogDurationPart.FindAllStringSubmatch(value, -1) {
+		n, err := strconv.ParseInt(part[1], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		switch part[2] {
+		case "w":
+			total += n * worklogSecondsPerWeek
+		case "d":
+			total += n * worklogSecondsPerDay
+		case "h":
+			total += n * 60 * 60
+		case "m":
+			total += n * 60
+		}
+	}
+	return total, true
+}
@@ -0,0 +1,237 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+// Package textdiff produces compact, line-based unified diffs for showing
+// reviewers what changed in long text attributes.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxCells bounds the size of the line comparison table. Texts with more
+// line pairs than this are diffed as a single whole-text replacement.
+const maxCells = 1 << 22
+
+// Hunk is a contiguous group of changed lines with surrounding context.
+// Starts are 1-based line numbers, as in unified diff headers.
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Lines              []string // prefixed with ' ', '-', or '+'
+}
+
+// Added returns the number of added lines in the hunk.
+func (h Hunk) Added() int {
+	return h.count('+')
+}
+
+// Removed returns the number of removed lines in the hunk.
+func (h Hunk) Removed() int {
+	return h.count('-')
+}
+
+func (h Hunk) count(prefix byte) int {
+	n := 0
+	for _, line := range h.Lines {
+		if line[0] == prefix {
+			n++
+		}
+	}
+	return n
+}
+
+// Header returns the unified diff hunk header, e.g. "@@ -3,4 +3,5 @@".
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+}
+
+// Options controls how a diff is rendered.
+type Options struct {
+	// Context is the number of unchanged lines shown around each change.
+	Context int
+	// MaxHunks limits how many hunks are rendered; zero means no limit.
+	MaxHunks int
+	// MaxLineLength truncates longer lines (on a rune boundary); zero means
+	// no limit.
+	MaxLineLength int
+	// HeadersOnly renders only hunk headers with added/removed counts.
+	HeadersOnly bool
+}
+
+// Unified returns a unified diff of two texts, or "" when they are equal.
+func Unified(oldText, newText string, opts Options) string {
+	hunks := Hunks(oldText, newText, opts.Context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	shown := hunks
+	if opts.MaxHunks > 0 && len(shown) > opts.MaxHunks {
+		shown = shown[:opts.MaxHunks]
+	}
+
+	var b strings.Builder
+	for _, h := range shown {
+		b.WriteString(h.Header())
+		if opts.HeadersOnly {
+			fmt.Fprintf(&b, " (+%d -%d)\n", h.Added(), h.Removed())
+			continue
+		}
+		b.WriteByte('\n')
+		for _, line := range h.Lines {
+			b.WriteString(truncate(line, opts.MaxLineLength))
+			b.WriteByte('\n')
+		}
+	}
+	if omitted := len(hunks) - len(shown); omitted > 0 {
+		fmt.Fprintf(&b, "... %d more hunk(s) not shown\n", omitted)
+	}
+	return b.String()
+}
+
+// Hunks computes the changed hunks between two texts, compared line by line.
+func Hunks(oldText, newText string, context int) []Hunk {
+	if oldText == newText {
+		return nil
+	}
+	if context < 0 {
+		context = 0
+	}
+
+	ops := diffLines(splitLines(oldText), splitLines(newText))
+
+	// Find the changed ops and group those within 2*context lines of each other.
+	var hunks []Hunk
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		start := i - context
+		if start < 0 {
+			start = 0
+		}
+
+		end := i
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == ' ' {
+				run++
+			}
+			if run == len(ops) || run-end > 2*context {
+				break
+			}
+			end = run
+		}
+		stop := end + context
+		if stop > len(ops) {
+			stop = len(ops)
+		}
+
+		h := Hunk{OldStart: ops[start].oldLine, NewStart: ops[start].newLine}
+		for _, op := range ops[start:stop] {
+			h.Lines = append(h.Lines, string(op.kind)+op.text)
+			if op.kind != '+' {
+				h.OldLines++
+			}
+			if op.kind != '-' {
+				h.NewLines++
+			}
+		}
+		hunks = append(hunks, h)
+		i = stop
+	}
+	return hunks
+}
+
+// op is a single line of an edit script.
+type op struct {
+	kind             byte // ' ', '-', or '+'
+	text             string
+	oldLine, newLine int // 1-based line positions before this op
+}
+
+// diffLines computes a line edit script using a longest common subsequence.
+func diffLines(a, b []string) []op {
+	if len(a)*len(b) > maxCells {
+		return replaceAll(a, b)
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, op{' ', a[i], i + 1, j + 1})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, op{'-', a[i], i + 1, j + 1})
+			i++
+		default:
+			ops = append(ops, op{'+', b[j], i + 1, j + 1})
+			j++
+		}
+	}
+	return ops
+}
+
+// replaceAll is the edit script that removes every line of a and adds every
+// line of b.
+func replaceAll(a, b []string) []op {
+	ops := make([]op, 0, len(a)+len(b))
+	for i, line := range a {
+		ops = append(ops, op{'-', line, i + 1, 1})
+	}
+	for j, line := range b {
+		ops = append(ops, op{'+', line, len(a) + 1, j + 1})
+	}
+	return ops
+}
+
+// splitLines splits text into lines, ignoring a trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// truncate shortens line to at most max bytes without splitting a
+// multi-byte character, marking the cut with an ellipsis.
+func truncate(line string, max int) string {
+	if max <= 0 || len(line) <= max {
+		return line
+	}
+	cut := max
+	for cut > 0 && !utf8.RuneStart(line[cut]) {
+		cut--
+	}
+	return line[:cut] + "…"
+}
@@ -0,0 +1,93 @@
+// Copyright (c) spectra
+// SPDX-License-Identifier: MIT
+
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		opts Options
+		want string
+	}{
+		{
+			name: "equal",
+			old:  "a\nb",
+			new:  "a\nb",
+			want: "",
+		},
+		{
+			name: "changed line with context",
+			old:  "a\nb\nc\nd\ne",
+			new:  "a\nb\nX\nd\ne",
+			opts: Options{Context: 1},
+			want: "@@ -2,3 +2,3 @@\n b\n-c\n+X\n d\n",
+		},
+		{
+			name: "headers only",
+			old:  "a\nb\nc\nd\ne",
+			new:  "a\nb\nX\nd\ne",
+			opts: Options{Context: 1, HeadersOnly: true},
+			want: "@@ -2,3 +2,3 @@ (+1 -1)\n",
+		},
+		{
+			name: "nearby changes share a hunk",
+			old:  "a\nb\nc\nd\ne",
+			new:  "A\nb\nc\nD\ne",
+			opts: Options{Context: 1},
+			want: "@@ -1,5 +1,5 @@\n-a\n+A\n b\n c\n-d\n+D\n e\n",
+		},
+		{
+			name: "hunks beyond the limit are counted",
+			old:  "a\nb\nc\nd\ne\nf\ng",
+			new:  "A\nb\nc\nD\ne\nf\nG",
+			opts: Options{MaxHunks: 2},
+			want: "@@ -1,1 +1,1 @@\n-a\n+A\n@@ -4,1 +4,1 @@\n-d\n+D\n... 1 more hunk(s) not shown\n",
+		},
+		{
+			name: "long lines truncated on a rune boundary",
+			old:  "héllo",
+			new:  "bye",
+			opts: Options{MaxLineLength: 3},
+			want: "@@ -1,1 +1,1 @@\n-h…\n+by…\n",
+		},
+		{
+			name: "trailing newline ignored",
+			old:  "a\n",
+			new:  "a\nb\n",
+			want: "@@ -2,0 +2,1 @@\n+b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified(tt.old, tt.new, tt.opts); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHunksLargeTextsReplacedWhole(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 3000; i++ {
+		a = append(a, fmt.Sprintf("line %d", i))
+		b = append(b, fmt.Sprintf("line %d", i))
+	}
+	b[0] = "changed"
+
+	hunks := Hunks(strings.Join(a, "\n"), strings.Join(b, "\n"), 0)
+	if len(hunks) != 1 {
+		t.Fatalf("got %d hunks, want 1", len(hunks))
+	}
+	if h := hunks[0]; h.Removed() != 3000 || h.Added() != 3000 {
+		t.Errorf("hunk removes %d and adds %d lines, want 3000 each", h.Removed(), h.Added())
+	}
+}